@@ -0,0 +1,10 @@
+# list the ACLs granted to a principal on topics
+data "redpanda_acls" "alice_topics" {
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+  principal       = "User:alice"
+  resource_type   = "TOPIC"
+}
+
+output "alice_topic_acls" {
+  value = [for acl in data.redpanda_acls.alice_topics.acls : "${acl.operation} on ${acl.resource_name}"]
+}
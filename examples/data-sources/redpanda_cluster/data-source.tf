@@ -0,0 +1,3 @@
+data "redpanda_cluster" "example" {
+  id = "cluster_id"
+}
@@ -0,0 +1,11 @@
+# write the configuration of the topics, users and ACLs of an existing cluster
+# to a file, then move it into the configuration and run terraform plan to
+# import them
+data "redpanda_cluster_export" "legacy" {
+  id = "cl-1234"
+}
+
+resource "local_file" "legacy" {
+  filename = "${path.module}/legacy.tf.txt"
+  content  = data.redpanda_cluster_export.legacy.hcl
+}
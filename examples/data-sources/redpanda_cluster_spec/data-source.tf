@@ -0,0 +1,8 @@
+data "redpanda_cluster_spec" "support" {
+  id = redpanda_cluster.test.id
+}
+
+resource "local_file" "support_bundle" {
+  filename = "${path.module}/cluster-spec.json"
+  content  = data.redpanda_cluster_spec.support.json
+}
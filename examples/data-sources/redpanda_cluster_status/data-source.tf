@@ -0,0 +1,19 @@
+# only create the topic once the cluster is healthy
+data "redpanda_cluster_status" "test" {
+  id = redpanda_cluster.test.id
+
+  lifecycle {
+    postcondition {
+      condition     = self.healthy
+      error_message = "cluster ${self.id} is not healthy: ${self.status}"
+    }
+  }
+}
+
+resource "redpanda_topic" "events" {
+  name               = "events"
+  partition_count    = 3
+  replication_factor = 3
+  cluster_api_url    = data.redpanda_cluster_status.test.cluster_api_url
+  allow_deletion     = false
+}
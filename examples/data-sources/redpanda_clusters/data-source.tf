@@ -0,0 +1,15 @@
+# manage the same topic on every production cluster of a resource group
+data "redpanda_clusters" "prod" {
+  resource_group_id = redpanda_resource_group.test.id
+  name_prefix       = "prod-"
+}
+
+resource "redpanda_topic" "events" {
+  for_each = { for c in data.redpanda_clusters.prod.clusters : c.name => c if c.state == "ready" }
+
+  name               = "events"
+  partition_count    = 3
+  replication_factor = 3
+  cluster_api_url    = each.value.cluster_api_url
+  allow_deletion     = false
+}
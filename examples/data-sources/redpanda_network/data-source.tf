@@ -0,0 +1,3 @@
+data "redpanda_network" "example" {
+  id = "network_id"
+}
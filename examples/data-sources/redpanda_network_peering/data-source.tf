@@ -0,0 +1,16 @@
+data "redpanda_network_peering" "test" {
+  network_id = redpanda_network.test.id
+}
+
+resource "aws_vpc_peering_connection" "redpanda" {
+  vpc_id        = aws_vpc.app.id
+  peer_owner_id = data.redpanda_network_peering.test.aws.owner_id
+  peer_vpc_id   = data.redpanda_network_peering.test.aws.vpc_id
+  peer_region   = redpanda_network.test.region
+}
+
+resource "aws_route" "redpanda" {
+  route_table_id            = aws_vpc.app.main_route_table_id
+  destination_cidr_block    = data.redpanda_network_peering.test.aws.cidr_block
+  vpc_peering_connection_id = aws_vpc_peering_connection.redpanda.id
+}
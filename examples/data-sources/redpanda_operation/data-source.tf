@@ -0,0 +1,12 @@
+# inspect the operation reported in the error of a failed cluster creation
+data "redpanda_operation" "failed_create" {
+  id = var.operation_id
+}
+
+output "failure" {
+  value = "${data.redpanda_operation.failed_create.state}: ${coalesce(data.redpanda_operation.failed_create.error_message, "no error")}"
+}
+
+variable "operation_id" {
+  type = string
+}
@@ -0,0 +1,4 @@
+data "redpanda_operations" "cluster" {
+  resource_id = redpanda_cluster.test.id
+  type        = "update_cluster"
+}
@@ -0,0 +1,11 @@
+data "redpanda_private_connectivity" "test" {
+  id = redpanda_cluster.test.id
+}
+
+# connect a VPC to the PrivateLink endpoint service of the cluster
+resource "aws_vpc_endpoint" "redpanda" {
+  vpc_id            = var.vpc_id
+  service_name      = data.redpanda_private_connectivity.test.service_name
+  vpc_endpoint_type = "Interface"
+  subnet_ids        = var.subnet_ids
+}
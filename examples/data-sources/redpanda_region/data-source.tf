@@ -0,0 +1,4 @@
+data "redpanda_region" "example" {
+  cloud_provider = "aws"
+  name           = "us-east-2"
+}
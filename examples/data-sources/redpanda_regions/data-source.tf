@@ -0,0 +1,3 @@
+data "redpanda_regions" "example" {
+  cloud_provider = "gcp"
+}
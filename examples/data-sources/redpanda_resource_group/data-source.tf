@@ -0,0 +1,19 @@
+# search by ID
+data "redpanda_resource_group" "by_id" {
+  id = "resource_group_id"
+}
+
+# search by name
+data "redpanda_resource_group" "by_name" {
+  name = "default"
+}
+
+# use the ID in place of a hardcoded UUID
+resource "redpanda_network" "example" {
+  name              = "example-network"
+  resource_group_id = data.redpanda_resource_group.by_name.id
+  cloud_provider    = "aws"
+  region            = "us-east-2"
+  cluster_type      = "dedicated"
+  cidr_block        = "10.0.0.0/20"
+}
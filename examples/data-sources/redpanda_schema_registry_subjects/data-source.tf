@@ -0,0 +1,9 @@
+# look up the schemas of the orders topics, registered by the application
+data "redpanda_schema_registry_subjects" "orders" {
+  cluster_id     = redpanda_cluster.test.id
+  subject_prefix = "orders-"
+}
+
+output "orders_value_schema_id" {
+  value = one([for s in data.redpanda_schema_registry_subjects.orders.subjects : s.schema_id if s.subject == "orders-value"])
+}
@@ -0,0 +1,3 @@
+data "redpanda_serverless_cluster" "example" {
+  id = "serverless_cluster_id"
+}
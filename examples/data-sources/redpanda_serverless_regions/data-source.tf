@@ -0,0 +1,3 @@
+data "redpanda_serverless_regions" "example" {
+  cloud_provider = "aws"
+}
@@ -0,0 +1,7 @@
+data "redpanda_throughput_tier_availability" "example" {
+  cloud_provider  = "aws"
+  cluster_type    = "dedicated"
+  region          = "us-east-2"
+  zones           = ["use2-az1", "use2-az2", "use2-az3"]
+  throughput_tier = "tier-1-aws-v2-arm"
+}
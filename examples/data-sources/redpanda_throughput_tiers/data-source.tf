@@ -0,0 +1,3 @@
+data "redpanda_throughput_tiers" "example" {
+  cloud_provider = "aws"
+}
@@ -0,0 +1,12 @@
+# list the service users of a cluster so an audit can compare them with the users managed in code
+data "redpanda_users" "services" {
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+  name_prefix     = "svc-"
+}
+
+output "unmanaged_service_users" {
+  value = setsubtract(
+    [for u in data.redpanda_users.services.users : u.name],
+    [for u in redpanda_user.services : u.name],
+  )
+}
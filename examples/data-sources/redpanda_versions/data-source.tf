@@ -0,0 +1,8 @@
+data "redpanda_versions" "v24_2" {
+  track = "24.2"
+}
+
+resource "redpanda_cluster" "example" {
+  # ...
+  redpanda_version = data.redpanda_versions.v24_2.stable
+}
@@ -0,0 +1,9 @@
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 3
+  replication_factor = 3
+  cluster_api_url    = data.redpanda_cluster.test.cluster_api_url
+  configuration = {
+    "retention.ms" = provider::redpanda::duration_ms("7d")
+  }
+}
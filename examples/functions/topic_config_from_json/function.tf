@@ -0,0 +1,7 @@
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 6
+  replication_factor = 3
+  cluster_api_url    = data.redpanda_cluster.test.cluster_api_url
+  configuration      = provider::redpanda::topic_config_from_json(file("${path.module}/orders.json"))
+}
@@ -0,0 +1,3 @@
+output "orders_configuration" {
+  value = provider::redpanda::topic_config_to_json(redpanda_topic.orders.configuration)
+}
@@ -0,0 +1,10 @@
+resource "redpanda_acl" "orders_read" {
+  resource_type         = "TOPIC"
+  resource_name         = redpanda_topic.orders.name
+  resource_pattern_type = "LITERAL"
+  principal             = "User:${redpanda_user.orders.name}"
+  host                  = "*"
+  operation             = "READ"
+  permission_type       = "ALLOW"
+  cluster_api_url       = redpanda_cluster.test.cluster_api_url
+}
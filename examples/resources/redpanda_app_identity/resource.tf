@@ -0,0 +1,8 @@
+resource "redpanda_app_identity" "orders_service" {
+  name                  = "orders-service"
+  password              = var.orders_service_password
+  read_topic_prefixes   = ["payments."]
+  write_topic_prefixes  = ["orders."]
+  consumer_group_prefix = "orders-service-"
+  cluster_api_url       = redpanda_cluster.test.cluster_api_url
+}
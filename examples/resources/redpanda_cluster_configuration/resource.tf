@@ -0,0 +1,8 @@
+resource "redpanda_cluster_configuration" "test" {
+  admin_api_url = "https://admin-api.example.com:9644"
+  properties = {
+    auto_create_topics_enabled = "false"
+    log_retention_ms           = "604800000"
+    log_compression_type       = "lz4"
+  }
+}
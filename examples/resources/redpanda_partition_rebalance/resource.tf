@@ -0,0 +1,6 @@
+resource "redpanda_partition_rebalance" "test" {
+  admin_api_url = "https://admin-api.example.com:9644"
+  triggers = {
+    throughput_tier = redpanda_cluster.test.throughput_tier
+  }
+}
@@ -0,0 +1,3 @@
+resource "redpanda_resource_group" "test" {
+  name = "data-platform"
+}
@@ -0,0 +1,21 @@
+resource "redpanda_role" "analysts" {
+  name            = "analysts"
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+}
+
+resource "redpanda_acl" "analysts_read" {
+  resource_type         = "TOPIC"
+  resource_name         = "orders"
+  resource_pattern_type = "LITERAL"
+  principal             = "RedpandaRole:${redpanda_role.analysts.name}"
+  host                  = "*"
+  operation             = "READ"
+  permission_type       = "ALLOW"
+  cluster_api_url       = redpanda_cluster.test.cluster_api_url
+}
+
+resource "redpanda_role_assignment" "alice" {
+  role_name       = redpanda_role.analysts.name
+  principal       = redpanda_user.alice.name
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+}
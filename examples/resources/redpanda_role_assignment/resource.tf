@@ -0,0 +1,5 @@
+resource "redpanda_role_assignment" "alice" {
+  role_name       = redpanda_role.analysts.name
+  principal       = redpanda_user.alice.name
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+}
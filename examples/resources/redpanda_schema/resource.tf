@@ -0,0 +1,12 @@
+resource "redpanda_schema" "orders" {
+  cluster_id = redpanda_cluster.test.id
+  subject    = "orders-value"
+  schema = jsonencode({
+    type = "record"
+    name = "Order"
+    fields = [
+      { name = "id", type = "string" },
+      { name = "amount", type = "double" },
+    ]
+  })
+}
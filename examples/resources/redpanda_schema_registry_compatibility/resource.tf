@@ -0,0 +1,12 @@
+# global compatibility level
+resource "redpanda_schema_registry_compatibility" "global" {
+  cluster_id = redpanda_cluster.test.id
+  level      = "BACKWARD"
+}
+
+# compatibility level of a single subject
+resource "redpanda_schema_registry_compatibility" "orders" {
+  cluster_id = redpanda_cluster.test.id
+  subject    = redpanda_schema.orders.subject
+  level      = "FULL_TRANSITIVE"
+}
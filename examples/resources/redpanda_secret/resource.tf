@@ -0,0 +1,5 @@
+resource "redpanda_secret" "db_password" {
+  name            = "DB_PASSWORD"
+  value           = var.db_password
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+}
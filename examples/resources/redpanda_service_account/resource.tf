@@ -0,0 +1,8 @@
+resource "redpanda_service_account" "ci" {
+  name        = "ci-pipeline"
+  description = "Deploys topics from CI"
+}
+
+output "ci_client_id" {
+  value = redpanda_service_account.ci.client_id
+}
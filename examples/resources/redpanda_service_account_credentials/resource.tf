@@ -0,0 +1,15 @@
+resource "redpanda_service_account" "ci" {
+  name = "ci-pipeline"
+
+  lifecycle {
+    replace_triggered_by = [terraform_data.ci_rotation]
+  }
+}
+
+resource "terraform_data" "ci_rotation" {
+  input = "2024-09"
+}
+
+resource "redpanda_service_account_credentials" "ci" {
+  service_account_id = redpanda_service_account.ci.id
+}
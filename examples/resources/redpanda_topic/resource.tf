@@ -0,0 +1,12 @@
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 3
+  replication_factor = 3
+  cluster_api_url    = redpanda_cluster.test.cluster_api_url
+  allow_deletion     = true
+
+  configuration = {
+    "cleanup.policy" = "delete"
+    "retention.ms"   = "604800000"
+  }
+}
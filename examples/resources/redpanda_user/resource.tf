@@ -0,0 +1,6 @@
+resource "redpanda_user" "orders" {
+  name            = "orders-service"
+  password        = var.orders_password
+  mechanism       = "scram-sha-256"
+  cluster_api_url = redpanda_cluster.test.cluster_api_url
+}
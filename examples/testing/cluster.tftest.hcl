@@ -0,0 +1,20 @@
+mock_provider "redpanda" {
+  source = "./mocks"
+}
+
+run "creates_cluster_in_network" {
+  assert {
+    condition     = redpanda_cluster.test.network_id == "cq4nekfbl8sdjqt0fo0g"
+    error_message = "cluster is not created in the network"
+  }
+
+  assert {
+    condition     = startswith(redpanda_topic.orders.cluster_api_url, "https://")
+    error_message = "topic is not created on the cluster API"
+  }
+
+  assert {
+    condition     = output.bootstrap_servers == "seed-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:9092"
+    error_message = "unexpected bootstrap servers"
+  }
+}
@@ -0,0 +1,39 @@
+resource "redpanda_resource_group" "test" {
+  name = var.name
+}
+
+resource "redpanda_network" "test" {
+  name              = var.name
+  resource_group_id = redpanda_resource_group.test.id
+  cloud_provider    = "aws"
+  region            = "us-east-2"
+  cluster_type      = "dedicated"
+  cidr_block        = "10.0.0.0/20"
+}
+
+resource "redpanda_cluster" "test" {
+  name              = var.name
+  resource_group_id = redpanda_resource_group.test.id
+  network_id        = redpanda_network.test.id
+  cloud_provider    = "aws"
+  region            = "us-east-2"
+  cluster_type      = "dedicated"
+  connection_type   = "public"
+  throughput_tier   = "tier-1-aws-v2-arm"
+  zones             = ["use2-az1", "use2-az2", "use2-az3"]
+}
+
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 3
+  replication_factor = 3
+  cluster_api_url    = redpanda_cluster.test.cluster_api_url
+}
+
+variable "name" {
+  default = "testname"
+}
+
+output "bootstrap_servers" {
+  value = join(",", redpanda_cluster.test.kafka_bootstrap_servers)
+}
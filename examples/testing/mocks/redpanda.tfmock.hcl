@@ -0,0 +1,121 @@
+# Realistic values for the attributes computed by the Redpanda Cloud API, for
+# use with the mock_provider blocks of `terraform test`:
+#
+#   mock_provider "redpanda" {
+#     source = "./mocks"
+#   }
+#
+# Attributes equal to the name of their object, such as the id of a topic or a
+# user, cannot be derived here: set them with override_resource when a test
+# depends on them.
+
+mock_resource "redpanda_resource_group" {
+  defaults = {
+    id = "b2d6c4f0-3c5e-4c4b-9a8e-6f1e2d3c4b5a"
+  }
+}
+
+mock_resource "redpanda_network" {
+  defaults = {
+    id = "cq4nekfbl8sdjqt0fo0g"
+  }
+}
+
+mock_resource "redpanda_cluster" {
+  defaults = {
+    id                      = "cq4nekfbl8sdjqt0fo10"
+    status                  = "ready"
+    status_reasons          = []
+    cluster_api_url         = "https://api-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com"
+    kafka_bootstrap_servers = ["seed-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:9092"]
+    schema_registry_url     = "https://schema-registry-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:30081"
+    http_proxy_url          = "https://pandaproxy-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:30082"
+    is_read_replica_source  = false
+  }
+}
+
+mock_resource "redpanda_serverless_cluster" {
+  defaults = {
+    id              = "cq4nekfbl8sdjqt0fo20"
+    state           = "ready"
+    dataplane_ready = true
+    cluster_api_url = "https://dataplane-api.cq4nekfbl8sdjqt0fo20.us-east-1.mpx.prd.cloud.redpanda.com"
+  }
+}
+
+mock_resource "redpanda_service_account" {
+  defaults = {
+    id            = "sa-cq4nekfbl8sdjqt0fo30"
+    client_id     = "Qk8mV3nT5rW1yZ7pL2xC9hJ4dF6gS0aE"
+    client_secret = "mock-client-secret"
+  }
+}
+
+mock_resource "redpanda_service_account_credentials" {
+  defaults = {
+    client_id     = "Qk8mV3nT5rW1yZ7pL2xC9hJ4dF6gS0aE"
+    client_secret = "mock-client-secret"
+  }
+}
+
+mock_resource "redpanda_schema" {
+  defaults = {
+    schema_id = 1
+    version   = 1
+  }
+}
+
+mock_data "redpanda_cluster" {
+  defaults = {
+    name                    = "mock-cluster"
+    cloud_provider          = "aws"
+    region                  = "us-east-2"
+    cluster_type            = "dedicated"
+    connection_type         = "public"
+    throughput_tier         = "tier-1-aws-v2-arm"
+    zones                   = ["use2-az1", "use2-az2", "use2-az3"]
+    network_id              = "cq4nekfbl8sdjqt0fo0g"
+    resource_group_id       = "b2d6c4f0-3c5e-4c4b-9a8e-6f1e2d3c4b5a"
+    redpanda_version        = "v24.2.4"
+    status                  = "ready"
+    cluster_api_url         = "https://api-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com"
+    kafka_bootstrap_servers = ["seed-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:9092"]
+    schema_registry_url     = "https://schema-registry-a1b2c3d4.cq4nekfbl8sdjqt0fo10.byoc.prd.cloud.redpanda.com:30081"
+  }
+}
+
+mock_data "redpanda_network" {
+  defaults = {
+    name              = "mock-network"
+    cloud_provider    = "aws"
+    region            = "us-east-2"
+    cluster_type      = "dedicated"
+    cidr_block        = "10.0.0.0/20"
+    resource_group_id = "b2d6c4f0-3c5e-4c4b-9a8e-6f1e2d3c4b5a"
+  }
+}
+
+mock_data "redpanda_serverless_cluster" {
+  defaults = {
+    name              = "mock-serverless-cluster"
+    serverless_region = "us-east-1"
+    state             = "ready"
+    dataplane_ready   = true
+    cluster_api_url   = "https://dataplane-api.cq4nekfbl8sdjqt0fo20.us-east-1.mpx.prd.cloud.redpanda.com"
+    resource_group_id = "b2d6c4f0-3c5e-4c4b-9a8e-6f1e2d3c4b5a"
+  }
+}
+
+mock_data "redpanda_region" {
+  defaults = {
+    zones = ["use2-az1", "use2-az2", "use2-az3"]
+  }
+}
+
+mock_data "redpanda_versions" {
+  defaults = {
+    versions = ["v24.2.4", "v24.2.3"]
+    latest   = "v24.2.4"
+    stable   = "v24.2.4"
+  }
+}
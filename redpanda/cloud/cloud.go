@@ -24,16 +24,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
-	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 )
@@ -45,20 +44,21 @@ type Endpoint struct {
 	InternalAPIURL string // CloudV2 internal API URL.
 	authURL        string // CloudV2 URL for authorization token exchange.
 	audience       string // CloudV2 audience used for token exchange.
+	scope          string // OAuth scope requested on token exchange, if any.
 }
 
 var endpoints = map[string]Endpoint{
 	"dev": {
-		"api.dev.cloud.redpanda.com:443",
-		"https://cloud-api.dev.cloud.redpanda.com",
-		"https://dev-cloudv2.us.auth0.com/oauth/token",
-		"cloudv2-dev.redpanda.cloud",
+		APIURL:         "api.dev.cloud.redpanda.com:443",
+		InternalAPIURL: "https://cloud-api.dev.cloud.redpanda.com",
+		authURL:        "https://dev-cloudv2.us.auth0.com/oauth/token",
+		audience:       "cloudv2-dev.redpanda.cloud",
 	},
 	"ign": {
-		"api.ign.cloud.redpanda.com:443",
-		"https://cloud-api.ign.cloud.redpanda.com",
-		"https://integration-cloudv2.us.auth0.com/oauth/token",
-		"cloudv2-ign.redpanda.cloud",
+		APIURL:         "api.ign.cloud.redpanda.com:443",
+		InternalAPIURL: "https://cloud-api.ign.cloud.redpanda.com",
+		authURL:        "https://integration-cloudv2.us.auth0.com/oauth/token",
+		audience:       "cloudv2-ign.redpanda.cloud",
 	},
 	"pre": {
 		APIURL:         "api.ppd.cloud.redpanda.com:443",
@@ -67,10 +67,10 @@ var endpoints = map[string]Endpoint{
 		audience:       "cloudv2-preprod.redpanda.cloud",
 	},
 	"prod": {
-		"api.redpanda.com:443",
-		"https://cloud-api.prd.cloud.redpanda.com",
-		"https://auth.prd.cloud.redpanda.com/oauth/token",
-		"cloudv2-production.redpanda.cloud",
+		APIURL:         "api.redpanda.com:443",
+		InternalAPIURL: "https://cloud-api.prd.cloud.redpanda.com",
+		authURL:        "https://auth.prd.cloud.redpanda.com/oauth/token",
+		audience:       "cloudv2-production.redpanda.cloud",
 	},
 }
 
@@ -81,30 +81,83 @@ type tokenResponse struct {
 	TokenType   string `json:"token_type"`
 }
 
-// EndpointForEnv returns the Endpoint for a given environment.
+// envAliases maps the long environment names to the keys of endpoints.
+var envAliases = map[string]string{
+	"development": "dev",
+	"integration": "ign",
+	"preprod":     "pre",
+	"production":  "prod",
+}
+
+// EndpointForEnv returns the Endpoint for a given environment. The environment
+// is case insensitive and can be given either by its short or its long name,
+// e.g. ign or integration.
 func EndpointForEnv(cloudEnv string) (*Endpoint, error) {
-	endpoint, found := endpoints[cloudEnv]
+	env := strings.ToLower(strings.TrimSpace(cloudEnv))
+	if alias, ok := envAliases[env]; ok {
+		env = alias
+	}
+	endpoint, found := endpoints[env]
 	if !found {
 		return nil, fmt.Errorf("unable to find requested environment: %q", cloudEnv)
 	}
 	return &endpoint, nil
 }
 
+// WithOverrides returns a copy of the endpoint whose API URL, internal API URL
+// and token exchange URL are replaced by the given ones, unless they are
+// empty. The audience of the environment is kept.
+func (e Endpoint) WithOverrides(apiURL, internalAPIURL, authURL string) *Endpoint {
+	if apiURL != "" {
+		e.APIURL = apiURL
+	}
+	if internalAPIURL != "" {
+		e.InternalAPIURL = internalAPIURL
+	}
+	if authURL != "" {
+		e.authURL = authURL
+	}
+	return &e
+}
+
+// WithOAuth returns a copy of the endpoint whose token exchange requests the
+// given audience and scope, unless they are empty. Control planes that are not
+// run by Redpanda, e.g. sovereign or self-hosted ones, usually expect their
+// own audience.
+func (e Endpoint) WithOAuth(audience, scope string) *Endpoint {
+	if audience != "" {
+		e.audience = audience
+	}
+	if scope != "" {
+		e.scope = scope
+	}
+	return &e
+}
+
 // RequestToken requests an authentication token for a given Endpoint.
-func RequestToken(ctx context.Context, endpoint *Endpoint, clientID, clientSecret string) (string, error) {
+// The request goes through the given proxy, if any.
+func RequestToken(ctx context.Context, endpoint *Endpoint, clientID, clientSecret string, proxy *Proxy) (string, error) {
 	if clientID == "" {
 		return "", fmt.Errorf("client_id is not set")
 	}
 	if clientSecret == "" {
 		return "", fmt.Errorf("client_secret is not set")
 	}
-	payload := fmt.Sprintf("grant_type=client_credentials&client_id=%s&client_secret=%s&audience=%s", clientID, clientSecret, endpoint.audience)
-	req, err := http.NewRequestWithContext(ctx, "POST", endpoint.authURL, strings.NewReader(payload))
+	payload := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {clientID},
+		"client_secret": {clientSecret},
+		"audience":      {endpoint.audience},
+	}
+	if endpoint.scope != "" {
+		payload.Set("scope", endpoint.scope)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint.authURL, strings.NewReader(payload.Encode()))
 	if err != nil {
 		return "", fmt.Errorf("unable to issue request to %v: %v", endpoint.authURL, err)
 	}
 	req.Header.Add("content-type", "application/x-www-form-urlencoded")
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := proxy.HTTPClient().Do(req)
 	if err != nil {
 		return "", fmt.Errorf("request to %v failed: %v", endpoint.authURL, err)
 	}
@@ -113,6 +166,9 @@ func RequestToken(ctx context.Context, endpoint *Endpoint, clientID, clientSecre
 		if err != nil {
 			return "", fmt.Errorf("request to %v failed: unable to read body", endpoint.authURL)
 		}
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return "", fmt.Errorf("client secret rejected by %v; check the provider credentials: %v %v: %s", endpoint.authURL, resp.StatusCode, http.StatusText(resp.StatusCode), resBody)
+		}
 		return "", fmt.Errorf("request to %v failed: %v %v: %s", endpoint.authURL, resp.StatusCode, http.StatusText(resp.StatusCode), resBody)
 	}
 
@@ -142,16 +198,23 @@ func parseHTTPSURLAsGrpc(url string) (string, error) {
 }
 
 // SpawnConn returns a grpc connection to the given URL, it adds a bearer token
-// to each request with the given 'authToken'.
-func SpawnConn(url, authToken string) (*grpc.ClientConn, error) {
+// to each request with the given 'authToken'. If proxy is not nil, the
+// connection is tunneled through it. Transient errors are retried according
+// to DefaultRetryPolicy.
+func SpawnConn(url, authToken string, proxy *Proxy) (*grpc.ClientConn, error) {
+	return SpawnConnWithRetries(url, authToken, proxy, DefaultRetryPolicy)
+}
+
+// SpawnConnWithRetries is SpawnConn with the given policy for retrying the
+// calls that failed with a transient error.
+func SpawnConnWithRetries(url, authToken string, proxy *Proxy, retries RetryPolicy) (*grpc.ClientConn, error) {
 	// we need a GRPC URL, but it's likely that we'll be given an HTTPS URL instead
 	grpcURL, err := parseHTTPSURLAsGrpc(url)
 	if err != nil {
 		return nil, err
 	}
 
-	return grpc.NewClient(
-		grpcURL,
+	opts := []grpc.DialOption{
 		// Chain the interceptors using grpc_middleware.ChainUnaryClient
 		grpc.WithUnaryInterceptor(grpcmiddleware.ChainUnaryClient(
 			// Interceptor to add the Bearer token
@@ -168,13 +231,16 @@ func SpawnConn(url, authToken string) (*grpc.ClientConn, error) {
 				})
 				return err
 			},
+			requestIDInterceptor,
+			deprecationInterceptor,
+			authErrorInterceptor(authToken),
+			throttleInterceptor,
 			rl.Limiter,
 			// Retry interceptor
-			grpcretry.UnaryClientInterceptor(
-				grpcretry.WithCodes(codes.Unavailable, codes.Unknown, codes.Internal),
-				grpcretry.WithMax(5),
-				grpcretry.WithBackoff(grpcretry.BackoffExponential(time.Millisecond*100)),
-			),
+			retries.interceptor(),
+			// Runs within the retry interceptor so gateway errors are
+			// classified before deciding whether to retry.
+			gatewayErrorInterceptor,
 		)),
 		// And provide TLS config.
 		grpc.WithTransportCredentials(
@@ -191,5 +257,12 @@ func SpawnConn(url, authToken string) (*grpc.ClientConn, error) {
 		// indefinitely if the cluster is not responding and to provide an
 		// useful error on these cases. See:
 		// https://github.com/grpc/grpc-go/blob/master/Documentation/anti-patterns.md#using-failonnontempdialerror-withblock-and-withreturnconnectionerror
-	)
+	}
+	if proxy != nil {
+		// the proxy resolves the hostname, so skip the DNS resolver
+		grpcURL = "passthrough:///" + grpcURL
+		opts = append(opts, grpc.WithContextDialer(proxy.dial))
+	}
+
+	return grpc.NewClient(grpcURL, opts...)
 }
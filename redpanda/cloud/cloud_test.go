@@ -1,6 +1,10 @@
 package cloud
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -84,3 +88,86 @@ func TestParseHTTPSURLAsGrpc(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointForEnv(t *testing.T) {
+	testCases := []struct {
+		env         string
+		expectedAPI string
+		expectError bool
+	}{
+		{env: "prod", expectedAPI: "api.redpanda.com:443"},
+		{env: "production", expectedAPI: "api.redpanda.com:443"},
+		{env: "ign", expectedAPI: "api.ign.cloud.redpanda.com:443"},
+		{env: "Integration", expectedAPI: "api.ign.cloud.redpanda.com:443"},
+		{env: "preprod", expectedAPI: "api.ppd.cloud.redpanda.com:443"},
+		{env: "staging", expectError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.env, func(t *testing.T) {
+			endpoint, err := EndpointForEnv(tc.env)
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("Expected an error for environment %q", tc.env)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if endpoint.APIURL != tc.expectedAPI {
+				t.Errorf("Expected API URL %q, got %q", tc.expectedAPI, endpoint.APIURL)
+			}
+		})
+	}
+}
+
+func TestEndpointWithOverrides(t *testing.T) {
+	endpoint, err := EndpointForEnv("pre")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := endpoint.WithOverrides("", "", ""); *got != *endpoint {
+		t.Errorf("Expected %+v, got %+v", *endpoint, *got)
+	}
+	got := endpoint.WithOverrides("api.gateway.example.com:443", "https://cloud-api.gateway.example.com", "https://auth.example.com/oauth/token")
+	if got.APIURL != "api.gateway.example.com:443" || got.InternalAPIURL != "https://cloud-api.gateway.example.com" ||
+		got.authURL != "https://auth.example.com/oauth/token" {
+		t.Errorf("Unexpected overridden endpoint %+v", *got)
+	}
+	if got.audience != endpoint.audience {
+		t.Errorf("Expected the audience of %+v, got %+v", *endpoint, *got)
+	}
+	if endpoint.APIURL != "api.ppd.cloud.redpanda.com:443" {
+		t.Errorf("Expected the environment endpoint to be unchanged, got %q", endpoint.APIURL)
+	}
+}
+
+func TestEndpointWithOAuth(t *testing.T) {
+	endpoint, err := EndpointForEnv("prod")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := endpoint.WithOAuth("", ""); *got != *endpoint {
+		t.Errorf("Expected %+v, got %+v", *endpoint, *got)
+	}
+	got := endpoint.WithOAuth("cloud.sovereign.example.com", "openid")
+	if got.audience != "cloud.sovereign.example.com" || got.scope != "openid" || got.authURL != endpoint.authURL {
+		t.Errorf("Unexpected overridden endpoint %+v", *got)
+	}
+}
+
+func TestRequestTokenRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":"access_denied","error_description":"Unauthorized"}`))
+	}))
+	defer srv.Close()
+
+	_, err := RequestToken(context.Background(), &Endpoint{authURL: srv.URL}, "id", "rotated", nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if !strings.Contains(err.Error(), "client secret rejected") || !strings.Contains(err.Error(), "access_denied") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
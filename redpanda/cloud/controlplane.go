@@ -19,8 +19,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/iam/v1alpha1/iamv1alpha1grpc"
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/ui/v1alpha1/uiv1alpha1grpc"
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"google.golang.org/grpc"
 )
@@ -49,20 +52,32 @@ type ControlPlaneClientSet struct {
 	Operation         controlplanev1beta2grpc.OperationServiceClient
 	ThroughputTier    controlplanev1beta2grpc.ThroughputTierServiceClient
 	Region            controlplanev1beta2grpc.RegionServiceClient
+	RedpandaVersion   controlplanev1beta2grpc.RedpandaVersionServiceClient
+	ServiceAccount    iamv1alpha1grpc.ServiceAccountServiceClient
+	// NetworkPeering is served by the Cloud UI backend rather than the Public
+	// API, so it has no stability guarantee.
+	NetworkPeering uiv1alpha1grpc.NetworkPeeringServiceClient
+
+	cache *lookupCache
 }
 
 // NewControlPlaneClientSet uses the passed grpc connection to create a control
 // plane client set.
 func NewControlPlaneClientSet(conn *grpc.ClientConn) *ControlPlaneClientSet {
+	cache := lookupCacheFor(conn)
 	return &ControlPlaneClientSet{
-		ResourceGroup:     controlplanev1beta2grpc.NewResourceGroupServiceClient(conn),
-		Network:           controlplanev1beta2grpc.NewNetworkServiceClient(conn),
+		ResourceGroup:     resourceGroupClient{controlplanev1beta2grpc.NewResourceGroupServiceClient(conn), cache},
+		Network:           networkClient{controlplanev1beta2grpc.NewNetworkServiceClient(conn), cache},
 		Cluster:           controlplanev1beta2grpc.NewClusterServiceClient(conn),
 		ServerlessCluster: controlplanev1beta2grpc.NewServerlessClusterServiceClient(conn),
 		ServerlessRegion:  controlplanev1beta2grpc.NewServerlessRegionServiceClient(conn),
 		Operation:         controlplanev1beta2grpc.NewOperationServiceClient(conn),
 		ThroughputTier:    controlplanev1beta2grpc.NewThroughputTierServiceClient(conn),
 		Region:            controlplanev1beta2grpc.NewRegionServiceClient(conn),
+		RedpandaVersion:   controlplanev1beta2grpc.NewRedpandaVersionServiceClient(conn),
+		ServiceAccount:    iamv1alpha1grpc.NewServiceAccountServiceClient(conn),
+		NetworkPeering:    uiv1alpha1grpc.NewNetworkPeeringServiceClient(conn),
+		cache:             cache,
 	}
 }
 
@@ -100,8 +115,15 @@ func (cpCl *ControlPlaneClientSet) ResourceGroupForID(ctx context.Context, id st
 }
 
 // ResourceGroupForName lists all resource group with a name filter, returns
-// the resource group for the given name.
+// the resource group for the given name. It fails if more than one resource
+// group has that name. Results are cached per provider connection.
 func (cpCl *ControlPlaneClientSet) ResourceGroupForName(ctx context.Context, name string) (*controlplanev1beta2.ResourceGroup, error) {
+	return lookup(cpCl.cache, lookupResourceGroup, name, func() (*controlplanev1beta2.ResourceGroup, error) {
+		return cpCl.listResourceGroupForName(ctx, name)
+	})
+}
+
+func (cpCl *ControlPlaneClientSet) listResourceGroupForName(ctx context.Context, name string) (*controlplanev1beta2.ResourceGroup, error) {
 	listResp, err := cpCl.ResourceGroup.ListResourceGroups(ctx, &controlplanev1beta2.ListResourceGroupsRequest{
 		Filter: &controlplanev1beta2.ListResourceGroupsRequest_Filter{
 			Name: name,
@@ -113,12 +135,23 @@ func (cpCl *ControlPlaneClientSet) ResourceGroupForName(ctx context.Context, nam
 	if listResp.ResourceGroups == nil {
 		return nil, fmt.Errorf("unable to find resource group with name %q: provider response was empty. Please report this issue to the provider developers", name)
 	}
+	var matches []*controlplanev1beta2.ResourceGroup
 	for _, rg := range listResp.ResourceGroups {
 		if rg.GetName() == name {
-			return rg, nil
+			matches = append(matches, rg)
 		}
 	}
-	return nil, fmt.Errorf("resource group %s not found", name)
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("resource group %s not found", name)
+	case 1:
+		return matches[0], nil
+	}
+	ids := make([]string, 0, len(matches))
+	for _, rg := range matches {
+		ids = append(ids, rg.GetId())
+	}
+	return nil, fmt.Errorf("found %d resource groups with name %q, use one of their IDs instead: %s", len(matches), name, strings.Join(ids, ", "))
 }
 
 // ResourceGroupForIDOrName gets the resource group for a given ID and/or name, or neither,
@@ -141,7 +174,7 @@ func (cpCl *ControlPlaneClientSet) ResourceGroupForIDOrName(ctx context.Context,
 
 	request := &controlplanev1beta2.ListResourceGroupsRequest{}
 	listResp, err := cpCl.ResourceGroup.ListResourceGroups(ctx, request)
-	if listResp.ResourceGroups == nil {
+	if err == nil && listResp.ResourceGroups == nil {
 		err = fmt.Errorf("provider response was empty. Please report this issue to the provider developers")
 	}
 	if err != nil {
@@ -171,8 +204,14 @@ func (cpCl *ControlPlaneClientSet) NetworkForID(ctx context.Context, id string)
 }
 
 // NetworkForName lists all networks with a name filter, returns the network for
-// the given name.
+// the given name. Results are cached per provider connection.
 func (cpCl *ControlPlaneClientSet) NetworkForName(ctx context.Context, name string) (*controlplanev1beta2.Network, error) {
+	return lookup(cpCl.cache, lookupNetwork, name, func() (*controlplanev1beta2.Network, error) {
+		return cpCl.listNetworkForName(ctx, name)
+	})
+}
+
+func (cpCl *ControlPlaneClientSet) listNetworkForName(ctx context.Context, name string) (*controlplanev1beta2.Network, error) {
 	ns, err := cpCl.Network.ListNetworks(ctx, &controlplanev1beta2.ListNetworksRequest{
 		Filter: &controlplanev1beta2.ListNetworksRequest_Filter{Name: name},
 	})
@@ -219,6 +258,27 @@ func (cpCl *ControlPlaneClientSet) ClusterForName(ctx context.Context, name stri
 	return nil, fmt.Errorf("cluster not found")
 }
 
+// ClusterForAPIURL lists all clusters and returns the cluster whose cluster
+// API has the given URL.
+func (cpCl *ControlPlaneClientSet) ClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1beta2.Cluster, error) {
+	var pageToken string
+	for {
+		clusters, err := cpCl.Cluster.ListClusters(ctx, &controlplanev1beta2.ListClustersRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range clusters.GetClusters() {
+			if c.GetDataplaneApi().GetUrl() == apiURL {
+				return c, nil
+			}
+		}
+		pageToken = clusters.GetNextPageToken()
+		if pageToken == "" || len(clusters.GetClusters()) == 0 {
+			return nil, fmt.Errorf("cluster with cluster API URL %q not found", apiURL)
+		}
+	}
+}
+
 // ServerlessClusterForID gets the ServerlessCluster for a given ID and handles the error if the
 // returned serverless cluster is nil.
 func (cpCl *ControlPlaneClientSet) ServerlessClusterForID(ctx context.Context, id string) (*controlplanev1beta2.ServerlessCluster, error) {
@@ -0,0 +1,129 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// DeprecationNotices collects the deprecation notices returned by the API
+// for the calls made with a context returned by WithDeprecationNotices.
+type DeprecationNotices struct {
+	mu      sync.Mutex
+	notices []string
+}
+
+type deprecationNoticesKey struct{}
+
+// WithDeprecationNotices returns a context collecting the deprecation notices
+// of the calls made with it.
+func WithDeprecationNotices(ctx context.Context) (context.Context, *DeprecationNotices) {
+	n := &DeprecationNotices{}
+	return context.WithValue(ctx, deprecationNoticesKey{}, n), n
+}
+
+// List returns the notices collected so far, without duplicates.
+func (n *DeprecationNotices) List() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return slices.Clone(n.notices)
+}
+
+func (n *DeprecationNotices) add(notice string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !slices.Contains(n.notices, notice) {
+		n.notices = append(n.notices, notice)
+	}
+}
+
+// deprecationInterceptor records the Deprecation, Sunset and Warning headers
+// returned by the API, so that deprecated calls are reported before they are
+// removed.
+func deprecationInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	if notice := deprecationNotice(method, header, trailer); notice != "" {
+		tflog.Warn(ctx, "Redpanda API call is deprecated", map[string]any{"method": method, "notice": notice})
+		if n, ok := ctx.Value(deprecationNoticesKey{}).(*DeprecationNotices); ok {
+			n.add(notice)
+		}
+	}
+	return err
+}
+
+// deprecationNotice returns the notice for the deprecation headers of a call,
+// or an empty string if the call is not deprecated.
+func deprecationNotice(method string, mds ...metadata.MD) string {
+	get := func(key string) string {
+		for _, md := range mds {
+			if v := md.Get(key); len(v) > 0 && strings.TrimSpace(v[0]) != "" {
+				return strings.TrimSpace(v[0])
+			}
+		}
+		return ""
+	}
+	deprecation, sunset, warning := get("deprecation"), get("sunset"), get("warning")
+	if (deprecation == "" || deprecation == "false") && sunset == "" {
+		return ""
+	}
+	// "/redpanda.api.controlplane.v1beta2.ClusterService/GetCluster" is
+	// reported as ClusterService/GetCluster
+	if i := strings.LastIndex(method, "."); i >= 0 {
+		method = method[i+1:]
+	}
+	notice := fmt.Sprintf("The Redpanda API call %s is deprecated", method)
+	if sunset != "" {
+		notice += fmt.Sprintf(" and will be removed after %s", sunset)
+	}
+	if warning != "" {
+		notice += ": " + warning
+	}
+	return notice + "."
+}
+
+// DeprecatedFields returns the dotted paths of the fields set in msg that
+// are marked as deprecated in the API definition.
+func DeprecatedFields(msg proto.Message) []string {
+	var fields []string
+	var walk func(prefix string, m protoreflect.Message)
+	walk = func(prefix string, m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			name := prefix + string(fd.Name())
+			if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && opts.GetDeprecated() {
+				fields = append(fields, name)
+			}
+			if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+				walk(name+".", v.Message())
+			}
+			return true
+		})
+	}
+	walk("", msg.ProtoReflect())
+	slices.Sort(fields)
+	return fields
+}
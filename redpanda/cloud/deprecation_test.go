@@ -0,0 +1,74 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestDeprecationNotice(t *testing.T) {
+	method := "/redpanda.api.controlplane.v1beta2.ClusterService/GetCluster"
+	tests := []struct {
+		name    string
+		header  metadata.MD
+		trailer metadata.MD
+		want    string
+	}{
+		{name: "not deprecated"},
+		{name: "explicitly not deprecated", header: metadata.Pairs("deprecation", "false")},
+		{name: "deprecated", header: metadata.Pairs("deprecation", "true"), want: "The Redpanda API call ClusterService/GetCluster is deprecated."},
+		{
+			name:   "sunset",
+			header: metadata.Pairs("deprecation", "@1688169599", "sunset", "Wed, 31 Dec 2025 23:59:59 GMT"),
+			want:   "The Redpanda API call ClusterService/GetCluster is deprecated and will be removed after Wed, 31 Dec 2025 23:59:59 GMT.",
+		},
+		{
+			name:    "warning in trailer",
+			header:  metadata.Pairs("deprecation", "true"),
+			trailer: metadata.Pairs("warning", `299 - "use v1 instead"`),
+			want:    `The Redpanda API call ClusterService/GetCluster is deprecated: 299 - "use v1 instead".`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, deprecationNotice(method, tt.header, tt.trailer))
+		})
+	}
+}
+
+func TestDeprecationInterceptor(t *testing.T) {
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		for _, o := range opts {
+			if h, ok := o.(grpc.HeaderCallOption); ok {
+				*h.HeaderAddr = metadata.Pairs("deprecation", "true")
+			}
+		}
+		return nil
+	}
+	ctx, notices := WithDeprecationNotices(context.Background())
+	for range 2 {
+		assert.NoError(t, deprecationInterceptor(ctx, "/svc.Service/Method", nil, nil, nil, invoker))
+	}
+	assert.Equal(t, []string{"The Redpanda API call Service/Method is deprecated."}, notices.List())
+
+	// calls made without a collector are only logged
+	assert.NoError(t, deprecationInterceptor(context.Background(), "/svc.Service/Method", nil, nil, nil, invoker))
+}
+
+func TestDeprecatedFields(t *testing.T) {
+	// descriptor.proto is used as it has deprecated fields
+	msg := &descriptorpb.FileDescriptorProto{
+		Name: proto.String("orders.proto"),
+		Options: &descriptorpb.FileOptions{
+			JavaPackage:               proto.String("com.example"),
+			JavaGenerateEqualsAndHash: proto.Bool(true),
+		},
+	}
+	assert.Equal(t, []string{"options.java_generate_equals_and_hash"}, DeprecatedFields(msg))
+	assert.Empty(t, DeprecatedFields(&descriptorpb.FileDescriptorProto{Name: proto.String("orders.proto")}))
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// httpStatusRegex matches the error reported by gRPC when an HTTP
+	// gateway or load balancer answers instead of the API.
+	httpStatusRegex = regexp.MustCompile(`unexpected HTTP status code received from server: (\d{3})`)
+	titleRegex      = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	scriptRegex     = regexp.MustCompile(`(?is)<(script|style)[^>]*>.*?</(script|style)>`)
+	tagRegex        = regexp.MustCompile(`(?s)<[^>]*>`)
+	spaceRegex      = regexp.MustCompile(`\s+`)
+)
+
+// maxGatewayMessageLen is the maximum length, in characters, of the message
+// kept from an HTML error page.
+const maxGatewayMessageLen = 256
+
+// credentialsRejectedMsg explains the usual cause of authentication failures
+// once the provider is configured: the client secret was rotated while
+// Terraform was running, or the access token expired.
+const credentialsRejectedMsg = "credentials rejected by the Redpanda API, the client secret was likely rotated or the access token expired; update the provider credentials"
+
+// acceptedTokens holds the access tokens accepted by the APIs during this run
+// of the provider.
+var acceptedTokens sync.Map
+
+// gatewayErrorInterceptor classifies the errors returned by HTTP gateways in
+// front of the APIs. 5xx responses are reported as Unavailable so that they
+// are retried, and HTML pages are reduced to their text so that diagnostics
+// show the actual issue.
+func gatewayErrorInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return classifyGatewayError(invoker(ctx, method, req, reply, cc, opts...))
+}
+
+func classifyGatewayError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	code, msg := st.Code(), st.Message()
+	isHTML := looksLikeHTML(msg)
+	if isHTML {
+		msg = stripHTML(msg)
+	}
+	if m := httpStatusRegex.FindStringSubmatch(msg); m != nil {
+		if httpCode, _ := strconv.Atoi(m[1]); httpCode >= 500 {
+			code = codes.Unavailable
+		}
+	}
+	if code == st.Code() && !isHTML {
+		return err
+	}
+	return withMessage(st, code, msg)
+}
+
+// withMessage returns the error of the status with the given code and message.
+// The details of the status, e.g. the field violations of a bad request, are
+// kept.
+func withMessage(st *status.Status, code codes.Code, msg string) error {
+	p := st.Proto()
+	p.Code = int32(code)
+	p.Message = msg
+	return status.ErrorProto(p)
+}
+
+// authErrorInterceptor returns an interceptor that replaces the
+// Unauthenticated errors returned by the APIs with an explanation of their
+// likely cause. The explanation only holds once the token was accepted earlier
+// in the run, so errors for a token that never worked are returned as is.
+func authErrorInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			acceptedTokens.Store(token, struct{}{})
+			return nil
+		}
+		if _, ok := acceptedTokens.Load(token); !ok {
+			return err
+		}
+		return classifyAuthError(err)
+	}
+}
+
+func classifyAuthError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unauthenticated {
+		return err
+	}
+	return withMessage(st, codes.Unauthenticated, fmt.Sprintf("%s: %s", credentialsRejectedMsg, st.Message()))
+}
+
+func looksLikeHTML(msg string) bool {
+	lower := strings.ToLower(msg)
+	return strings.Contains(lower, "<html") || strings.Contains(lower, "<!doctype html") || strings.Contains(lower, "<body")
+}
+
+// stripHTML reduces an error message holding an HTML page to its text. The
+// page title is kept first as it usually summarizes the error.
+func stripHTML(msg string) string {
+	var title string
+	if m := titleRegex.FindStringSubmatch(msg); m != nil {
+		title = strings.TrimSpace(html.UnescapeString(tagRegex.ReplaceAllString(m[1], " ")))
+		msg = strings.Replace(msg, m[0], " ", 1)
+	}
+	msg = scriptRegex.ReplaceAllString(msg, " ")
+	msg = tagRegex.ReplaceAllString(msg, " ")
+	msg = strings.TrimSpace(spaceRegex.ReplaceAllString(html.UnescapeString(msg), " "))
+	if title != "" && !strings.Contains(msg, title) {
+		msg = fmt.Sprintf("%s: %s", title, msg)
+	}
+	return truncate(msg, maxGatewayMessageLen)
+}
+
+// truncate shortens msg to limit characters. It cuts on a character boundary,
+// so that multi-byte characters are never split.
+func truncate(msg string, limit int) string {
+	n := 0
+	for i := range msg {
+		if n == limit {
+			return msg[:i] + "..."
+		}
+		n++
+	}
+	return msg
+}
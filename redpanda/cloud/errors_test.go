@@ -0,0 +1,160 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyGatewayError(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		expectedCode codes.Code
+		expectedMsg  string
+	}{
+		{
+			name:         "bad gateway becomes unavailable",
+			err:          status.Error(codes.Unknown, `unexpected HTTP status code received from server: 502 (Bad Gateway); transport: received unexpected content-type "text/html"`),
+			expectedCode: codes.Unavailable,
+			expectedMsg:  `unexpected HTTP status code received from server: 502 (Bad Gateway); transport: received unexpected content-type "text/html"`,
+		},
+		{
+			name:         "html page is reduced to its text",
+			err:          status.Error(codes.Unavailable, "<!DOCTYPE html><html><head><title>503 Service Temporarily Unavailable</title><style>body{color:red}</style></head><body><center><h1>503 Service Temporarily Unavailable</h1></center><hr><center>nginx</center></body></html>"),
+			expectedCode: codes.Unavailable,
+			expectedMsg:  "503 Service Temporarily Unavailable nginx",
+		},
+		{
+			name:         "title is kept when the body doesn't repeat it",
+			err:          status.Error(codes.Internal, "<html><head><title>Gateway Timeout</title></head><body><p>upstream request timeout &amp; retry later</p></body></html>"),
+			expectedCode: codes.Internal,
+			expectedMsg:  "Gateway Timeout: upstream request timeout & retry later",
+		},
+		{
+			name:         "client errors keep their code",
+			err:          status.Error(codes.Unknown, "unexpected HTTP status code received from server: 413 (Request Entity Too Large)"),
+			expectedCode: codes.Unknown,
+			expectedMsg:  "unexpected HTTP status code received from server: 413 (Request Entity Too Large)",
+		},
+		{
+			name:         "api errors are untouched",
+			err:          status.Error(codes.InvalidArgument, "partition count must be positive"),
+			expectedCode: codes.InvalidArgument,
+			expectedMsg:  "partition count must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st := status.Convert(classifyGatewayError(tc.err))
+			if st.Code() != tc.expectedCode {
+				t.Errorf("Expected code %v, got %v", tc.expectedCode, st.Code())
+			}
+			if st.Message() != tc.expectedMsg {
+				t.Errorf("Expected message %q, got %q", tc.expectedMsg, st.Message())
+			}
+		})
+	}
+}
+
+func TestClassifyGatewayErrorKeepsDetails(t *testing.T) {
+	st, err := status.New(codes.Unknown, "<html><head><title>Bad Gateway</title></head><body>upstream connect error</body></html>").
+		WithDetails(&errdetails.RequestInfo{RequestId: "req-123"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := status.Convert(classifyGatewayError(st.Err()))
+	if got.Message() != "Bad Gateway: upstream connect error" {
+		t.Errorf("Unexpected message %q", got.Message())
+	}
+	details := got.Details()
+	if len(details) != 1 {
+		t.Fatalf("Expected the details to be kept, got %v", details)
+	}
+	if info, ok := details[0].(*errdetails.RequestInfo); !ok || info.GetRequestId() != "req-123" {
+		t.Errorf("Unexpected details %v", details)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		limit    int
+		expected string
+	}{
+		{msg: "short", limit: 10, expected: "short"},
+		{msg: "exactly", limit: 7, expected: "exactly"},
+		{msg: "too long", limit: 3, expected: "too..."},
+		{msg: "Zeitüberschreitung", limit: 5, expected: "Zeitü..."},
+		{msg: "日本語のエラー", limit: 3, expected: "日本語..."},
+	}
+	for _, tc := range testCases {
+		got := truncate(tc.msg, tc.limit)
+		if got != tc.expected {
+			t.Errorf("truncate(%q, %d): expected %q, got %q", tc.msg, tc.limit, tc.expected, got)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("truncate(%q, %d) returned invalid UTF-8 %q", tc.msg, tc.limit, got)
+		}
+	}
+}
+
+func TestClassifyGatewayErrorNonStatus(t *testing.T) {
+	err := errors.New("boom")
+	if got := classifyGatewayError(err); got != err {
+		t.Errorf("Expected the error to be returned as is, got %v", got)
+	}
+	if got := classifyGatewayError(nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
+
+func TestClassifyAuthError(t *testing.T) {
+	st := status.Convert(classifyAuthError(status.Error(codes.Unauthenticated, "invalid token")))
+	if st.Code() != codes.Unauthenticated {
+		t.Errorf("Expected code %v, got %v", codes.Unauthenticated, st.Code())
+	}
+	if want := credentialsRejectedMsg + ": invalid token"; st.Message() != want {
+		t.Errorf("Expected message %q, got %q", want, st.Message())
+	}
+
+	err := status.Error(codes.PermissionDenied, "missing permission")
+	if got := classifyAuthError(err); got != err {
+		t.Errorf("Expected the error to be returned as is, got %v", got)
+	}
+	if got := classifyAuthError(nil); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
+
+func TestAuthErrorInterceptor(t *testing.T) {
+	interceptor := authErrorInterceptor(t.Name())
+	var callErr error
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		return callErr
+	}
+	call := func() error {
+		return interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	}
+
+	callErr = status.Error(codes.Unauthenticated, "invalid token")
+	if got := call(); got != callErr {
+		t.Errorf("Expected the error of a token never accepted to be returned as is, got %v", got)
+	}
+
+	callErr = nil
+	if got := call(); got != nil {
+		t.Fatalf("Expected nil, got %v", got)
+	}
+
+	callErr = status.Error(codes.Unauthenticated, "invalid token")
+	if want := credentialsRejectedMsg + ": invalid token"; status.Convert(call()).Message() != want {
+		t.Errorf("Expected the error of a token accepted earlier to be explained as %q", want)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"sync"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	lookupResourceGroup = "resource_group"
+	lookupNetwork       = "network"
+)
+
+type lookupKey struct {
+	kind string
+	name string
+}
+
+// lookupCache holds the resource groups and networks found by name, so that
+// the data sources and resources resolving the same name in one plan or apply
+// share a single List call. Writes through the client set invalidate the
+// entries of their kind, since they may rename or remove them.
+type lookupCache struct {
+	mu      sync.Mutex
+	entries map[lookupKey]proto.Message
+}
+
+// lookupCaches maps each control plane connection to its cache. The provider
+// opens one connection per run of Terraform, which bounds the cache to a
+// single plan or apply.
+var lookupCaches sync.Map
+
+func lookupCacheFor(conn *grpc.ClientConn) *lookupCache {
+	c, _ := lookupCaches.LoadOrStore(conn, &lookupCache{entries: map[lookupKey]proto.Message{}})
+	return c.(*lookupCache)
+}
+
+// lookup returns the cached message for the key, or calls fetch and caches
+// its result if it succeeds. A nil cache always calls fetch.
+func lookup[T proto.Message](c *lookupCache, kind, name string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+	key := lookupKey{kind: kind, name: name}
+	c.mu.Lock()
+	m, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		// callers may modify the message they get
+		return proto.Clone(m).(T), nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	c.mu.Lock()
+	c.entries[key] = proto.Clone(v)
+	c.mu.Unlock()
+	return v, nil
+}
+
+// invalidate removes the cached entries of the kind.
+func (c *lookupCache) invalidate(kind string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.entries {
+		if k.kind == kind {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// resourceGroupClient invalidates the cached resource groups on writes.
+type resourceGroupClient struct {
+	controlplanev1beta2grpc.ResourceGroupServiceClient
+	cache *lookupCache
+}
+
+func (c resourceGroupClient) CreateResourceGroup(ctx context.Context, in *controlplanev1beta2.CreateResourceGroupRequest, opts ...grpc.CallOption) (*controlplanev1beta2.CreateResourceGroupResponse, error) {
+	defer c.cache.invalidate(lookupResourceGroup)
+	return c.ResourceGroupServiceClient.CreateResourceGroup(ctx, in, opts...)
+}
+
+func (c resourceGroupClient) UpdateResourceGroup(ctx context.Context, in *controlplanev1beta2.UpdateResourceGroupRequest, opts ...grpc.CallOption) (*controlplanev1beta2.UpdateResourceGroupResponse, error) {
+	defer c.cache.invalidate(lookupResourceGroup)
+	return c.ResourceGroupServiceClient.UpdateResourceGroup(ctx, in, opts...)
+}
+
+func (c resourceGroupClient) DeleteResourceGroup(ctx context.Context, in *controlplanev1beta2.DeleteResourceGroupRequest, opts ...grpc.CallOption) (*controlplanev1beta2.DeleteResourceGroupResponse, error) {
+	defer c.cache.invalidate(lookupResourceGroup)
+	return c.ResourceGroupServiceClient.DeleteResourceGroup(ctx, in, opts...)
+}
+
+// networkClient invalidates the cached networks on writes.
+type networkClient struct {
+	controlplanev1beta2grpc.NetworkServiceClient
+	cache *lookupCache
+}
+
+func (c networkClient) CreateNetwork(ctx context.Context, in *controlplanev1beta2.CreateNetworkRequest, opts ...grpc.CallOption) (*controlplanev1beta2.CreateNetworkOperation, error) {
+	defer c.cache.invalidate(lookupNetwork)
+	return c.NetworkServiceClient.CreateNetwork(ctx, in, opts...)
+}
+
+func (c networkClient) DeleteNetwork(ctx context.Context, in *controlplanev1beta2.DeleteNetworkRequest, opts ...grpc.CallOption) (*controlplanev1beta2.DeleteNetworkOperation, error) {
+	defer c.cache.invalidate(lookupNetwork)
+	return c.NetworkServiceClient.DeleteNetwork(ctx, in, opts...)
+}
@@ -0,0 +1,89 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestResourceGroupForNameCache(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	rgClient := mocks.NewMockResourceGroupServiceClient(ctrl)
+	cache := &lookupCache{entries: map[lookupKey]proto.Message{}}
+	cpCl := &ControlPlaneClientSet{
+		ResourceGroup: resourceGroupClient{rgClient, cache},
+		cache:         cache,
+	}
+
+	list := func(context.Context, *controlplanev1beta2.ListResourceGroupsRequest, ...grpc.CallOption) (*controlplanev1beta2.ListResourceGroupsResponse, error) {
+		return &controlplanev1beta2.ListResourceGroupsResponse{
+			ResourceGroups: []*controlplanev1beta2.ResourceGroup{{Id: "rg-1", Name: "default"}},
+		}, nil
+	}
+	rgClient.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).DoAndReturn(list).Times(2)
+	rgClient.EXPECT().DeleteResourceGroup(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.DeleteResourceGroupResponse{}, nil)
+
+	rg, err := cpCl.ResourceGroupForName(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "rg-1", rg.GetId())
+
+	// served from the cache, and not shared with the first caller
+	rg.Name = "changed"
+	rg, err = cpCl.ResourceGroupForName(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "default", rg.GetName())
+
+	_, err = cpCl.ResourceGroup.DeleteResourceGroup(ctx, &controlplanev1beta2.DeleteResourceGroupRequest{Id: "rg-2"})
+	require.NoError(t, err)
+	_, err = cpCl.ResourceGroupForName(ctx, "default")
+	require.NoError(t, err)
+}
+
+func TestResourceGroupForNameCacheSkipsErrors(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	rgClient := mocks.NewMockResourceGroupServiceClient(ctrl)
+	cpCl := &ControlPlaneClientSet{
+		ResourceGroup: rgClient,
+		cache:         &lookupCache{entries: map[lookupKey]proto.Message{}},
+	}
+
+	gomock.InOrder(
+		rgClient.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{
+			ResourceGroups: []*controlplanev1beta2.ResourceGroup{},
+		}, nil),
+		rgClient.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{
+			ResourceGroups: []*controlplanev1beta2.ResourceGroup{{Id: "rg-1", Name: "default"}},
+		}, nil),
+	)
+
+	_, err := cpCl.ResourceGroupForName(ctx, "default")
+	require.Error(t, err)
+	rg, err := cpCl.ResourceGroupForName(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "rg-1", rg.GetId())
+}
+
+func TestLookupCacheNil(t *testing.T) {
+	calls := 0
+	fetch := func() (*controlplanev1beta2.Network, error) {
+		calls++
+		return &controlplanev1beta2.Network{Id: "net-1"}, nil
+	}
+	var c *lookupCache
+	for range 2 {
+		n, err := lookup(c, lookupNetwork, "net", fetch)
+		require.NoError(t, err)
+		assert.Equal(t, "net-1", n.GetId())
+	}
+	c.invalidate(lookupNetwork)
+	assert.Equal(t, 2, calls)
+}
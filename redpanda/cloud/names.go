@@ -0,0 +1,95 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+)
+
+type namedObject interface {
+	GetId() string
+	GetName() string
+}
+
+// idsForName pages through list and returns the IDs of the objects named
+// name. list returns a page of objects and the token of the next page.
+func idsForName[T namedObject](name string, list func(pageToken string) ([]T, string, error)) ([]string, error) {
+	ids := []string{}
+	pageToken := ""
+	for {
+		objects, next, err := list(pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range objects {
+			if o.GetName() == name {
+				ids = append(ids, o.GetId())
+			}
+		}
+		if next == "" || len(objects) == 0 {
+			return ids, nil
+		}
+		pageToken = next
+	}
+}
+
+// ClusterIDsForName returns the IDs of the clusters named name. Unlike
+// ClusterForName, it reports every match and no match is not an error.
+func (cpCl *ControlPlaneClientSet) ClusterIDsForName(ctx context.Context, name string) ([]string, error) {
+	return idsForName(name, func(pageToken string) ([]*controlplanev1beta2.Cluster, string, error) {
+		resp, err := cpCl.Cluster.ListClusters(ctx, &controlplanev1beta2.ListClustersRequest{
+			Filter:    &controlplanev1beta2.ListClustersRequest_Filter{Name: name},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to list clusters: %v", err)
+		}
+		return resp.GetClusters(), resp.GetNextPageToken(), nil
+	})
+}
+
+// NetworkIDsForName returns the IDs of the networks named name, bypassing the
+// cache of NetworkForName.
+func (cpCl *ControlPlaneClientSet) NetworkIDsForName(ctx context.Context, name string) ([]string, error) {
+	return idsForName(name, func(pageToken string) ([]*controlplanev1beta2.Network, string, error) {
+		resp, err := cpCl.Network.ListNetworks(ctx, &controlplanev1beta2.ListNetworksRequest{
+			Filter:    &controlplanev1beta2.ListNetworksRequest_Filter{Name: name},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to list networks: %v", err)
+		}
+		return resp.GetNetworks(), resp.GetNextPageToken(), nil
+	})
+}
+
+// ResourceGroupIDsForName returns the IDs of the resource groups named name,
+// bypassing the cache of ResourceGroupForName.
+func (cpCl *ControlPlaneClientSet) ResourceGroupIDsForName(ctx context.Context, name string) ([]string, error) {
+	return idsForName(name, func(pageToken string) ([]*controlplanev1beta2.ResourceGroup, string, error) {
+		resp, err := cpCl.ResourceGroup.ListResourceGroups(ctx, &controlplanev1beta2.ListResourceGroupsRequest{
+			Filter:    &controlplanev1beta2.ListResourceGroupsRequest_Filter{Name: name},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to list resource groups: %v", err)
+		}
+		return resp.GetResourceGroups(), resp.GetNextPageToken(), nil
+	})
+}
@@ -0,0 +1,49 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestClusterIDsForName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockClusterServiceClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().ListClusters(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListClustersRequest, _ ...grpc.CallOption) (*controlplanev1beta2.ListClustersResponse, error) {
+			assert.Equal(t, "prod", req.GetFilter().GetName())
+			return &controlplanev1beta2.ListClustersResponse{
+				Clusters:      []*controlplanev1beta2.Cluster{{Id: "cl-1", Name: "prod"}, {Id: "cl-2", Name: "prod-eu"}},
+				NextPageToken: "next",
+			}, nil
+		}),
+		client.EXPECT().ListClusters(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListClustersRequest, _ ...grpc.CallOption) (*controlplanev1beta2.ListClustersResponse, error) {
+			assert.Equal(t, "next", req.GetPageToken())
+			return &controlplanev1beta2.ListClustersResponse{
+				Clusters: []*controlplanev1beta2.Cluster{{Id: "cl-3", Name: "prod"}},
+			}, nil
+		}),
+	)
+	cpCl := &ControlPlaneClientSet{Cluster: client}
+
+	ids, err := cpCl.ClusterIDsForName(context.Background(), "prod")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cl-1", "cl-3"}, ids)
+}
+
+func TestResourceGroupIDsForNameNoMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockResourceGroupServiceClient(ctrl)
+	client.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{}, nil)
+	cpCl := &ControlPlaneClientSet{ResourceGroup: client}
+
+	ids, err := cpCl.ResourceGroupIDsForName(context.Background(), "default")
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
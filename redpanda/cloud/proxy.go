@@ -0,0 +1,132 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// Proxy is an HTTP CONNECT proxy used to reach the Redpanda Cloud APIs, with
+// optional basic authentication.
+type Proxy struct {
+	url *url.URL
+}
+
+// NewProxy parses the proxy URL. Credentials can be given either as the URL
+// userinfo or with username and password, the latter taking precedence.
+func NewProxy(rawURL, username, password string) (*Proxy, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse proxy URL: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported proxy URL scheme %q, must be http or https", u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("proxy URL %q has no host", rawURL)
+	}
+	if username != "" {
+		u.User = url.UserPassword(username, password)
+	}
+	return &Proxy{url: u}, nil
+}
+
+// HTTPClient returns an HTTP client going through the proxy. It returns the
+// default client for a nil Proxy.
+func (p *Proxy) HTTPClient() *http.Client {
+	if p == nil {
+		return http.DefaultClient
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(p.url)
+	return &http.Client{Transport: transport}
+}
+
+// proxyAuthorization returns the value of the Proxy-Authorization header, or
+// an empty string if the proxy doesn't require authentication.
+func (p *Proxy) proxyAuthorization() string {
+	if p.url.User == nil {
+		return ""
+	}
+	password, _ := p.url.User.Password()
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(p.url.User.Username()+":"+password))
+}
+
+// dial opens a tunnel to addr through the proxy.
+func (p *Proxy) dial(ctx context.Context, addr string) (net.Conn, error) {
+	proxyAddr := p.url.Host
+	if p.url.Port() == "" {
+		port := "80"
+		if p.url.Scheme == "https" {
+			port = "443"
+		}
+		proxyAddr = net.JoinHostPort(p.url.Hostname(), port)
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to proxy %v: %v", proxyAddr, err)
+	}
+	if p.url.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: p.url.Hostname(), MinVersion: tls.VersionTLS12})
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if auth := p.proxyAuthorization(); auth != "" {
+		req.Header.Set("Proxy-Authorization", auth)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to send CONNECT request to proxy %v: %v", proxyAddr, err)
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("unable to read CONNECT response from proxy %v: %v", proxyAddr, err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %v refused to connect to %v: %v", proxyAddr, addr, resp.Status)
+	}
+	if r.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: r}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn keeps the bytes read past the CONNECT response.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
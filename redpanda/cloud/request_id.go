@@ -0,0 +1,76 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// requestIDKeys are the metadata keys that may hold the ID the API assigned
+// to a request, in order of preference.
+var requestIDKeys = []string{"x-request-id", "request-id", "x-correlation-id"}
+
+// requestIDInterceptor adds the ID of the failed request to the error message,
+// so that it shows in diagnostics and can be quoted to Redpanda support. The
+// ID is taken from the RequestInfo error detail, or from the response headers
+// and trailers. The code and details of the error are kept.
+func requestIDInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header, trailer metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header), grpc.Trailer(&trailer))...)
+	return withRequestID(err, header, trailer)
+}
+
+func withRequestID(err error, mds ...metadata.MD) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	id := requestID(st, mds...)
+	if id == "" || strings.Contains(st.Message(), id) {
+		return err
+	}
+	p := st.Proto()
+	p.Message = fmt.Sprintf("%s (request ID: %s)", p.GetMessage(), id)
+	return status.FromProto(p).Err()
+}
+
+// requestID returns the ID of the request that failed with st, or an empty
+// string if the API did not return one.
+func requestID(st *status.Status, mds ...metadata.MD) string {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.RequestInfo); ok && info.GetRequestId() != "" {
+			return info.GetRequestId()
+		}
+	}
+	for _, key := range requestIDKeys {
+		for _, md := range mds {
+			if v := md.Get(key); len(v) > 0 && v[0] != "" {
+				return v[0]
+			}
+		}
+	}
+	return ""
+}
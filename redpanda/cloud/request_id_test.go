@@ -0,0 +1,72 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestWithRequestID(t *testing.T) {
+	withInfo, err := status.New(codes.Internal, "boom").WithDetails(&errdetails.RequestInfo{RequestId: "req-detail"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	throttled, err := status.New(codes.ResourceExhausted, "slow down").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		err     error
+		header  metadata.MD
+		trailer metadata.MD
+		wantMsg string
+	}{
+		{name: "nil", err: nil},
+		{name: "not a status", err: errors.New("dial failed"), header: metadata.Pairs("x-request-id", "req-1"), wantMsg: "dial failed"},
+		{name: "no request ID", err: status.Error(codes.NotFound, "cluster not found"), wantMsg: "cluster not found"},
+		{name: "header", err: status.Error(codes.NotFound, "cluster not found"), header: metadata.Pairs("x-request-id", "req-1"), wantMsg: "cluster not found (request ID: req-1)"},
+		{name: "trailer", err: status.Error(codes.NotFound, "cluster not found"), trailer: metadata.Pairs("request-id", "req-2"), wantMsg: "cluster not found (request ID: req-2)"},
+		{name: "error detail first", err: withInfo.Err(), header: metadata.Pairs("x-request-id", "req-1"), wantMsg: "boom (request ID: req-detail)"},
+		{name: "already in message", err: status.Error(codes.Internal, "request req-1 failed"), header: metadata.Pairs("x-request-id", "req-1"), wantMsg: "request req-1 failed"},
+		{name: "details are kept", err: throttled.Err(), header: metadata.Pairs("x-request-id", "req-3"), wantMsg: "slow down (request ID: req-3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := withRequestID(tt.err, tt.header, tt.trailer)
+			if tt.err == nil {
+				assert.NoError(t, got)
+				return
+			}
+			if st, ok := status.FromError(got); ok {
+				assert.Equal(t, tt.wantMsg, st.Message())
+				assert.Equal(t, status.Code(tt.err), st.Code())
+				assert.Len(t, st.Details(), len(status.Convert(tt.err).Details()))
+			} else {
+				assert.Equal(t, tt.wantMsg, got.Error())
+			}
+		})
+	}
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		for _, o := range opts {
+			if tr, ok := o.(grpc.TrailerCallOption); ok {
+				*tr.TrailerAddr = metadata.Pairs("x-request-id", "req-1")
+			}
+		}
+		return status.Error(codes.Unavailable, "try again")
+	}
+	err := requestIDInterceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, "try again (request ID: req-1)", status.Convert(err).Message())
+}
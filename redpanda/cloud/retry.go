@@ -0,0 +1,90 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"time"
+
+	grpcretry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retryBaseBackoff is the delay before the first retry of a failed call,
+// doubled on every further attempt.
+const retryBaseBackoff = 100 * time.Millisecond
+
+// RetryPolicy configures how calls failing with a transient error, such as
+// Unavailable or DeadlineExceeded, are retried.
+type RetryPolicy struct {
+	// Max is the number of times a call is retried, 0 to never retry.
+	Max uint
+	// MaxBackoff caps the exponential delay between two attempts.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is the RetryPolicy of the connections opened with
+// SpawnConn: five attempts in total.
+var DefaultRetryPolicy = RetryPolicy{Max: 4, MaxBackoff: 10 * time.Second}
+
+// backoff returns the delay before the given retry, starting at 1.
+func (p RetryPolicy) backoff(attempt uint) time.Duration {
+	d := grpcretry.BackoffExponentialWithJitter(retryBaseBackoff, 0.1)(attempt)
+	if p.MaxBackoff > 0 && (d > p.MaxBackoff || d <= 0) {
+		return p.MaxBackoff
+	}
+	return d
+}
+
+// retryableCodes are the codes of the transient errors retried by a
+// RetryPolicy.
+var retryableCodes = map[codes.Code]bool{
+	codes.Unavailable:      true,
+	codes.DeadlineExceeded: true,
+	codes.Unknown:          true,
+	codes.Internal:         true,
+}
+
+// interceptor returns the interceptor retrying the calls that failed with a
+// transient error according to the policy. Calls whose context is done are
+// not retried.
+func (p RetryPolicy) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for attempt := uint(1); ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt > p.Max || ctx.Err() != nil || !retryableCodes[status.Code(err)] {
+				return err
+			}
+			delay := p.backoff(attempt)
+			tflog.Debug(ctx, "Redpanda API call failed with a transient error, retrying", map[string]any{
+				"method":  method,
+				"error":   err,
+				"delay":   delay,
+				"attempt": attempt,
+			})
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{Max: 10, MaxBackoff: time.Second}
+	assert.InDelta(t, float64(retryBaseBackoff), float64(p.backoff(1)), float64(retryBaseBackoff)/10)
+	assert.InDelta(t, float64(4*retryBaseBackoff), float64(p.backoff(3)), float64(4*retryBaseBackoff)/10)
+	assert.Equal(t, time.Second, p.backoff(8))
+	assert.Equal(t, time.Second, p.backoff(100))
+}
+
+func TestRetryPolicyInterceptor(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection reset")
+	tests := []struct {
+		name      string
+		policy    RetryPolicy
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "transient errors retried",
+			policy:    RetryPolicy{Max: 3, MaxBackoff: time.Millisecond},
+			errs:      []error{unavailable, status.Error(codes.DeadlineExceeded, "timeout"), nil},
+			wantCalls: 3,
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "gives up after max retries",
+			policy:    RetryPolicy{Max: 2, MaxBackoff: time.Millisecond},
+			errs:      []error{unavailable, unavailable, unavailable},
+			wantCalls: 3,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "retries disabled",
+			policy:    RetryPolicy{MaxBackoff: time.Millisecond},
+			errs:      []error{unavailable},
+			wantCalls: 1,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "other errors not retried",
+			policy:    RetryPolicy{Max: 3, MaxBackoff: time.Millisecond},
+			errs:      []error{status.Error(codes.InvalidArgument, "bad request")},
+			wantCalls: 1,
+			wantCode:  codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				err := tt.errs[calls]
+				calls++
+				return err
+			}
+			err := tt.policy.interceptor()(context.Background(), "/test", nil, nil, nil, invoker)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxThrottleRetries is the number of times a throttled call is retried.
+	maxThrottleRetries = 5
+	// maxThrottleDelay caps the delay requested by the API before retrying a
+	// throttled call.
+	maxThrottleDelay = time.Minute
+)
+
+// RetryDelay returns the delay the API asked to wait before retrying a call
+// that failed with err: the delay of the RetryInfo details of a
+// ResourceExhausted error. It returns false when the API gave no hint.
+// Unavailable errors are retried by the RetryPolicy of the connection instead.
+func RetryDelay(err error) (time.Duration, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return 0, false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return capThrottleDelay(info.GetRetryDelay().AsDuration()), true
+		}
+	}
+	return 0, false
+}
+
+// retryAfter returns the delay of a Retry-After header given in seconds.
+func retryAfter(md metadata.MD) (time.Duration, bool) {
+	v := md.Get("retry-after")
+	if len(v) == 0 {
+		return 0, false
+	}
+	secs, err := strconv.Atoi(strings.TrimSpace(v[0]))
+	if err != nil || secs < 0 {
+		return 0, false
+	}
+	return capThrottleDelay(time.Duration(secs) * time.Second), true
+}
+
+func capThrottleDelay(d time.Duration) time.Duration {
+	if d > maxThrottleDelay {
+		return maxThrottleDelay
+	}
+	return d
+}
+
+// throttleInterceptor retries the calls throttled by the API with
+// ResourceExhausted, after waiting for the delay given by the error details
+// or by the Retry-After header. Throttled calls without any hint are not
+// retried.
+func throttleInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	for attempt := 0; ; attempt++ {
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		if status.Code(err) != codes.ResourceExhausted || attempt == maxThrottleRetries {
+			return err
+		}
+		delay, ok := RetryDelay(err)
+		if !ok {
+			if delay, ok = retryAfter(header); !ok {
+				return err
+			}
+		}
+		tflog.Warn(ctx, "Redpanda API call throttled, retrying", map[string]any{
+			"method":  method,
+			"delay":   delay,
+			"attempt": attempt + 1,
+		})
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package cloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func throttledError(t *testing.T, delay time.Duration) error {
+	st, err := status.New(codes.ResourceExhausted, "too many requests").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
+
+func unavailableError(t *testing.T, delay time.Duration) error {
+	st, err := status.New(codes.Unavailable, "try again later").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		{name: "retry info", err: throttledError(t, 2*time.Second), wantDelay: 2 * time.Second, wantOK: true},
+		{name: "capped", err: throttledError(t, time.Hour), wantDelay: maxThrottleDelay, wantOK: true},
+		{name: "no details", err: status.Error(codes.ResourceExhausted, "too many requests")},
+		{name: "other code", err: status.Error(codes.NotFound, "not found")},
+		{name: "unavailable", err: unavailableError(t, 2*time.Second)},
+		{name: "nil", err: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := RetryDelay(tt.err)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
+
+func TestThrottleInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		errs      []error
+		header    metadata.MD
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "retried after the requested delay",
+			errs:      []error{throttledError(t, time.Millisecond), throttledError(t, time.Millisecond), nil},
+			wantCalls: 3,
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "retried after retry-after header",
+			errs:      []error{status.Error(codes.ResourceExhausted, "slow down"), nil},
+			header:    metadata.Pairs("retry-after", "0"),
+			wantCalls: 2,
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "not retried without hint",
+			errs:      []error{status.Error(codes.ResourceExhausted, "quota exceeded")},
+			wantCalls: 1,
+			wantCode:  codes.ResourceExhausted,
+		},
+		{
+			name:      "other errors not retried",
+			errs:      []error{status.Error(codes.NotFound, "not found")},
+			wantCalls: 1,
+			wantCode:  codes.NotFound,
+		},
+		{
+			name: "gives up after max retries",
+			errs: []error{
+				throttledError(t, 0), throttledError(t, 0), throttledError(t, 0),
+				throttledError(t, 0), throttledError(t, 0), throttledError(t, 0),
+			},
+			wantCalls: maxThrottleRetries + 1,
+			wantCode:  codes.ResourceExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+				for _, o := range opts {
+					if h, ok := o.(grpc.HeaderCallOption); ok && tt.header != nil {
+						*h.HeaderAddr = tt.header
+					}
+				}
+				err := tt.errs[calls]
+				calls++
+				return err
+			}
+			err := throttleInterceptor(context.Background(), "/test", nil, nil, nil, invoker)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
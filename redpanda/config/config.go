@@ -18,6 +18,11 @@
 package config
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"google.golang.org/grpc"
 )
@@ -28,6 +33,90 @@ type Resource struct {
 	AuthToken              string
 	ByocClient             *utils.ByocClient
 	ControlPlaneConnection *grpc.ClientConn
+	// Proxy is the proxy used to open dataplane connections, nil if none.
+	Proxy *cloud.Proxy
+	// DefaultTags are the tags placed on every cluster, overridden by the
+	// tags of the cluster.
+	DefaultTags map[string]string
+	// Timeouts scales the time resources wait for long-running operations.
+	Timeouts Timeouts
+	// Polling configures the logs and the stall detection of the polls of
+	// long-running operations.
+	Polling Polling
+	// Registry records the objects planned by the resources, nil if none.
+	Registry *Registry
+	// PreventDuplicateNames fails the plans creating a cluster, network or
+	// resource group under a name already in use.
+	PreventDuplicateNames bool
+}
+
+// Timeouts scales the default timeouts of long-running operations, for
+// regions where provisioning is consistently slower.
+type Timeouts struct {
+	// Multiplier is applied to every default timeout. Values of 0 or less
+	// leave the defaults unchanged.
+	Multiplier float64
+}
+
+// Scale returns the default timeout d scaled by the multiplier.
+func (t Timeouts) Scale(d time.Duration) time.Duration {
+	if t.Multiplier <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * t.Multiplier)
+}
+
+// Polling configures the logs written while polling long-running operations,
+// and how long an operation can go unchanged before it is deemed stuck.
+type Polling struct {
+	// Verbose logs every poll at INFO instead of DEBUG.
+	Verbose bool
+	// StallWarning is how long an operation can go unchanged before a
+	// warning is added. 0 disables the warning.
+	StallWarning time.Duration
+	// StallTimeout is how long an operation can go unchanged before polling
+	// fails. 0 disables the failure.
+	StallTimeout time.Duration
+}
+
+// Context returns the context to poll an operation on the resource named
+// target with, and the watchdog to pass to utils.WarnStalledOperations once
+// polling is over.
+func (p Polling) Context(ctx context.Context, target string) (context.Context, *utils.OperationWatchdog) {
+	watchdog := &utils.OperationWatchdog{Warn: p.StallWarning, Fail: p.StallTimeout}
+	ctx = utils.WithOperationWatchdog(ctx, watchdog)
+	if p.Verbose {
+		ctx = utils.WithVerbosePolling(ctx, target)
+	}
+	return ctx, watchdog
+}
+
+// Registry records the objects planned by the resources of a configuration,
+// so that an object declared by more than one resource is reported at plan
+// time instead of failing to be created twice.
+type Registry struct {
+	mu      sync.Mutex
+	claimed map[string]struct{}
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{claimed: map[string]struct{}{}}
+}
+
+// Claim records that key is planned by a resource, and returns false if
+// another resource already planned it. A nil Registry claims every key.
+func (r *Registry) Claim(key string) bool {
+	if r == nil {
+		return true
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.claimed[key]; ok {
+		return false
+	}
+	r.claimed[key] = struct{}{}
+	return true
 }
 
 // Datasource is the config used to pass data and dependencies to data source
@@ -35,6 +124,8 @@ type Resource struct {
 type Datasource struct {
 	AuthToken              string
 	ControlPlaneConnection *grpc.ClientConn
+	// Proxy is the proxy used to open dataplane connections, nil if none.
+	Proxy *cloud.Proxy
 }
 
 // TODO add cloud provider and region as values to persist
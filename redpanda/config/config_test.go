@@ -0,0 +1,54 @@
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutsScale(t *testing.T) {
+	assert.Equal(t, 90*time.Minute, Timeouts{}.Scale(90*time.Minute))
+	assert.Equal(t, 15*time.Minute, Timeouts{Multiplier: 1}.Scale(15*time.Minute))
+	assert.Equal(t, 180*time.Minute, Timeouts{Multiplier: 2}.Scale(90*time.Minute))
+	assert.Equal(t, 90*time.Second, Timeouts{Multiplier: 1.5}.Scale(time.Minute))
+}
+
+func TestPollingContext(t *testing.T) {
+	ctx := context.Background()
+	quiet, watchdog := Polling{StallWarning: 20 * time.Minute}.Context(ctx, "my-cluster")
+	assert.Equal(t, 20*time.Minute, watchdog.Warn)
+	assert.Zero(t, watchdog.Fail)
+	verbose, _ := Polling{Verbose: true}.Context(ctx, "my-cluster")
+	assert.NotEqual(t, quiet, verbose)
+}
+
+func TestRegistryClaim(t *testing.T) {
+	r := NewRegistry()
+	assert.True(t, r.Claim("a"))
+	assert.True(t, r.Claim("b"))
+	assert.False(t, r.Claim("a"))
+
+	var none *Registry
+	assert.True(t, none.Claim("a"))
+	assert.True(t, none.Claim("a"))
+}
+
+func TestResourceData(t *testing.T) {
+	var diags diag.Diagnostics
+	_, ok := ResourceData(nil, &diags)
+	assert.False(t, ok)
+	assert.False(t, diags.HasError())
+
+	p, ok := ResourceData(Resource{AuthToken: "token"}, &diags)
+	assert.True(t, ok)
+	assert.Equal(t, "token", p.AuthToken)
+
+	_, ok = ResourceData(Datasource{}, &diags)
+	assert.False(t, ok)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "Unexpected Resource Configure Type", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "Expected config.Resource, got: config.Datasource")
+}
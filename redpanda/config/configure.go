@@ -0,0 +1,52 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ResourceData returns the provider data given to the Configure method of a
+// resource. It returns false when the resource can't be configured: the
+// provider data is not set during the early part of the Terraform lifecycle,
+// which is valid, and data of an unexpected type is reported in diags.
+func ResourceData(providerData any, diags *diag.Diagnostics) (Resource, bool) {
+	return configureData[Resource](providerData, "Resource", diags)
+}
+
+// DatasourceData returns the provider data given to the Configure method of a
+// data source, like ResourceData.
+func DatasourceData(providerData any, diags *diag.Diagnostics) (Datasource, bool) {
+	return configureData[Datasource](providerData, "Data Source", diags)
+}
+
+func configureData[T any](data any, kind string, diags *diag.Diagnostics) (T, bool) {
+	var zero T
+	if data == nil {
+		return zero, false
+	}
+	p, ok := data.(T)
+	if !ok {
+		diags.AddError(
+			fmt.Sprintf("Unexpected %s Configure Type", kind),
+			fmt.Sprintf("Expected %T, got: %T. Please report this issue to the provider developers.", zero, data),
+		)
+		return zero, false
+	}
+	return p, true
+}
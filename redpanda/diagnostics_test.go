@@ -0,0 +1,187 @@
+package redpanda
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// diagnosticsFuncs are the framework functions and methods that return
+// diag.Diagnostics as their last result.
+var diagnosticsFuncs = map[string]bool{
+	"ListValue":       true,
+	"ListValueFrom":   true,
+	"MapValue":        true,
+	"MapValueFrom":    true,
+	"SetValue":        true,
+	"SetValueFrom":    true,
+	"ObjectValue":     true,
+	"ObjectValueFrom": true,
+	"ElementsAs":      true,
+	"GetAttribute":    true,
+	"SetAttribute":    true,
+	"GetKey":          true,
+	"SetKey":          true,
+}
+
+// tfsdkReceivers are the fields holding a tfsdk.State, tfsdk.Plan,
+// tfsdk.Config or tfsdk.ResourceIdentity, whose Get and Set methods return
+// diag.Diagnostics.
+var tfsdkReceivers = map[string]bool{
+	"State":    true,
+	"Plan":     true,
+	"Config":   true,
+	"Identity": true,
+}
+
+func returnsDiagnostics(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	if diagnosticsFuncs[sel.Sel.Name] {
+		return true
+	}
+	if sel.Sel.Name != "Get" && sel.Sel.Name != "Set" {
+		return false
+	}
+	recv, ok := sel.X.(*ast.SelectorExpr)
+	return ok && tfsdkReceivers[recv.Sel.Name]
+}
+
+// usesDiagnostics reports whether the diagnostics held by name are passed
+// on, either as the argument of a call such as Append or by being returned.
+func usesDiagnostics(body *ast.BlockStmt, name string) bool {
+	used := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		var exprs []ast.Expr
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			exprs = n.Args
+		case *ast.ReturnStmt:
+			exprs = n.Results
+		}
+		for _, e := range exprs {
+			if id, ok := e.(*ast.Ident); ok && id.Name == name {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
+
+// discardedDiagnostics returns the position of each call in the file whose
+// diagnostics are dropped instead of being added to a response.
+func discardedDiagnostics(fset *token.FileSet, file *ast.File) []string {
+	var found []string
+	report := func(n ast.Node, msg string) {
+		found = append(found, fmt.Sprintf("%s: %s", fset.Position(n.Pos()), msg))
+	}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.ExprStmt:
+				if returnsDiagnostics(n.X) {
+					report(n, "diagnostics are discarded")
+				}
+			case *ast.AssignStmt:
+				if len(n.Rhs) != 1 || !returnsDiagnostics(n.Rhs[0]) {
+					return true
+				}
+				id, ok := n.Lhs[len(n.Lhs)-1].(*ast.Ident)
+				if !ok {
+					return true
+				}
+				if id.Name == "_" {
+					report(n, "diagnostics are assigned to _")
+				} else if !usesDiagnostics(fn.Body, id.Name) {
+					report(n, fmt.Sprintf("%s is never appended to the diagnostics or returned", id.Name))
+				}
+			}
+			return true
+		})
+	}
+	return found
+}
+
+func TestDiscardedDiagnosticsChecker(t *testing.T) {
+	src := `package p
+
+func discarded() {
+	resp.State.Set(ctx, model)
+	_, _ = types.ListValueFrom(ctx, types.StringType, v)
+}
+
+func checkedOnly() {
+	m, diags := types.MapValue(types.StringType, v)
+	if diags.HasError() {
+		return
+	}
+}
+
+func appended() {
+	m, diags := types.MapValue(types.StringType, v)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func returned() (types.List, diag.Diagnostics) {
+	l, d := types.ListValueFrom(ctx, types.StringType, v)
+	return l, d
+}
+`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{
+		"p.go:4:2: diagnostics are discarded",
+		"p.go:5:2: diagnostics are assigned to _",
+		"p.go:9:2: diags is never appended to the diagnostics or returned",
+	}, discardedDiagnostics(fset, file))
+}
+
+// TestNoDiscardedDiagnostics fails when non-test code drops the diagnostics
+// of a framework conversion, so that errors always reach the user.
+func TestNoDiscardedDiagnostics(t *testing.T) {
+	fset := token.NewFileSet()
+	var found []string
+	err := filepath.WalkDir(".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && d.Name() == "mocks" {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		found = append(found, discardedDiagnostics(fset, file)...)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, found)
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package functions contains the implementation of the provider defined
+// functions following the Terraform framework interfaces.
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &TopicConfigFromJSON{}
+	_ function.Function = &TopicConfigToJSON{}
+)
+
+// TopicConfigFromJSON converts a JSON document holding topic configurations
+// into a map usable as the configuration of a redpanda_topic.
+type TopicConfigFromJSON struct{}
+
+// Metadata returns the name of the function.
+func (*TopicConfigFromJSON) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "topic_config_from_json"
+}
+
+// Definition returns the signature of the function.
+func (*TopicConfigFromJSON) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse topic configurations from JSON",
+		MarkdownDescription: "Converts a JSON document holding topic configurations into a map that can be used as the " +
+			"`configuration` of a `redpanda_topic`. The document is either an object of configuration names to values, " +
+			"e.g. `{\"cleanup.policy\": \"compact\"}`, or a list of objects with `name` and `value` keys as dumped by " +
+			"Kafka tooling. Number and boolean values are converted to strings and null values are skipped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "JSON document holding the topic configurations.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+// Run parses the JSON document.
+func (*TopicConfigFromJSON) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+	cfg, err := parseTopicConfigJSON([]byte(input))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cfg))
+}
+
+// TopicConfigToJSON converts the configuration of a redpanda_topic into a JSON
+// object, the reverse of TopicConfigFromJSON.
+type TopicConfigToJSON struct{}
+
+// Metadata returns the name of the function.
+func (*TopicConfigToJSON) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "topic_config_to_json"
+}
+
+// Definition returns the signature of the function.
+func (*TopicConfigToJSON) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Encode topic configurations as JSON",
+		MarkdownDescription: "Converts a map of topic configurations, such as the `configuration` of a `redpanda_topic`, " +
+			"into a JSON object of configuration names to values, with the names sorted.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "configuration",
+				MarkdownDescription: "Map of topic configuration names to values.",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the configuration.
+func (*TopicConfigToJSON) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cfg map[string]string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cfg))
+	if resp.Error != nil {
+		return
+	}
+	// encoding/json sorts map keys, so the output is stable across runs
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("unable to encode the topic configuration: %v", err))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(b)))
+}
+
+// parseTopicConfigJSON accepts either an object of configuration names to
+// values or a list of {"name": ..., "value": ...} objects.
+func parseTopicConfigJSON(b []byte) (map[string]string, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var entries []struct {
+			Name  string          `json:"name"`
+			Value json.RawMessage `json:"value"`
+		}
+		if err := json.Unmarshal(b, &entries); err != nil {
+			return nil, fmt.Errorf("unable to parse the topic configuration list: %v", err)
+		}
+		cfg := make(map[string]string, len(entries))
+		for i, e := range entries {
+			if e.Name == "" {
+				return nil, fmt.Errorf("entry %d of the topic configuration list has no name", i)
+			}
+			v, ok, err := configValue(e.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %q: %v", e.Name, err)
+			}
+			if ok {
+				cfg[e.Name] = v
+			}
+		}
+		return cfg, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("unable to parse the topic configuration object: %v", err)
+	}
+	cfg := make(map[string]string, len(raw))
+	for name, value := range raw {
+		v, ok, err := configValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %q: %v", name, err)
+		}
+		if ok {
+			cfg[name] = v
+		}
+	}
+	return cfg, nil
+}
+
+// configValue returns the string form of a JSON scalar, and false for null.
+func configValue(raw json.RawMessage) (string, bool, error) {
+	var v any
+	d := json.NewDecoder(bytes.NewReader(raw))
+	d.UseNumber()
+	if len(raw) > 0 {
+		if err := d.Decode(&v); err != nil {
+			return "", false, err
+		}
+	}
+	switch v := v.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case json.Number:
+		return v.String(), true, nil
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	default:
+		return "", false, fmt.Errorf("expected a string, number or boolean, got %T", v)
+	}
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTopicConfigJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:  "object",
+			input: `{"cleanup.policy": "compact", "retention.ms": 86400000, "unclean.leader.election.enable": false, "segment.bytes": null}`,
+			want:  map[string]string{"cleanup.policy": "compact", "retention.ms": "86400000", "unclean.leader.election.enable": "false"},
+		},
+		{
+			name:  "list of name and value",
+			input: `[{"name": "cleanup.policy", "value": "delete"}, {"name": "max.message.bytes", "value": "1048576"}]`,
+			want:  map[string]string{"cleanup.policy": "delete", "max.message.bytes": "1048576"},
+		},
+		{
+			name:  "large numbers are kept as is",
+			input: `{"retention.bytes": 9223372036854775807}`,
+			want:  map[string]string{"retention.bytes": "9223372036854775807"},
+		},
+		{name: "nested value", input: `{"cleanup.policy": {"a": "b"}}`, wantErr: `invalid value for "cleanup.policy"`},
+		{name: "missing name", input: `[{"value": "delete"}]`, wantErr: "has no name"},
+		{name: "invalid json", input: `{`, wantErr: "unable to parse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTopicConfigJSON([]byte(tt.input))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTopicConfigJSONRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	fromResp := &function.RunResponse{Result: function.NewResultData(types.MapUnknown(types.StringType))}
+	(&TopicConfigFromJSON{}).Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`{"retention.ms": 1000, "cleanup.policy": "compact"}`)}),
+	}, fromResp)
+	require.Nil(t, fromResp.Error)
+	cfg, ok := fromResp.Result.Value().(types.Map)
+	require.True(t, ok)
+	assert.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"retention.ms":   types.StringValue("1000"),
+		"cleanup.policy": types.StringValue("compact"),
+	}), cfg)
+
+	toResp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	(&TopicConfigToJSON{}).Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{cfg}),
+	}, toResp)
+	require.Nil(t, toResp.Error)
+	assert.Equal(t, types.StringValue(`{"cleanup.policy":"compact","retention.ms":"1000"}`), toResp.Result.Value())
+}
+
+func TestTopicConfigFromJSONInvalidArgument(t *testing.T) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.MapUnknown(types.StringType))}
+	(&TopicConfigFromJSON{}).Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`"compact"`)}),
+	}, resp)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &DurationMs{}
+	_ function.Function = &SizeBytes{}
+)
+
+// DurationMs converts a human-readable duration into milliseconds, the unit of
+// the topic configurations ending in .ms.
+type DurationMs struct{}
+
+// Metadata returns the name of the function.
+func (*DurationMs) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_ms"
+}
+
+// Definition returns the signature of the function.
+func (*DurationMs) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a duration to milliseconds",
+		MarkdownDescription: "Converts a human-readable duration such as `45m`, `2h30m` or `7d` into a number of milliseconds, " +
+			"e.g. for the `retention.ms` topic configuration. On top of `ms`, `s`, `m` and `h`, the units `d` for days and " +
+			"`w` for weeks are accepted. The result is always the same number for the same duration, so that writing it " +
+			"differently does not show a diff.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "Duration to convert.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run converts the duration.
+func (*DurationMs) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+	d, err := utils.ParseDuration(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, d.Milliseconds()))
+}
+
+// SizeBytes converts a human-readable size into bytes, the unit of the topic
+// configurations ending in .bytes.
+type SizeBytes struct{}
+
+// Metadata returns the name of the function.
+func (*SizeBytes) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "size_bytes"
+}
+
+// Definition returns the signature of the function.
+func (*SizeBytes) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a size to bytes",
+		MarkdownDescription: "Converts a human-readable size such as `512MiB` or `1GB` into a number of bytes, e.g. for the " +
+			"`retention.bytes` topic configuration. `KB`, `MB`, `GB` and `TB` are powers of 1000 and `KiB`, `MiB`, `GiB` " +
+			"and `TiB` powers of 1024; units are case insensitive and a number without unit is a number of bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "size",
+				MarkdownDescription: "Size to convert.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run converts the size.
+func (*SizeBytes) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+	n, err := utils.ParseSize(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, n))
+}
@@ -0,0 +1,37 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationMs(t *testing.T) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	(&DurationMs{}).Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("7d")}),
+	}, resp)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.Int64Value(604800000), resp.Result.Value())
+}
+
+func TestSizeBytes(t *testing.T) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	(&SizeBytes{}).Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("512MiB")}),
+	}, resp)
+	require.Nil(t, resp.Error)
+	assert.Equal(t, types.Int64Value(536870912), resp.Result.Value())
+
+	resp = &function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+	(&SizeBytes{}).Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("512 potatoes")}),
+	}, resp)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, int64(0), *resp.Error.FunctionArgument)
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package mocks
+
+import (
+	"fmt"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+)
+
+// DataplaneMocks groups the mocked clients of every dataplane service.
+type DataplaneMocks struct {
+	Topic     *MockTopicServiceClient
+	User      *MockUserServiceClient
+	ACL       *MockACLServiceClient
+	Secret    *MockSecretServiceClient
+	Transform *MockTransformServiceClient
+}
+
+// NewDataplaneMocks returns mocked clients for every dataplane service.
+func NewDataplaneMocks(ctrl *gomock.Controller) *DataplaneMocks {
+	return &DataplaneMocks{
+		Topic:     NewMockTopicServiceClient(ctrl),
+		User:      NewMockUserServiceClient(ctrl),
+		ACL:       NewMockACLServiceClient(ctrl),
+		Secret:    NewMockSecretServiceClient(ctrl),
+		Transform: NewMockTransformServiceClient(ctrl),
+	}
+}
+
+// Topic returns a listed topic with the given partition count and a
+// replication factor of 3.
+func Topic(name string, partitions int32) *dataplanev1alpha2.ListTopicsResponse_Topic {
+	return &dataplanev1alpha2.ListTopicsResponse_Topic{
+		Name:              name,
+		PartitionCount:    partitions,
+		ReplicationFactor: 3,
+	}
+}
+
+// TopicConfigurations returns a configuration response holding n dynamic
+// topic configurations named config.0 to config.n-1, with values value-0 to
+// value-n-1.
+func TopicConfigurations(n int) *dataplanev1alpha2.GetTopicConfigurationsResponse {
+	resp := &dataplanev1alpha2.GetTopicConfigurationsResponse{}
+	for i := range n {
+		v := fmt.Sprintf("value-%d", i)
+		resp.Configurations = append(resp.Configurations, &dataplanev1alpha2.Topic_Configuration{
+			Name:   fmt.Sprintf("config.%d", i),
+			Value:  &v,
+			Source: dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG,
+		})
+	}
+	return resp
+}
+
+// ExpectListTopics makes the mock return the topics split in pages of
+// pageSize elements, each page linked to the next one by its page token.
+func ExpectListTopics(m *MockTopicServiceClient, pageSize int, topics ...*dataplanev1alpha2.ListTopicsResponse_Topic) {
+	for _, p := range paginate(topics, pageSize) {
+		m.EXPECT().ListTopics(gomock.Any(), listTopicsPage{token: p.token}).Return(&dataplanev1alpha2.ListTopicsResponse{
+			Topics:        p.items,
+			NextPageToken: p.next,
+		}, nil)
+	}
+}
+
+// ExpectListUsers makes the mock return users with the given names split in
+// pages of pageSize elements.
+func ExpectListUsers(m *MockUserServiceClient, pageSize int, names ...string) {
+	users := make([]*dataplanev1alpha2.ListUsersResponse_User, 0, len(names))
+	for _, n := range names {
+		users = append(users, &dataplanev1alpha2.ListUsersResponse_User{Name: n})
+	}
+	for _, p := range paginate(users, pageSize) {
+		m.EXPECT().ListUsers(gomock.Any(), listUsersPage{token: p.token}).Return(&dataplanev1alpha2.ListUsersResponse{
+			Users:         p.items,
+			NextPageToken: p.next,
+		}, nil)
+	}
+}
+
+type page[T any] struct {
+	token string
+	next  string
+	items []T
+}
+
+// paginate splits items in pages of size elements. It always returns at
+// least one page so that an empty list is still answered.
+func paginate[T any](items []T, size int) []page[T] {
+	if size <= 0 {
+		size = len(items)
+	}
+	pages := []page[T]{{}}
+	for i, item := range items {
+		if i > 0 && i%size == 0 {
+			next := fmt.Sprintf("page-%d", len(pages))
+			pages[len(pages)-1].next = next
+			pages = append(pages, page[T]{token: next})
+		}
+		pages[len(pages)-1].items = append(pages[len(pages)-1].items, item)
+	}
+	return pages
+}
+
+// listTopicsPage matches a ListTopicsRequest asking for the given page.
+type listTopicsPage struct{ token string }
+
+func (m listTopicsPage) Matches(x any) bool {
+	req, ok := x.(*dataplanev1alpha2.ListTopicsRequest)
+	return ok && req.GetPageToken() == m.token
+}
+
+func (m listTopicsPage) String() string {
+	return fmt.Sprintf("ListTopicsRequest with page token %q", m.token)
+}
+
+// listUsersPage matches a ListUsersRequest asking for the given page.
+type listUsersPage struct{ token string }
+
+func (m listUsersPage) Matches(x any) bool {
+	req, ok := x.(*dataplanev1alpha2.ListUsersRequest)
+	return ok && req.GetPageToken() == m.token
+}
+
+func (m listUsersPage) String() string {
+	return fmt.Sprintf("ListUsersRequest with page token %q", m.token)
+}
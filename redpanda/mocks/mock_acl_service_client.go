@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc (interfaces: ACLServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockACLServiceClient is a mock of ACLServiceClient interface.
+type MockACLServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockACLServiceClientMockRecorder
+}
+
+// MockACLServiceClientMockRecorder is the mock recorder for MockACLServiceClient.
+type MockACLServiceClientMockRecorder struct {
+	mock *MockACLServiceClient
+}
+
+// NewMockACLServiceClient creates a new mock instance.
+func NewMockACLServiceClient(ctrl *gomock.Controller) *MockACLServiceClient {
+	mock := &MockACLServiceClient{ctrl: ctrl}
+	mock.recorder = &MockACLServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockACLServiceClient) EXPECT() *MockACLServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateACL mocks base method.
+func (m *MockACLServiceClient) CreateACL(arg0 context.Context, arg1 *dataplanev1alpha2.CreateACLRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.CreateACLResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateACL", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.CreateACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateACL indicates an expected call of CreateACL.
+func (mr *MockACLServiceClientMockRecorder) CreateACL(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateACL", reflect.TypeOf((*MockACLServiceClient)(nil).CreateACL), varargs...)
+}
+
+// DeleteACLs mocks base method.
+func (m *MockACLServiceClient) DeleteACLs(arg0 context.Context, arg1 *dataplanev1alpha2.DeleteACLsRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.DeleteACLsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteACLs", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.DeleteACLsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteACLs indicates an expected call of DeleteACLs.
+func (mr *MockACLServiceClientMockRecorder) DeleteACLs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteACLs", reflect.TypeOf((*MockACLServiceClient)(nil).DeleteACLs), varargs...)
+}
+
+// ListACLs mocks base method.
+func (m *MockACLServiceClient) ListACLs(arg0 context.Context, arg1 *dataplanev1alpha2.ListACLsRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.ListACLsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListACLs", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.ListACLsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListACLs indicates an expected call of ListACLs.
+func (mr *MockACLServiceClientMockRecorder) ListACLs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListACLs", reflect.TypeOf((*MockACLServiceClient)(nil).ListACLs), varargs...)
+}
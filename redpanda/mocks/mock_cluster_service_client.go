@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc (interfaces: ClusterServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockClusterServiceClient is a mock of ClusterServiceClient interface.
+type MockClusterServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockClusterServiceClientMockRecorder
+}
+
+// MockClusterServiceClientMockRecorder is the mock recorder for MockClusterServiceClient.
+type MockClusterServiceClientMockRecorder struct {
+	mock *MockClusterServiceClient
+}
+
+// NewMockClusterServiceClient creates a new mock instance.
+func NewMockClusterServiceClient(ctrl *gomock.Controller) *MockClusterServiceClient {
+	mock := &MockClusterServiceClient{ctrl: ctrl}
+	mock.recorder = &MockClusterServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockClusterServiceClient) EXPECT() *MockClusterServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateCluster mocks base method.
+func (m *MockClusterServiceClient) CreateCluster(arg0 context.Context, arg1 *controlplanev1beta2.CreateClusterRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.CreateClusterOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCluster", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.CreateClusterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCluster indicates an expected call of CreateCluster.
+func (mr *MockClusterServiceClientMockRecorder) CreateCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCluster", reflect.TypeOf((*MockClusterServiceClient)(nil).CreateCluster), varargs...)
+}
+
+// DeleteCluster mocks base method.
+func (m *MockClusterServiceClient) DeleteCluster(arg0 context.Context, arg1 *controlplanev1beta2.DeleteClusterRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.DeleteClusterOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCluster", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.DeleteClusterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCluster indicates an expected call of DeleteCluster.
+func (mr *MockClusterServiceClientMockRecorder) DeleteCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterServiceClient)(nil).DeleteCluster), varargs...)
+}
+
+// GetCluster mocks base method.
+func (m *MockClusterServiceClient) GetCluster(arg0 context.Context, arg1 *controlplanev1beta2.GetClusterRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.GetClusterResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCluster", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.GetClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCluster indicates an expected call of GetCluster.
+func (mr *MockClusterServiceClientMockRecorder) GetCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockClusterServiceClient)(nil).GetCluster), varargs...)
+}
+
+// ListClusters mocks base method.
+func (m *MockClusterServiceClient) ListClusters(arg0 context.Context, arg1 *controlplanev1beta2.ListClustersRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusters indicates an expected call of ListClusters.
+func (mr *MockClusterServiceClientMockRecorder) ListClusters(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterServiceClient)(nil).ListClusters), varargs...)
+}
+
+// UpdateCluster mocks base method.
+func (m *MockClusterServiceClient) UpdateCluster(arg0 context.Context, arg1 *controlplanev1beta2.UpdateClusterRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.UpdateClusterOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCluster", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.UpdateClusterOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCluster indicates an expected call of UpdateCluster.
+func (mr *MockClusterServiceClientMockRecorder) UpdateCluster(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterServiceClient)(nil).UpdateCluster), varargs...)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/ui/v1alpha1/uiv1alpha1grpc (interfaces: NetworkPeeringServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uiv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/ui/v1alpha1"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockNetworkPeeringServiceClient is a mock of NetworkPeeringServiceClient interface.
+type MockNetworkPeeringServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkPeeringServiceClientMockRecorder
+}
+
+// MockNetworkPeeringServiceClientMockRecorder is the mock recorder for MockNetworkPeeringServiceClient.
+type MockNetworkPeeringServiceClientMockRecorder struct {
+	mock *MockNetworkPeeringServiceClient
+}
+
+// NewMockNetworkPeeringServiceClient creates a new mock instance.
+func NewMockNetworkPeeringServiceClient(ctrl *gomock.Controller) *MockNetworkPeeringServiceClient {
+	mock := &MockNetworkPeeringServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNetworkPeeringServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkPeeringServiceClient) EXPECT() *MockNetworkPeeringServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetNetworkPeeringInstructions mocks base method.
+func (m *MockNetworkPeeringServiceClient) GetNetworkPeeringInstructions(arg0 context.Context, arg1 *uiv1alpha1.GetNetworkPeeringInstructionsRequest, arg2 ...grpc.CallOption) (*uiv1alpha1.GetNetworkPeeringInstructionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkPeeringInstructions", varargs...)
+	ret0, _ := ret[0].(*uiv1alpha1.GetNetworkPeeringInstructionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkPeeringInstructions indicates an expected call of GetNetworkPeeringInstructions.
+func (mr *MockNetworkPeeringServiceClientMockRecorder) GetNetworkPeeringInstructions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkPeeringInstructions", reflect.TypeOf((*MockNetworkPeeringServiceClient)(nil).GetNetworkPeeringInstructions), varargs...)
+}
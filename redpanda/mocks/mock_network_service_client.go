@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc (interfaces: NetworkServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockNetworkServiceClient is a mock of NetworkServiceClient interface.
+type MockNetworkServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNetworkServiceClientMockRecorder
+}
+
+// MockNetworkServiceClientMockRecorder is the mock recorder for MockNetworkServiceClient.
+type MockNetworkServiceClientMockRecorder struct {
+	mock *MockNetworkServiceClient
+}
+
+// NewMockNetworkServiceClient creates a new mock instance.
+func NewMockNetworkServiceClient(ctrl *gomock.Controller) *MockNetworkServiceClient {
+	mock := &MockNetworkServiceClient{ctrl: ctrl}
+	mock.recorder = &MockNetworkServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNetworkServiceClient) EXPECT() *MockNetworkServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateNetwork mocks base method.
+func (m *MockNetworkServiceClient) CreateNetwork(arg0 context.Context, arg1 *controlplanev1beta2.CreateNetworkRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.CreateNetworkOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateNetwork", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.CreateNetworkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetwork indicates an expected call of CreateNetwork.
+func (mr *MockNetworkServiceClientMockRecorder) CreateNetwork(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNetworkServiceClient)(nil).CreateNetwork), varargs...)
+}
+
+// DeleteNetwork mocks base method.
+func (m *MockNetworkServiceClient) DeleteNetwork(arg0 context.Context, arg1 *controlplanev1beta2.DeleteNetworkRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.DeleteNetworkOperation, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteNetwork", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.DeleteNetworkOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetwork indicates an expected call of DeleteNetwork.
+func (mr *MockNetworkServiceClientMockRecorder) DeleteNetwork(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworkServiceClient)(nil).DeleteNetwork), varargs...)
+}
+
+// DummyCreateMetadata mocks base method.
+func (m *MockNetworkServiceClient) DummyCreateMetadata(arg0 context.Context, arg1 *controlplanev1beta2.CreateNetworkRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.CreateNetworkMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DummyCreateMetadata", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.CreateNetworkMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DummyCreateMetadata indicates an expected call of DummyCreateMetadata.
+func (mr *MockNetworkServiceClientMockRecorder) DummyCreateMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyCreateMetadata", reflect.TypeOf((*MockNetworkServiceClient)(nil).DummyCreateMetadata), varargs...)
+}
+
+// DummyDeleteMetadata mocks base method.
+func (m *MockNetworkServiceClient) DummyDeleteMetadata(arg0 context.Context, arg1 *controlplanev1beta2.DeleteNetworkRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.DeleteNetworkMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DummyDeleteMetadata", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.DeleteNetworkMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DummyDeleteMetadata indicates an expected call of DummyDeleteMetadata.
+func (mr *MockNetworkServiceClientMockRecorder) DummyDeleteMetadata(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyDeleteMetadata", reflect.TypeOf((*MockNetworkServiceClient)(nil).DummyDeleteMetadata), varargs...)
+}
+
+// GetNetwork mocks base method.
+func (m *MockNetworkServiceClient) GetNetwork(arg0 context.Context, arg1 *controlplanev1beta2.GetNetworkRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.GetNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetwork", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.GetNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetwork indicates an expected call of GetNetwork.
+func (mr *MockNetworkServiceClientMockRecorder) GetNetwork(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetwork", reflect.TypeOf((*MockNetworkServiceClient)(nil).GetNetwork), varargs...)
+}
+
+// ListNetworks mocks base method.
+func (m *MockNetworkServiceClient) ListNetworks(arg0 context.Context, arg1 *controlplanev1beta2.ListNetworksRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.ListNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworks", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.ListNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworks indicates an expected call of ListNetworks.
+func (mr *MockNetworkServiceClientMockRecorder) ListNetworks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockNetworkServiceClient)(nil).ListNetworks), varargs...)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc (interfaces: RedpandaVersionServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockRedpandaVersionServiceClient is a mock of RedpandaVersionServiceClient interface.
+type MockRedpandaVersionServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRedpandaVersionServiceClientMockRecorder
+}
+
+// MockRedpandaVersionServiceClientMockRecorder is the mock recorder for MockRedpandaVersionServiceClient.
+type MockRedpandaVersionServiceClientMockRecorder struct {
+	mock *MockRedpandaVersionServiceClient
+}
+
+// NewMockRedpandaVersionServiceClient creates a new mock instance.
+func NewMockRedpandaVersionServiceClient(ctrl *gomock.Controller) *MockRedpandaVersionServiceClient {
+	mock := &MockRedpandaVersionServiceClient{ctrl: ctrl}
+	mock.recorder = &MockRedpandaVersionServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRedpandaVersionServiceClient) EXPECT() *MockRedpandaVersionServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListRedpandaVersions mocks base method.
+func (m *MockRedpandaVersionServiceClient) ListRedpandaVersions(arg0 context.Context, arg1 *controlplanev1beta2.ListRedpandaVersionsRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.ListRedpandaVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRedpandaVersions", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.ListRedpandaVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRedpandaVersions indicates an expected call of ListRedpandaVersions.
+func (mr *MockRedpandaVersionServiceClientMockRecorder) ListRedpandaVersions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRedpandaVersions", reflect.TypeOf((*MockRedpandaVersionServiceClient)(nil).ListRedpandaVersions), varargs...)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc (interfaces: RegionServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockRegionServiceClient is a mock of RegionServiceClient interface.
+type MockRegionServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegionServiceClientMockRecorder
+}
+
+// MockRegionServiceClientMockRecorder is the mock recorder for MockRegionServiceClient.
+type MockRegionServiceClientMockRecorder struct {
+	mock *MockRegionServiceClient
+}
+
+// NewMockRegionServiceClient creates a new mock instance.
+func NewMockRegionServiceClient(ctrl *gomock.Controller) *MockRegionServiceClient {
+	mock := &MockRegionServiceClient{ctrl: ctrl}
+	mock.recorder = &MockRegionServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegionServiceClient) EXPECT() *MockRegionServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetRegion mocks base method.
+func (m *MockRegionServiceClient) GetRegion(arg0 context.Context, arg1 *controlplanev1beta2.GetRegionRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.GetRegionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegion", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.GetRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegion indicates an expected call of GetRegion.
+func (mr *MockRegionServiceClientMockRecorder) GetRegion(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegion", reflect.TypeOf((*MockRegionServiceClient)(nil).GetRegion), varargs...)
+}
+
+// ListRegions mocks base method.
+func (m *MockRegionServiceClient) ListRegions(arg0 context.Context, arg1 *controlplanev1beta2.ListRegionsRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.ListRegionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegions", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.ListRegionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegions indicates an expected call of ListRegions.
+func (mr *MockRegionServiceClientMockRecorder) ListRegions(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockRegionServiceClient)(nil).ListRegions), varargs...)
+}
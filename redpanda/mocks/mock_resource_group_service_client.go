@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc (interfaces: ResourceGroupServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockResourceGroupServiceClient is a mock of ResourceGroupServiceClient interface.
+type MockResourceGroupServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceGroupServiceClientMockRecorder
+}
+
+// MockResourceGroupServiceClientMockRecorder is the mock recorder for MockResourceGroupServiceClient.
+type MockResourceGroupServiceClientMockRecorder struct {
+	mock *MockResourceGroupServiceClient
+}
+
+// NewMockResourceGroupServiceClient creates a new mock instance.
+func NewMockResourceGroupServiceClient(ctrl *gomock.Controller) *MockResourceGroupServiceClient {
+	mock := &MockResourceGroupServiceClient{ctrl: ctrl}
+	mock.recorder = &MockResourceGroupServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceGroupServiceClient) EXPECT() *MockResourceGroupServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateResourceGroup mocks base method.
+func (m *MockResourceGroupServiceClient) CreateResourceGroup(arg0 context.Context, arg1 *controlplanev1beta2.CreateResourceGroupRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.CreateResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateResourceGroup", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.CreateResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceGroup indicates an expected call of CreateResourceGroup.
+func (mr *MockResourceGroupServiceClientMockRecorder) CreateResourceGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceGroup", reflect.TypeOf((*MockResourceGroupServiceClient)(nil).CreateResourceGroup), varargs...)
+}
+
+// DeleteResourceGroup mocks base method.
+func (m *MockResourceGroupServiceClient) DeleteResourceGroup(arg0 context.Context, arg1 *controlplanev1beta2.DeleteResourceGroupRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.DeleteResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourceGroup", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.DeleteResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceGroup indicates an expected call of DeleteResourceGroup.
+func (mr *MockResourceGroupServiceClientMockRecorder) DeleteResourceGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceGroup", reflect.TypeOf((*MockResourceGroupServiceClient)(nil).DeleteResourceGroup), varargs...)
+}
+
+// GetResourceGroup mocks base method.
+func (m *MockResourceGroupServiceClient) GetResourceGroup(arg0 context.Context, arg1 *controlplanev1beta2.GetResourceGroupRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.GetResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceGroup", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.GetResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceGroup indicates an expected call of GetResourceGroup.
+func (mr *MockResourceGroupServiceClientMockRecorder) GetResourceGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceGroup", reflect.TypeOf((*MockResourceGroupServiceClient)(nil).GetResourceGroup), varargs...)
+}
+
+// ListResourceGroups mocks base method.
+func (m *MockResourceGroupServiceClient) ListResourceGroups(arg0 context.Context, arg1 *controlplanev1beta2.ListResourceGroupsRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.ListResourceGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceGroups", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.ListResourceGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceGroups indicates an expected call of ListResourceGroups.
+func (mr *MockResourceGroupServiceClientMockRecorder) ListResourceGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceGroups", reflect.TypeOf((*MockResourceGroupServiceClient)(nil).ListResourceGroups), varargs...)
+}
+
+// UpdateResourceGroup mocks base method.
+func (m *MockResourceGroupServiceClient) UpdateResourceGroup(arg0 context.Context, arg1 *controlplanev1beta2.UpdateResourceGroupRequest, arg2 ...grpc.CallOption) (*controlplanev1beta2.UpdateResourceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceGroup", varargs...)
+	ret0, _ := ret[0].(*controlplanev1beta2.UpdateResourceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceGroup indicates an expected call of UpdateResourceGroup.
+func (mr *MockResourceGroupServiceClientMockRecorder) UpdateResourceGroup(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceGroup", reflect.TypeOf((*MockResourceGroupServiceClient)(nil).UpdateResourceGroup), varargs...)
+}
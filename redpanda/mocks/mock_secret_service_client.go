@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc (interfaces: SecretServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockSecretServiceClient is a mock of SecretServiceClient interface.
+type MockSecretServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceClientMockRecorder
+}
+
+// MockSecretServiceClientMockRecorder is the mock recorder for MockSecretServiceClient.
+type MockSecretServiceClientMockRecorder struct {
+	mock *MockSecretServiceClient
+}
+
+// NewMockSecretServiceClient creates a new mock instance.
+func NewMockSecretServiceClient(ctrl *gomock.Controller) *MockSecretServiceClient {
+	mock := &MockSecretServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretServiceClient) EXPECT() *MockSecretServiceClientMockRecorder {
+	return m.recorder
+}
+
+// CreateConnectSecret mocks base method.
+func (m *MockSecretServiceClient) CreateConnectSecret(arg0 context.Context, arg1 *dataplanev1alpha2.CreateConnectSecretRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.CreateConnectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateConnectSecret", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.CreateConnectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConnectSecret indicates an expected call of CreateConnectSecret.
+func (mr *MockSecretServiceClientMockRecorder) CreateConnectSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConnectSecret", reflect.TypeOf((*MockSecretServiceClient)(nil).CreateConnectSecret), varargs...)
+}
+
+// DeleteConnectSecret mocks base method.
+func (m *MockSecretServiceClient) DeleteConnectSecret(arg0 context.Context, arg1 *dataplanev1alpha2.DeleteConnectSecretRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.DeleteConnectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteConnectSecret", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.DeleteConnectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConnectSecret indicates an expected call of DeleteConnectSecret.
+func (mr *MockSecretServiceClientMockRecorder) DeleteConnectSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConnectSecret", reflect.TypeOf((*MockSecretServiceClient)(nil).DeleteConnectSecret), varargs...)
+}
+
+// GetConnectSecret mocks base method.
+func (m *MockSecretServiceClient) GetConnectSecret(arg0 context.Context, arg1 *dataplanev1alpha2.GetConnectSecretRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.GetConnectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConnectSecret", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.GetConnectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConnectSecret indicates an expected call of GetConnectSecret.
+func (mr *MockSecretServiceClientMockRecorder) GetConnectSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnectSecret", reflect.TypeOf((*MockSecretServiceClient)(nil).GetConnectSecret), varargs...)
+}
+
+// ListConnectSecrets mocks base method.
+func (m *MockSecretServiceClient) ListConnectSecrets(arg0 context.Context, arg1 *dataplanev1alpha2.ListConnectSecretsRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.ListConnectSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConnectSecrets", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.ListConnectSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectSecrets indicates an expected call of ListConnectSecrets.
+func (mr *MockSecretServiceClientMockRecorder) ListConnectSecrets(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectSecrets", reflect.TypeOf((*MockSecretServiceClient)(nil).ListConnectSecrets), varargs...)
+}
+
+// UpdateConnectSecret mocks base method.
+func (m *MockSecretServiceClient) UpdateConnectSecret(arg0 context.Context, arg1 *dataplanev1alpha2.UpdateConnectSecretRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.UpdateConnectSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateConnectSecret", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.UpdateConnectSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConnectSecret indicates an expected call of UpdateConnectSecret.
+func (mr *MockSecretServiceClientMockRecorder) UpdateConnectSecret(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectSecret", reflect.TypeOf((*MockSecretServiceClient)(nil).UpdateConnectSecret), varargs...)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc (interfaces: TransformServiceClient)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	gomock "github.com/golang/mock/gomock"
+	grpc "google.golang.org/grpc"
+)
+
+// MockTransformServiceClient is a mock of TransformServiceClient interface.
+type MockTransformServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransformServiceClientMockRecorder
+}
+
+// MockTransformServiceClientMockRecorder is the mock recorder for MockTransformServiceClient.
+type MockTransformServiceClientMockRecorder struct {
+	mock *MockTransformServiceClient
+}
+
+// NewMockTransformServiceClient creates a new mock instance.
+func NewMockTransformServiceClient(ctrl *gomock.Controller) *MockTransformServiceClient {
+	mock := &MockTransformServiceClient{ctrl: ctrl}
+	mock.recorder = &MockTransformServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransformServiceClient) EXPECT() *MockTransformServiceClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteTransform mocks base method.
+func (m *MockTransformServiceClient) DeleteTransform(arg0 context.Context, arg1 *dataplanev1alpha2.DeleteTransformRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.DeleteTransformResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTransform", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.DeleteTransformResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransform indicates an expected call of DeleteTransform.
+func (mr *MockTransformServiceClientMockRecorder) DeleteTransform(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransform", reflect.TypeOf((*MockTransformServiceClient)(nil).DeleteTransform), varargs...)
+}
+
+// GetTransform mocks base method.
+func (m *MockTransformServiceClient) GetTransform(arg0 context.Context, arg1 *dataplanev1alpha2.GetTransformRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.GetTransformResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTransform", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.GetTransformResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransform indicates an expected call of GetTransform.
+func (mr *MockTransformServiceClientMockRecorder) GetTransform(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransform", reflect.TypeOf((*MockTransformServiceClient)(nil).GetTransform), varargs...)
+}
+
+// ListTransforms mocks base method.
+func (m *MockTransformServiceClient) ListTransforms(arg0 context.Context, arg1 *dataplanev1alpha2.ListTransformsRequest, arg2 ...grpc.CallOption) (*dataplanev1alpha2.ListTransformsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTransforms", varargs...)
+	ret0, _ := ret[0].(*dataplanev1alpha2.ListTransformsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransforms indicates an expected call of ListTransforms.
+func (mr *MockTransformServiceClientMockRecorder) ListTransforms(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransforms", reflect.TypeOf((*MockTransformServiceClient)(nil).ListTransforms), varargs...)
+}
@@ -21,8 +21,19 @@ package mocks
 
 //go:generate mockgen -destination=./mock_topic_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc TopicServiceClient
 //go:generate mockgen -destination=./mock_user_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc UserServiceClient
+//go:generate mockgen -destination=./mock_acl_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc ACLServiceClient
+//go:generate mockgen -destination=./mock_secret_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc SecretServiceClient
+//go:generate mockgen -destination=./mock_transform_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc TransformServiceClient
+//go:generate mockgen -destination=./mock_security_service_client.go -package=mocks buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc SecurityServiceClient
 //go:generate mockgen -destination=./mock_operations_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc OperationServiceClient
 //go:generate mockgen -destination=./mock_serverless_cluster_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc ServerlessClusterServiceClient
 //go:generate mockgen -destination=./mock_throughput_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc ThroughputTierServiceClient
+//go:generate mockgen -destination=./mock_cluster_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc ClusterServiceClient
+//go:generate mockgen -destination=./mock_resource_group_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc ResourceGroupServiceClient
+//go:generate mockgen -destination=./mock_network_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc NetworkServiceClient
+//go:generate mockgen -destination=./mock_region_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc RegionServiceClient
+//go:generate mockgen -destination=./mock_redpanda_version_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc RedpandaVersionServiceClient
+//go:generate mockgen -destination=./mock_service_account_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/iam/v1alpha1/iamv1alpha1grpc ServiceAccountServiceClient
+//go:generate mockgen -destination=./mock_network_peering_service_client.go -package=mocks buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/ui/v1alpha1/uiv1alpha1grpc NetworkPeeringServiceClient
 //go:generate mockgen -destination=./mock_cp_client_set.go -package=mocks github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud CpClientSet
 //go:generate mockgen -destination=./mock_throughput_tier_client.go -package=mocks github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils ThroughputTierClient
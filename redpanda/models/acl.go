@@ -29,3 +29,24 @@ type ACL struct {
 	ClusterAPIURL       types.String `tfsdk:"cluster_api_url"`
 	ID                  types.String `tfsdk:"id"`
 }
+
+// ACLs defines the configuration of the ACLs data source.
+type ACLs struct {
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+	Principal     types.String `tfsdk:"principal"`
+	ResourceType  types.String `tfsdk:"resource_type"`
+	ResourceName  types.String `tfsdk:"resource_name"`
+	ACLs          []ACLEntry   `tfsdk:"acls"`
+}
+
+// ACLEntry is an ACL listed by the ACLs data source.
+type ACLEntry struct {
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourcePatternType types.String `tfsdk:"resource_pattern_type"`
+	Principal           types.String `tfsdk:"principal"`
+	Host                types.String `tfsdk:"host"`
+	Operation           types.String `tfsdk:"operation"`
+	PermissionType      types.String `tfsdk:"permission_type"`
+	ID                  types.String `tfsdk:"id"`
+}
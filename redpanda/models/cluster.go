@@ -21,34 +21,81 @@ import (
 
 // Cluster represents the Terraform schema for the cluster resource.
 type Cluster struct {
-	Name                     types.String              `tfsdk:"name"`
-	ID                       types.String              `tfsdk:"id"`
-	ConnectionType           types.String              `tfsdk:"connection_type"`
-	CloudProvider            types.String              `tfsdk:"cloud_provider"`
-	ClusterType              types.String              `tfsdk:"cluster_type"`
-	RedpandaVersion          types.String              `tfsdk:"redpanda_version"`
-	ThroughputTier           types.String              `tfsdk:"throughput_tier"`
-	Region                   types.String              `tfsdk:"region"`
-	Zones                    types.List                `tfsdk:"zones"`
-	AllowDeletion            types.Bool                `tfsdk:"allow_deletion"`
-	Tags                     types.Map                 `tfsdk:"tags"`
-	ResourceGroupID          types.String              `tfsdk:"resource_group_id"`
-	NetworkID                types.String              `tfsdk:"network_id"`
-	ClusterAPIURL            types.String              `tfsdk:"cluster_api_url"`
-	AwsPrivateLink           *AwsPrivateLink           `tfsdk:"aws_private_link"`
-	GcpPrivateServiceConnect *GcpPrivateServiceConnect `tfsdk:"gcp_private_service_connect"`
-	AzurePrivateLink         *AzurePrivateLink         `tfsdk:"azure_private_link"`
-	KafkaAPI                 *KafkaAPI                 `tfsdk:"kafka_api"`
-	HTTPProxy                *HTTPProxy                `tfsdk:"http_proxy"`
-	SchemaRegistry           *SchemaRegistry           `tfsdk:"schema_registry"`
-	ReadReplicaClusterIDs    types.List                `tfsdk:"read_replica_cluster_ids"`
+	Name                     types.String                     `tfsdk:"name"`
+	ID                       types.String                     `tfsdk:"id"`
+	ConnectionType           types.String                     `tfsdk:"connection_type"`
+	CloudProvider            types.String                     `tfsdk:"cloud_provider"`
+	ClusterType              types.String                     `tfsdk:"cluster_type"`
+	RedpandaVersion          types.String                     `tfsdk:"redpanda_version"`
+	ThroughputTier           types.String                     `tfsdk:"throughput_tier"`
+	Region                   types.String                     `tfsdk:"region"`
+	Zones                    types.List                       `tfsdk:"zones"`
+	AllowDeletion            types.Bool                       `tfsdk:"allow_deletion"`
+	Tags                     types.Map                        `tfsdk:"tags"`
+	TagsAll                  types.Map                        `tfsdk:"tags_all"`
+	ResourceGroupID          types.String                     `tfsdk:"resource_group_id"`
+	NetworkID                types.String                     `tfsdk:"network_id"`
+	ClusterAPIURL            types.String                     `tfsdk:"cluster_api_url"`
+	KafkaBootstrapServers    types.List                       `tfsdk:"kafka_bootstrap_servers"`
+	SchemaRegistryURL        types.String                     `tfsdk:"schema_registry_url"`
+	HTTPProxyURL             types.String                     `tfsdk:"http_proxy_url"`
+	Status                   types.String                     `tfsdk:"status"`
+	StatusReasons            types.List                       `tfsdk:"status_reasons"`
+	ByocAgentStatus          types.String                     `tfsdk:"byoc_agent_status"`
+	AwsPrivateLink           *AwsPrivateLink                  `tfsdk:"aws_private_link"`
+	GcpPrivateServiceConnect *GcpPrivateServiceConnect        `tfsdk:"gcp_private_service_connect"`
+	AzurePrivateLink         *AzurePrivateLink                `tfsdk:"azure_private_link"`
+	KafkaAPI                 *KafkaAPI                        `tfsdk:"kafka_api"`
+	HTTPProxy                *HTTPProxy                       `tfsdk:"http_proxy"`
+	SchemaRegistry           *SchemaRegistry                  `tfsdk:"schema_registry"`
+	ReadReplicaClusterIDs    types.List                       `tfsdk:"read_replica_cluster_ids"`
+	IsReadReplicaSource      types.Bool                       `tfsdk:"is_read_replica_source"`
+	Endpoints                *ClusterEndpoints                `tfsdk:"endpoints"`
+	Listeners                *ClusterListeners                `tfsdk:"listeners"`
+	MaintenanceWindowConfig  *MaintenanceWindowConfig         `tfsdk:"maintenance_window_config"`
+	CustomerManagedResources *ClusterCustomerManagedResources `tfsdk:"customer_managed_resources"`
+}
+
+// ClusterEndpoints represents the connection information of a cluster.
+type ClusterEndpoints struct {
+	BootstrapServers           types.List   `tfsdk:"bootstrap_servers"`
+	SchemaRegistryURL          types.String `tfsdk:"schema_registry_url"`
+	HTTPProxyURL               types.String `tfsdk:"http_proxy_url"`
+	ConsoleURL                 types.String `tfsdk:"console_url"`
+	ClusterAPIURL              types.String `tfsdk:"cluster_api_url"`
+	PrometheusURL              types.String `tfsdk:"prometheus_url"`
+	KafkaAPIMtlsRequired       types.Bool   `tfsdk:"kafka_api_mtls_required"`
+	HTTPProxyMtlsRequired      types.Bool   `tfsdk:"http_proxy_mtls_required"`
+	SchemaRegistryMtlsRequired types.Bool   `tfsdk:"schema_registry_mtls_required"`
+}
+
+// ClusterListeners represents the host names and ports clients connect to,
+// for firewall rules.
+type ClusterListeners struct {
+	SeedBrokerCount    types.Int64 `tfsdk:"seed_broker_count"`
+	KafkaAPIHosts      types.List  `tfsdk:"kafka_api_hosts"`
+	KafkaAPIPorts      types.List  `tfsdk:"kafka_api_ports"`
+	HTTPProxyPort      types.Int64 `tfsdk:"http_proxy_port"`
+	SchemaRegistryPort types.Int64 `tfsdk:"schema_registry_port"`
 }
 
 // AwsPrivateLink represents the Terraform schema for the AWS Private Link configuration.
 type AwsPrivateLink struct {
-	Enabled           types.Bool `tfsdk:"enabled"`
-	ConnectConsole    types.Bool `tfsdk:"connect_console"`
-	AllowedPrincipals types.List `tfsdk:"allowed_principals"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	ConnectConsole    types.Bool   `tfsdk:"connect_console"`
+	AllowedPrincipals types.List   `tfsdk:"allowed_principals"`
+	ListenerPorts     types.Object `tfsdk:"listener_ports"`
+}
+
+// AwsPrivateLinkListenerPorts represents the ports Redpanda assigns to each
+// listener of the AWS Private Link Endpoint Service.
+type AwsPrivateLinkListenerPorts struct {
+	KafkaAPISeedPort          types.Int64 `tfsdk:"kafka_api_seed_port"`
+	KafkaAPINodeBasePort      types.Int64 `tfsdk:"kafka_api_node_base_port"`
+	RedpandaProxySeedPort     types.Int64 `tfsdk:"redpanda_proxy_seed_port"`
+	RedpandaProxyNodeBasePort types.Int64 `tfsdk:"redpanda_proxy_node_base_port"`
+	SchemaRegistrySeedPort    types.Int64 `tfsdk:"schema_registry_seed_port"`
+	ConsolePort               types.Int64 `tfsdk:"console_port"`
 }
 
 // GcpPrivateServiceConnect represents the Terraform schema for the GCP Private Service Connect configuration.
@@ -56,6 +103,17 @@ type GcpPrivateServiceConnect struct {
 	Enabled             types.Bool                          `tfsdk:"enabled"`
 	GlobalAccessEnabled types.Bool                          `tfsdk:"global_access_enabled"`
 	ConsumerAcceptList  []*GcpPrivateServiceConnectConsumer `tfsdk:"consumer_accept_list"`
+	ConsumerStatus      types.List                          `tfsdk:"consumer_status"`
+}
+
+// GcpPrivateServiceConnectConsumerStatus represents the status of a connection
+// from a consumer to the GCP Private Service Connect service attachment.
+type GcpPrivateServiceConnectConsumerStatus struct {
+	Source          types.String `tfsdk:"source"`
+	ConnectionID    types.String `tfsdk:"connection_id"`
+	ConsumerNetwork types.String `tfsdk:"consumer_network"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Status          types.String `tfsdk:"status"`
 }
 
 // GcpPrivateServiceConnectConsumer represents the Terraform schema for the GCP Private Service Connect consumer configuration.
@@ -70,6 +128,70 @@ type AzurePrivateLink struct {
 	Enabled              types.Bool `tfsdk:"enabled"`
 }
 
+// MaintenanceWindowConfig represents the Terraform schema for the maintenance
+// window of a cluster. Only one of the attributes is set.
+type MaintenanceWindowConfig struct {
+	DayHour     *MaintenanceWindowDayHour `tfsdk:"day_hour"`
+	Anytime     types.Bool                `tfsdk:"anytime"`
+	Unspecified types.Bool                `tfsdk:"unspecified"`
+}
+
+// MaintenanceWindowDayHour represents a weekly maintenance window.
+type MaintenanceWindowDayHour struct {
+	HourOfDay types.Int64  `tfsdk:"hour_of_day"`
+	DayOfWeek types.String `tfsdk:"day_of_week"`
+}
+
+// ClusterCustomerManagedResources represents the cloud resources that the
+// customer creates for a BYOVPC cluster, of which only the block of the
+// cluster's cloud provider is set.
+type ClusterCustomerManagedResources struct {
+	AWS *ClusterCustomerManagedAWS `tfsdk:"aws"`
+	GCP *ClusterCustomerManagedGCP `tfsdk:"gcp"`
+}
+
+// ClusterCustomerManagedAWS represents the customer-managed resources of a
+// cluster on AWS.
+type ClusterCustomerManagedAWS struct {
+	AgentInstanceProfileArn               types.String `tfsdk:"agent_instance_profile_arn"`
+	ConnectorsNodeGroupInstanceProfileArn types.String `tfsdk:"connectors_node_group_instance_profile_arn"`
+	UtilityNodeGroupInstanceProfileArn    types.String `tfsdk:"utility_node_group_instance_profile_arn"`
+	RedpandaNodeGroupInstanceProfileArn   types.String `tfsdk:"redpanda_node_group_instance_profile_arn"`
+	K8sClusterRoleArn                     types.String `tfsdk:"k8s_cluster_role_arn"`
+	ConsoleSecretsManagerRoleArn          types.String `tfsdk:"console_secrets_manager_role_arn"`
+	ConnectorsSecretsManagerRoleArn       types.String `tfsdk:"connectors_secrets_manager_role_arn"`
+	RedpandaCloudStorageManagerRoleArn    types.String `tfsdk:"redpanda_cloud_storage_manager_role_arn"`
+	RedpandaAgentSecurityGroupArn         types.String `tfsdk:"redpanda_agent_security_group_arn"`
+	ConnectorsSecurityGroupArn            types.String `tfsdk:"connectors_security_group_arn"`
+	RedpandaNodeGroupSecurityGroupArn     types.String `tfsdk:"redpanda_node_group_security_group_arn"`
+	UtilitySecurityGroupArn               types.String `tfsdk:"utility_security_group_arn"`
+	ClusterSecurityGroupArn               types.String `tfsdk:"cluster_security_group_arn"`
+	NodeSecurityGroupArn                  types.String `tfsdk:"node_security_group_arn"`
+	CloudStorageBucketArn                 types.String `tfsdk:"cloud_storage_bucket_arn"`
+}
+
+// ClusterCustomerManagedGCP represents the customer-managed resources of a
+// cluster on GCP.
+type ClusterCustomerManagedGCP struct {
+	Subnet                             *ClusterCustomerManagedGCPSubnet `tfsdk:"subnet"`
+	AgentServiceAccountEmail           types.String                     `tfsdk:"agent_service_account_email"`
+	ConsoleServiceAccountEmail         types.String                     `tfsdk:"console_service_account_email"`
+	ConnectorServiceAccountEmail       types.String                     `tfsdk:"connector_service_account_email"`
+	RedpandaClusterServiceAccountEmail types.String                     `tfsdk:"redpanda_cluster_service_account_email"`
+	GkeServiceAccountEmail             types.String                     `tfsdk:"gke_service_account_email"`
+	TieredStorageBucketName            types.String                     `tfsdk:"tiered_storage_bucket_name"`
+	PscNatSubnetName                   types.String                     `tfsdk:"psc_nat_subnet_name"`
+}
+
+// ClusterCustomerManagedGCPSubnet represents the GCP subnet a BYOVPC cluster
+// is deployed into.
+type ClusterCustomerManagedGCPSubnet struct {
+	Name                           types.String `tfsdk:"name"`
+	SecondaryIPv4RangePodsName     types.String `tfsdk:"secondary_ipv4_range_pods_name"`
+	SecondaryIPv4RangeServicesName types.String `tfsdk:"secondary_ipv4_range_services_name"`
+	K8sMasterIPv4Range             types.String `tfsdk:"k8s_master_ipv4_range"`
+}
+
 // KafkaAPI represents the Terraform schema for the Kafka API configuration.
 type KafkaAPI struct {
 	Mtls *Mtls `tfsdk:"mtls"`
@@ -91,3 +213,55 @@ type Mtls struct {
 	CaCertificatesPem     types.List `tfsdk:"ca_certificates_pem"`
 	PrincipalMappingRules types.List `tfsdk:"principal_mapping_rules"`
 }
+
+// Clusters represents the Terraform model for the Clusters data source.
+type Clusters struct {
+	ResourceGroupID types.String    `tfsdk:"resource_group_id"`
+	NamePrefix      types.String    `tfsdk:"name_prefix"`
+	Clusters        []ClustersEntry `tfsdk:"clusters"`
+}
+
+// ClustersEntry represents a single cluster in a Clusters data source.
+type ClustersEntry struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+	Region        types.String `tfsdk:"region"`
+	ClusterType   types.String `tfsdk:"cluster_type"`
+	NetworkID     types.String `tfsdk:"network_id"`
+	State         types.String `tfsdk:"state"`
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+}
+
+// ClusterStatus represents the Terraform model for the ClusterStatus data
+// source.
+type ClusterStatus struct {
+	ID                 types.String `tfsdk:"id"`
+	State              types.String `tfsdk:"state"`
+	Status             types.String `tfsdk:"status"`
+	StatusReasons      types.List   `tfsdk:"status_reasons"`
+	StateDescription   types.String `tfsdk:"state_description"`
+	ClusterAPIURL      types.String `tfsdk:"cluster_api_url"`
+	DataplaneReachable types.Bool   `tfsdk:"dataplane_reachable"`
+	DataplaneError     types.String `tfsdk:"dataplane_error"`
+	Healthy            types.Bool   `tfsdk:"healthy"`
+}
+
+// PrivateConnectivity represents the Terraform model for the
+// PrivateConnectivity data source.
+type PrivateConnectivity struct {
+	ID            types.String                    `tfsdk:"id"`
+	CloudProvider types.String                    `tfsdk:"cloud_provider"`
+	Enabled       types.Bool                      `tfsdk:"enabled"`
+	ServiceName   types.String                    `tfsdk:"service_name"`
+	ServiceID     types.String                    `tfsdk:"service_id"`
+	Connections   []PrivateConnectivityConnection `tfsdk:"connections"`
+}
+
+// PrivateConnectivityConnection represents a consumer endpoint connected to
+// the private connectivity service of a cluster.
+type PrivateConnectivityConnection struct {
+	EndpointID   types.String `tfsdk:"endpoint_id"`
+	ConnectionID types.String `tfsdk:"connection_id"`
+	State        types.String `tfsdk:"state"`
+}
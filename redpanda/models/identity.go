@@ -0,0 +1,31 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ResourceIdentity is the identity of the resources identified by their cloud
+// ID, such as clusters and networks.
+type ResourceIdentity struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// TopicIdentity is the identity of a topic. Topic names are only unique
+// within a cluster, so the cluster API URL is part of the identity.
+type TopicIdentity struct {
+	Name          types.String `tfsdk:"name"`
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+}
@@ -26,4 +26,32 @@ type Network struct {
 	CidrBlock       types.String `tfsdk:"cidr_block"`
 	ID              types.String `tfsdk:"id"`
 	ClusterType     types.String `tfsdk:"cluster_type"`
+
+	CustomerManagedResources *NetworkCustomerManagedResources `tfsdk:"customer_managed_resources"`
+}
+
+// NetworkCustomerManagedResources represents the cloud resources that the
+// customer creates for a BYOVPC network, of which only the block of the
+// network's cloud provider is set.
+type NetworkCustomerManagedResources struct {
+	AWS *NetworkCustomerManagedAWS `tfsdk:"aws"`
+	GCP *NetworkCustomerManagedGCP `tfsdk:"gcp"`
+}
+
+// NetworkCustomerManagedAWS represents the customer-managed resources of a
+// network on AWS.
+type NetworkCustomerManagedAWS struct {
+	ManagementBucketArn types.String `tfsdk:"management_bucket_arn"`
+	DynamoDBTableArn    types.String `tfsdk:"dynamodb_table_arn"`
+	VpcArn              types.String `tfsdk:"vpc_arn"`
+	PrivateSubnetArns   types.List   `tfsdk:"private_subnet_arns"`
+	PublicSubnetArns    types.List   `tfsdk:"public_subnet_arns"`
+}
+
+// NetworkCustomerManagedGCP represents the customer-managed resources of a
+// network on GCP.
+type NetworkCustomerManagedGCP struct {
+	NetworkName          types.String `tfsdk:"network_name"`
+	NetworkProjectID     types.String `tfsdk:"network_project_id"`
+	ManagementBucketName types.String `tfsdk:"management_bucket_name"`
 }
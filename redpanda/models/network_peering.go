@@ -0,0 +1,39 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// NetworkPeering represents the Terraform schema for the NetworkPeering data
+// source.
+type NetworkPeering struct {
+	NetworkID types.String       `tfsdk:"network_id"`
+	Aws       *NetworkPeeringAws `tfsdk:"aws"`
+	Gcp       *NetworkPeeringGcp `tfsdk:"gcp"`
+}
+
+// NetworkPeeringAws is the VPC of a Redpanda network on AWS.
+type NetworkPeeringAws struct {
+	OwnerID   types.String `tfsdk:"owner_id"`
+	VpcID     types.String `tfsdk:"vpc_id"`
+	CidrBlock types.String `tfsdk:"cidr_block"`
+}
+
+// NetworkPeeringGcp is the VPC network of a Redpanda network on GCP.
+type NetworkPeeringGcp struct {
+	ProjectID   types.String `tfsdk:"project_id"`
+	NetworkName types.String `tfsdk:"network_name"`
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Operations represents the Terraform model for the Operations data source.
+type Operations struct {
+	ResourceID types.String     `tfsdk:"resource_id"`
+	Type       types.String     `tfsdk:"type"`
+	Operations []OperationsItem `tfsdk:"operations"`
+}
+
+// OperationsItem represents a single operation in an Operations data source.
+type OperationsItem struct {
+	ID         string `tfsdk:"id"`
+	Type       string `tfsdk:"type"`
+	ResourceID string `tfsdk:"resource_id"`
+	StartedAt  string `tfsdk:"started_at"`
+}
+
+// Operation represents the Terraform model for the Operation data source.
+type Operation struct {
+	ID           types.String `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	State        types.String `tfsdk:"state"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+	ErrorCode    types.String `tfsdk:"error_code"`
+	ErrorMessage types.String `tfsdk:"error_message"`
+	Metadata     types.String `tfsdk:"metadata"`
+}
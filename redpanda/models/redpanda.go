@@ -26,4 +26,21 @@ type Redpanda struct {
 	ClientSecret        types.String `tfsdk:"client_secret"`
 	AzureSubscriptionID types.String `tfsdk:"azure_subscription_id"`
 	GcpProjectID        types.String `tfsdk:"gcp_project_id"`
+	APIURL              types.String `tfsdk:"api_url"`
+	InternalAPIURL      types.String `tfsdk:"internal_api_url"`
+	AuthURL             types.String `tfsdk:"auth_url"`
+	Audience            types.String `tfsdk:"audience"`
+	Scope               types.String `tfsdk:"scope"`
+	ProxyURL            types.String `tfsdk:"proxy_url"`
+	ProxyUsername       types.String `tfsdk:"proxy_username"`
+	ProxyPassword       types.String `tfsdk:"proxy_password"`
+	DefaultTags         types.Map    `tfsdk:"default_tags"`
+
+	OperationTimeoutMultiplier types.Float64 `tfsdk:"operation_timeout_multiplier"`
+	VerbosePolling             types.Bool    `tfsdk:"verbose_polling"`
+	OperationStallWarning      types.String  `tfsdk:"operation_stall_warning"`
+	OperationStallTimeout      types.String  `tfsdk:"operation_stall_timeout"`
+	MaxRetries                 types.Int64   `tfsdk:"max_retries"`
+	RetryMaxBackoff            types.String  `tfsdk:"retry_max_backoff"`
+	PreventDuplicateNames      types.Bool    `tfsdk:"prevent_duplicate_names"`
 }
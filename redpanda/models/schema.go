@@ -0,0 +1,32 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Schema defines the structure for configuration settings parsed from HCL.
+type Schema struct {
+	ClusterID     types.String `tfsdk:"cluster_id"`
+	Subject       types.String `tfsdk:"subject"`
+	Schema        types.String `tfsdk:"schema"`
+	SchemaType    types.String `tfsdk:"schema_type"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	AllowDeletion types.Bool   `tfsdk:"allow_deletion"`
+	SchemaID      types.Int64  `tfsdk:"schema_id"`
+	Version       types.Int64  `tfsdk:"version"`
+	ID            types.String `tfsdk:"id"`
+}
@@ -26,4 +26,6 @@ type ServerlessCluster struct {
 	ServerlessRegion types.String `tfsdk:"serverless_region"`
 	ResourceGroupID  types.String `tfsdk:"resource_group_id"`
 	ClusterAPIURL    types.String `tfsdk:"cluster_api_url"`
+	State            types.String `tfsdk:"state"`
+	DataplaneReady   types.Bool   `tfsdk:"dataplane_ready"`
 }
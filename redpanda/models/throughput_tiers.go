@@ -29,3 +29,18 @@ type ThroughputTiersItem struct {
 	DisplayName   string `tfsdk:"display_name"`
 	Name          string `tfsdk:"name"`
 }
+
+// ThroughputTierAvailability represents the Terraform model for the Throughput
+// Tier Availability data source.
+type ThroughputTierAvailability struct {
+	CloudProvider     types.String `tfsdk:"cloud_provider"`
+	ClusterType       types.String `tfsdk:"cluster_type"`
+	Region            types.String `tfsdk:"region"`
+	Zones             types.List   `tfsdk:"zones"`
+	ThroughputTier    types.String `tfsdk:"throughput_tier"`
+	FailOnUnavailable types.Bool   `tfsdk:"fail_on_unavailable"`
+	Available         types.Bool   `tfsdk:"available"`
+	Reasons           types.List   `tfsdk:"reasons"`
+	AvailableZones    types.List   `tfsdk:"available_zones"`
+	AvailableTiers    types.List   `tfsdk:"available_tiers"`
+}
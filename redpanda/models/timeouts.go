@@ -0,0 +1,52 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClusterResource represents the Terraform schema for the cluster resource:
+// the attributes shared with the cluster data source, the attributes that
+// only configure the resource and the timeouts of the cluster operations.
+type ClusterResource struct {
+	Cluster
+	WaitForPendingDeletion  types.Bool     `tfsdk:"wait_for_pending_deletion"`
+	CloneFromClusterID      types.String   `tfsdk:"clone_from_cluster_id"`
+	ForceDestroy            types.Bool     `tfsdk:"force_destroy"`
+	ResolvedResourceGroupID types.String   `tfsdk:"resolved_resource_group_id"`
+	ResolvedNetworkID       types.String   `tfsdk:"resolved_network_id"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NetworkResource represents the Terraform schema for the network resource:
+// the attributes shared with the network data source and the timeouts of the
+// network operations.
+type NetworkResource struct {
+	Network
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ServerlessClusterResource represents the Terraform schema for the serverless
+// cluster resource: the attributes shared with the serverless cluster data
+// source, the attributes that only configure the resource and the timeouts of
+// the serverless cluster operations.
+type ServerlessClusterResource struct {
+	ServerlessCluster
+	AllowDeletion types.Bool     `tfsdk:"allow_deletion"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
@@ -18,11 +18,19 @@ import "github.com/hashicorp/terraform-plugin-framework/types"
 
 // Topic defines the structure for configuration settings parsed from HCL.
 type Topic struct {
-	Name              types.String `tfsdk:"name"`
-	PartitionCount    types.Number `tfsdk:"partition_count"`
-	ReplicationFactor types.Number `tfsdk:"replication_factor"`
-	Configuration     types.Map    `tfsdk:"configuration"`
-	AllowDeletion     types.Bool   `tfsdk:"allow_deletion"`
-	ClusterAPIURL     types.String `tfsdk:"cluster_api_url"`
-	ID                types.String `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	PartitionCount    types.Int64   `tfsdk:"partition_count"`
+	ReplicationFactor types.Int64   `tfsdk:"replication_factor"`
+	Configuration     types.Map     `tfsdk:"configuration"`
+	AllowDeletion     types.Bool    `tfsdk:"allow_deletion"`
+	ClusterAPIURL     types.String  `tfsdk:"cluster_api_url"`
+	ID                types.String  `tfsdk:"id"`
+	Access            []TopicAccess `tfsdk:"access"`
+}
+
+// TopicAccess is an entry of the access block of a topic, granting a role on
+// the topic to a principal.
+type TopicAccess struct {
+	Principal types.String `tfsdk:"principal"`
+	Role      types.String `tfsdk:"role"`
 }
@@ -25,3 +25,16 @@ type User struct {
 	ID            types.String `tfsdk:"id"`
 	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
 }
+
+// Users defines the configuration of the users data source.
+type Users struct {
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+	NamePrefix    types.String `tfsdk:"name_prefix"`
+	Users         []UserEntry  `tfsdk:"users"`
+}
+
+// UserEntry is a user listed by the users data source.
+type UserEntry struct {
+	Name      types.String `tfsdk:"name"`
+	Mechanism types.String `tfsdk:"mechanism"`
+}
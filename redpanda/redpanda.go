@@ -21,37 +21,55 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/functions"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/acl"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/appidentity"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/cluster"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/clusterconfig"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/clusterexport"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/network"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/operations"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/region"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/regions"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/resourcegroup"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/role"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/schemaregistry"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/secret"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/serverlesscluster"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/serverlessregions"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/serviceaccount"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/throughputtiers"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/topic"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/user"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/versions"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 	"google.golang.org/grpc"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &Redpanda{}
+var (
+	_ provider.Provider              = &Redpanda{}
+	_ provider.ProviderWithFunctions = &Redpanda{}
+)
 
 // Redpanda represents the Redpanda Terraform provider.
 type Redpanda struct {
@@ -73,8 +91,23 @@ const (
 	ClientIDEnv = "REDPANDA_CLIENT_ID"
 	// ClientSecretEnv is the client_secret used to authenticate to Redpanda cloud.
 	ClientSecretEnv = "REDPANDA_CLIENT_SECRET"
+	// APIURLEnv overrides the Redpanda Cloud API endpoint of the environment.
+	APIURLEnv = "REDPANDA_API_URL"
+	// InternalAPIURLEnv overrides the Redpanda Cloud internal API URL of the
+	// environment.
+	InternalAPIURLEnv = "REDPANDA_INTERNAL_API_URL"
+	// AuthURLEnv overrides the token exchange URL of the environment.
+	AuthURLEnv = "REDPANDA_AUTH_URL"
+	// AudienceEnv overrides the OAuth audience of the environment.
+	AudienceEnv = "REDPANDA_AUDIENCE"
+	// ScopeEnv is the OAuth scope requested when exchanging client credentials.
+	ScopeEnv = "REDPANDA_SCOPE"
 )
 
+// defaultStallWarning is how long an operation can go unchanged before a
+// warning is added, when operation_stall_warning is unset.
+const defaultStallWarning = 20 * time.Minute
+
 // New spawns a basic provider struct, no client. Configure must be called for a
 // working client.
 func New(_ context.Context, cloudEnv, version string) func() provider.Provider {
@@ -123,17 +156,146 @@ func providerSchema() schema.Schema {
 			},
 			"azure_subscription_id": schema.StringAttribute{
 				Optional: true,
-				Description: ("The default Azure Subscription ID which should be used for Redpanda BYOC clusters." +
+				MarkdownDescription: ("The default Azure Subscription ID which should be used for Redpanda BYOC clusters." +
 					" If another subscription is specified on a resource, it will take precedence. This can also be" +
 					" sourced from the `ARM_SUBSCRIPTION_ID` environment variable."),
 			},
 			"gcp_project_id": schema.StringAttribute{
 				Optional: true,
-				Description: ("The default Google Cloud Project ID to use for Redpanda BYOC clusters. If another" +
+				MarkdownDescription: ("The default Google Cloud Project ID to use for Redpanda BYOC clusters. If another" +
 					" project is specified on a resource, it will take precedence. This can also be sourced from" +
 					" the `GOOGLE_PROJECT` environment variable, or any of the following ordered by precedence:" +
 					" `GOOGLE_PROJECT`, `GOOGLE_CLOUD_PROJECT`, `GCLOUD_PROJECT`, or `CLOUDSDK_CORE_PROJECT`."),
 			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Redpanda Cloud API endpoint to use instead of the one of the environment,"+
+					" e.g. `api.ppd.cloud.redpanda.com:443` for preprod or the address of an API-compatible gateway. Can also"+
+					" be set with the `%v` environment variable.", APIURLEnv),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"internal_api_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Redpanda Cloud internal API URL to use instead of the one of the environment,"+
+					" e.g. `https://cloud-api.ppd.cloud.redpanda.com`. It is used to download and run the BYOC plugin of"+
+					" BYOC clusters. Can also be set with the `%v` environment variable.", InternalAPIURLEnv),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"auth_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("URL of the OAuth token exchange used to authenticate with `client_id` and"+
+					" `client_secret`, instead of the one of the environment. Can also be set with the `%v` environment"+
+					" variable.", AuthURLEnv),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"audience": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("OAuth audience requested when authenticating with `client_id` and"+
+					" `client_secret`, instead of the one of the environment, e.g. for a sovereign or self-hosted control"+
+					" plane. Can also be set with the `%v` environment variable.", AudienceEnv),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Space-separated OAuth scopes requested when authenticating with"+
+					" `client_id` and `client_secret`. No scope is requested by default. Can also be set with the `%v`"+
+					" environment variable.", ScopeEnv),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: ("URL of an HTTP CONNECT proxy used to reach the Redpanda Cloud and cluster APIs, e.g." +
+					" `http://proxy.example.com:3128`. Credentials can be given in the URL or with `proxy_username`" +
+					" and `proxy_password`. When unset, the `HTTPS_PROXY` environment variable is honored."),
+				Validators: []validator.String{
+					validators.NotUnknown(),
+				},
+			},
+			"proxy_username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the proxy with basic authentication.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_url")),
+				},
+			},
+			"proxy_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the proxy with basic authentication.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("proxy_username")),
+				},
+			},
+			"default_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: ("Tags placed on the cloud resources of every cluster managed by the provider, e.g. a cost" +
+					" center or an owner. Tags of the same name set on a cluster take precedence."),
+			},
+			"operation_timeout_multiplier": schema.Float64Attribute{
+				Optional: true,
+				MarkdownDescription: ("Multiplier applied to the time resources wait for clusters and networks to be created," +
+					" updated or deleted, e.g. `2` in regions where provisioning is consistently slower. Defaults to `1`."),
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+			},
+			"verbose_polling": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: ("Log every poll of a long-running operation at INFO with its state, the time elapsed and" +
+					" the name of the resource it acts on, to debug operations that seem stuck. Defaults to `false`."),
+			},
+			"operation_stall_warning": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: ("How long a long-running operation can go without any change of its state or metadata" +
+					" before a warning naming the operation is added, while polling continues, e.g. `45m` or `2h`. Also applies to" +
+					" the state of clusters being created or deleted. Defaults to `20m`."),
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"operation_stall_timeout": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: ("How long a long-running operation can go without any change of its state or metadata" +
+					" before the provider stops waiting for it and fails, instead of waiting for the whole timeout of the" +
+					" resource, e.g. `1h`. Also applies to the state of clusters being created or deleted. Unset by default, so" +
+					" that stalled operations are only warned about."),
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: ("Number of times a call to the Redpanda Cloud control plane is retried when it fails with a" +
+					" transient error, such as `Unavailable` or `DeadlineExceeded`. Defaults to `4`; `0` disables retries."),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: ("Longest delay between two attempts of a retried call, e.g. `30s`. The delay starts at" +
+					" 100ms and doubles on every attempt. Defaults to `10s`."),
+				Validators: []validator.String{
+					validators.Duration(),
+				},
+			},
+			"prevent_duplicate_names": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: ("Fail the plan when a cluster, network or resource group is about to be created under a name" +
+					" already used in the organization, e.g. by another team, with a hint to import the existing object instead." +
+					" Defaults to `false`."),
+			},
 		},
 		Description:         "Redpanda Data terraform provider",
 		MarkdownDescription: "Provider configuration",
@@ -149,7 +311,7 @@ type credentials struct {
 }
 
 // getCredentials reads authentication configuration from multiple sources and returns an access token for Redpanda Cloud
-func getCredentials(ctx context.Context, cloudEnv string, conf models.Redpanda) (credentials, diag.Diagnostics) {
+func getCredentials(ctx context.Context, cloudEnv string, conf models.Redpanda, proxy *cloud.Proxy) (credentials, diag.Diagnostics) {
 	// Similar to other Terraform providers, this provider (1) can pick up authentication configuration
 	// from multiple sources, and (2) gives precedence to direct configuration over environment variables.
 	// The first source which provides a client_id, client_secret, or token will be chosen to validate and
@@ -176,6 +338,19 @@ func getCredentials(ctx context.Context, cloudEnv string, conf models.Redpanda)
 		diags.AddError("error retrieving correct endpoint", err.Error())
 		return creds, diags
 	}
+	apiURL := firstNonEmptyString(conf.APIURL.ValueString(), os.Getenv(APIURLEnv))
+	internalAPIURL := firstNonEmptyString(conf.InternalAPIURL.ValueString(), os.Getenv(InternalAPIURLEnv))
+	authURL := firstNonEmptyString(conf.AuthURL.ValueString(), os.Getenv(AuthURLEnv))
+	if apiURL != "" || internalAPIURL != "" || authURL != "" {
+		tflog.Info(ctx, "using overridden Redpanda Cloud endpoints", map[string]any{"api_url": apiURL, "internal_api_url": internalAPIURL, "auth_url": authURL})
+		endpoint = endpoint.WithOverrides(apiURL, internalAPIURL, authURL)
+	}
+	audience := firstNonEmptyString(conf.Audience.ValueString(), os.Getenv(AudienceEnv))
+	scope := firstNonEmptyString(conf.Scope.ValueString(), os.Getenv(ScopeEnv))
+	if audience != "" || scope != "" {
+		tflog.Info(ctx, "using overridden OAuth audience and scope", map[string]any{"audience": audience, "scope": scope})
+		endpoint = endpoint.WithOAuth(audience, scope)
+	}
 	creds.EndpointAPIURL = endpoint.APIURL
 	creds.InternalAPIURL = endpoint.InternalAPIURL
 
@@ -190,7 +365,7 @@ func getCredentials(ctx context.Context, cloudEnv string, conf models.Redpanda)
 		creds.Token = conf.AccessToken.ValueString()
 
 		if creds.Token == "" {
-			creds.Token, err = cloud.RequestToken(ctx, endpoint, creds.ClientID, creds.ClientSecret)
+			creds.Token, err = cloud.RequestToken(ctx, endpoint, creds.ClientID, creds.ClientSecret, proxy)
 			if err != nil {
 				diags.AddError("failed to authenticate with Redpanda API", err.Error())
 			}
@@ -215,8 +390,9 @@ func getCredentials(ctx context.Context, cloudEnv string, conf models.Redpanda)
 
 		creds.ClientID = id
 		creds.ClientSecret = sec
+		creds.Token = token
 		if creds.Token == "" {
-			creds.Token, err = cloud.RequestToken(ctx, endpoint, creds.ClientID, creds.ClientSecret)
+			creds.Token, err = cloud.RequestToken(ctx, endpoint, creds.ClientID, creds.ClientSecret, proxy)
 			if err != nil {
 				diags.AddError("failed to authenticate with Redpanda API", err.Error())
 			}
@@ -250,15 +426,36 @@ func (r *Redpanda) Configure(ctx context.Context, request provider.ConfigureRequ
 		return
 	}
 
+	var proxy *cloud.Proxy
+	if !conf.ProxyURL.IsNull() {
+		var err error
+		proxy, err = cloud.NewProxy(conf.ProxyURL.ValueString(), conf.ProxyUsername.ValueString(), conf.ProxyPassword.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("proxy_url"), "invalid proxy configuration", err.Error())
+			return
+		}
+	}
+
 	// Clients are passed through to downstream resources through the response
 	// struct.
-	creds, diags := getCredentials(ctx, r.cloudEnv, conf)
+	creds, diags := getCredentials(ctx, r.cloudEnv, conf, proxy)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 	if r.conn == nil {
-		conn, err := cloud.SpawnConn(creds.EndpointAPIURL, creds.Token)
+		retries := cloud.DefaultRetryPolicy
+		if !conf.MaxRetries.IsNull() {
+			retries.Max = uint(conf.MaxRetries.ValueInt64())
+		}
+		if v := conf.RetryMaxBackoff.ValueString(); v != "" {
+			var err error
+			if retries.MaxBackoff, err = utils.ParseDuration(v); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root("retry_max_backoff"), "invalid duration", err.Error())
+				return
+			}
+		}
+		conn, err := cloud.SpawnConnWithRetries(creds.EndpointAPIURL, creds.Token, proxy, retries)
 		if err != nil {
 			response.Diagnostics.AddError("failed to open a connection with the Redpanda Cloud API", err.Error())
 			return
@@ -288,14 +485,39 @@ func (r *Redpanda) Configure(ctx context.Context, request provider.ConfigureRequ
 		})
 	}
 
+	stallWarning, stallTimeout := defaultStallWarning, time.Duration(0)
+	var err error
+	if v := conf.OperationStallWarning.ValueString(); v != "" {
+		if stallWarning, err = utils.ParseDuration(v); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("operation_stall_warning"), "invalid duration", err.Error())
+			return
+		}
+	}
+	if v := conf.OperationStallTimeout.ValueString(); v != "" {
+		if stallTimeout, err = utils.ParseDuration(v); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("operation_stall_timeout"), "invalid duration", err.Error())
+			return
+		}
+	}
 	response.ResourceData = config.Resource{
 		AuthToken:              creds.Token,
 		ByocClient:             r.byoc,
 		ControlPlaneConnection: r.conn,
+		Proxy:                  proxy,
+		DefaultTags:            utils.TypeMapToStringMap(conf.DefaultTags),
+		Timeouts:               config.Timeouts{Multiplier: conf.OperationTimeoutMultiplier.ValueFloat64()},
+		Polling: config.Polling{
+			Verbose:      conf.VerbosePolling.ValueBool(),
+			StallWarning: stallWarning,
+			StallTimeout: stallTimeout,
+		},
+		Registry:              config.NewRegistry(),
+		PreventDuplicateNames: conf.PreventDuplicateNames.ValueBool(),
 	}
 	response.DataSourceData = config.Datasource{
 		AuthToken:              creds.Token,
 		ControlPlaneConnection: r.conn,
+		Proxy:                  proxy,
 	}
 }
 
@@ -323,12 +545,33 @@ func (*Redpanda) DataSources(_ context.Context) []func() datasource.DataSource {
 		func() datasource.DataSource {
 			return &cluster.DataSourceCluster{}
 		},
+		func() datasource.DataSource {
+			return &cluster.DataSourceClusters{}
+		},
+		func() datasource.DataSource {
+			return &cluster.DataSourceClusterStatus{}
+		},
+		func() datasource.DataSource {
+			return &cluster.DataSourcePrivateConnectivity{}
+		},
+		func() datasource.DataSource {
+			return &clusterexport.DataSourceClusterExport{}
+		},
+		func() datasource.DataSource {
+			return &cluster.DataSourceClusterSpec{}
+		},
 		func() datasource.DataSource {
 			return &resourcegroup.DataSourceResourceGroup{}
 		},
+		func() datasource.DataSource {
+			return &resourcegroup.DataSourceNamespace{}
+		},
 		func() datasource.DataSource {
 			return &network.DataSourceNetwork{}
 		},
+		func() datasource.DataSource {
+			return &network.DataSourceNetworkPeering{}
+		},
 		func() datasource.DataSource {
 			return &region.DataSourceRegion{}
 		},
@@ -338,6 +581,38 @@ func (*Redpanda) DataSources(_ context.Context) []func() datasource.DataSource {
 		func() datasource.DataSource {
 			return &throughputtiers.DataSourceThroughputTiers{}
 		},
+		func() datasource.DataSource {
+			return &throughputtiers.DataSourceThroughputTierAvailability{}
+		},
+		func() datasource.DataSource {
+			return &versions.DataSourceVersions{}
+		},
+		func() datasource.DataSource {
+			return &operations.DataSourceOperations{}
+		},
+		func() datasource.DataSource {
+			return &operations.DataSourceOperation{}
+		},
+		func() datasource.DataSource {
+			return &acl.DataSourceACLs{}
+		},
+		func() datasource.DataSource {
+			return &user.DataSourceUsers{}
+		},
+		func() datasource.DataSource {
+			return &schemaregistry.DataSourceSubjects{}
+		},
+	}
+}
+
+// Functions returns a slice of functions to instantiate each Redpanda provider
+// defined function.
+func (*Redpanda) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return &functions.TopicConfigFromJSON{} },
+		func() function.Function { return &functions.TopicConfigToJSON{} },
+		func() function.Function { return &functions.DurationMs{} },
+		func() function.Function { return &functions.SizeBytes{} },
 	}
 }
 
@@ -347,6 +622,9 @@ func (*Redpanda) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource {
 			return &resourcegroup.ResourceGroup{}
 		},
+		func() resource.Resource {
+			return &resourcegroup.Namespace{}
+		},
 		func() resource.Resource {
 			return &network.Network{}
 		},
@@ -357,5 +635,15 @@ func (*Redpanda) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return &acl.ACL{} },
 		func() resource.Resource { return &user.User{} },
 		func() resource.Resource { return &topic.Topic{} },
+		func() resource.Resource { return &schemaregistry.Schema{} },
+		func() resource.Resource { return &schemaregistry.Compatibility{} },
+		func() resource.Resource { return &clusterconfig.ClusterConfiguration{} },
+		func() resource.Resource { return &clusterconfig.PartitionRebalance{} },
+		func() resource.Resource { return &secret.Secret{} },
+		func() resource.Resource { return &role.Role{} },
+		func() resource.Resource { return &role.Assignment{} },
+		func() resource.Resource { return &serviceaccount.ServiceAccount{} },
+		func() resource.Resource { return &serviceaccount.Credentials{} },
+		func() resource.Resource { return &appidentity.AppIdentity{} },
 	}
 }
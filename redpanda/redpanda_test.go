@@ -17,9 +17,13 @@ package redpanda
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 )
 
 func TestProviderConfigure(t *testing.T) {
@@ -32,3 +36,74 @@ func TestProviderConfigure(t *testing.T) {
 		t.Fatalf("unexpected error in provider schema: %s", d)
 	}
 }
+
+func TestGetCredentialsAccessToken(t *testing.T) {
+	ctx := context.Background()
+	testCases := []struct {
+		name string
+		conf models.Redpanda
+		env  map[string]string
+	}{
+		{
+			name: "provider configuration",
+			conf: models.Redpanda{AccessToken: types.StringValue("pre-issued")},
+		},
+		{
+			name: "environment variable",
+			env:  map[string]string{AccessTokenEnv: "pre-issued"},
+		},
+		{
+			name: "environment variable with client ID",
+			env:  map[string]string{AccessTokenEnv: "pre-issued", ClientIDEnv: "id"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, env := range []string{AccessTokenEnv, ClientIDEnv, ClientSecretEnv, APIURLEnv, AuthURLEnv, AudienceEnv, ScopeEnv} {
+				t.Setenv(env, tc.env[env])
+			}
+			// no token exchange is attempted, so this does not reach the network
+			creds, diags := getCredentials(ctx, "pre", tc.conf, nil)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if creds.Token != "pre-issued" {
+				t.Errorf("Expected the pre-issued token, got %q", creds.Token)
+			}
+		})
+	}
+}
+
+func TestGetCredentialsOAuthOverrides(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unable to parse the token request: %v", err)
+		}
+		if got := r.PostForm.Get("audience"); got != "cloud.sovereign.example.com" {
+			t.Errorf("Expected the overridden audience, got %q", got)
+		}
+		if got := r.PostForm.Get("scope"); got != "openid cloud:admin" {
+			t.Errorf("Expected the scope from the environment, got %q", got)
+		}
+		_, _ = w.Write([]byte(`{"access_token":"exchanged","token_type":"Bearer"}`))
+	}))
+	defer srv.Close()
+
+	for _, env := range []string{AccessTokenEnv, ClientIDEnv, ClientSecretEnv, APIURLEnv, AuthURLEnv, AudienceEnv} {
+		t.Setenv(env, "")
+	}
+	t.Setenv(ScopeEnv, "openid cloud:admin")
+	conf := models.Redpanda{
+		ClientID:     types.StringValue("id"),
+		ClientSecret: types.StringValue("secret"),
+		AuthURL:      types.StringValue(srv.URL),
+		Audience:     types.StringValue("cloud.sovereign.example.com"),
+	}
+	creds, diags := getCredentials(context.Background(), "pre", conf, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if creds.Token != "exchanged" {
+		t.Errorf("Expected the exchanged token, got %q", creds.Token)
+	}
+}
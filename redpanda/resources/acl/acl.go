@@ -19,11 +19,14 @@ package acl
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 )
 
 // stringToEnum converts a string to an enum given a certain map. It prepends
@@ -36,12 +39,13 @@ func stringToEnum(s, prepend string, m map[string]int32) (int32, error) {
 }
 
 // enumToString converts an enum to a string using a given map. It cuts the
-// given cutset from the response.
+// given cutset from the response. Values missing from the map, sent by a
+// newer API, are returned as their number.
 func enumToString(e int32, cutset string, m map[int32]string) string {
 	if s, ok := m[e]; ok {
 		return strings.TrimPrefix(s, cutset)
 	}
-	return "UNKNOWN"
+	return strconv.Itoa(int(e))
 }
 
 // mapValueToValidator creates strings OneOf validators for the values of the
@@ -108,6 +112,10 @@ func stringToACLOperation(s string) (dataplanev1alpha2.ACL_Operation, error) {
 	return dataplanev1alpha2.ACL_Operation(enum), nil
 }
 
+func aclOperationToString(e dataplanev1alpha2.ACL_Operation) string {
+	return enumToString(int32(e), aclOperationPrefix, dataplanev1alpha2.ACL_Operation_name)
+}
+
 func aclOperationValidator() []validator.String {
 	return mapValueToValidator(aclOperationPrefix, dataplanev1alpha2.ACL_Operation_name)
 }
@@ -124,6 +132,75 @@ func stringToACLPermissionType(s string) (dataplanev1alpha2.ACL_PermissionType,
 	return dataplanev1alpha2.ACL_PermissionType(enum), nil
 }
 
+func aclPermissionTypeToString(e dataplanev1alpha2.ACL_PermissionType) string {
+	return enumToString(int32(e), aclPermissionTypePrefix, dataplanev1alpha2.ACL_PermissionType_name)
+}
+
 func aclPermissionTypeValidator() []validator.String {
 	return mapValueToValidator(aclPermissionTypePrefix, dataplanev1alpha2.ACL_PermissionType_name)
 }
+
+// ACL key
+
+// aclKeySeparator separates the fields of an ACL key. Principals and hosts
+// cannot contain it.
+const aclKeySeparator = "|"
+
+// aclKeyFormat documents the fields of an ACL key, in order.
+const aclKeyFormat = "<resource_type>|<resource_name>|<resource_pattern_type>|<principal>|<host>|<operation>|<permission_type>"
+
+// aclKey returns the key identifying an ACL on its cluster. It is the ID of
+// the ACL, and follows the cluster ID in its import ID.
+func aclKey(model models.ACL) string {
+	return strings.Join([]string{
+		model.ResourceType.ValueString(),
+		model.ResourceName.ValueString(),
+		model.ResourcePatternType.ValueString(),
+		model.Principal.ValueString(),
+		model.Host.ValueString(),
+		model.Operation.ValueString(),
+		model.PermissionType.ValueString(),
+	}, aclKeySeparator)
+}
+
+// parseACLKey parses a key returned by aclKey into the ACL it identifies,
+// without its cluster_api_url. The resource name is the only field that may
+// contain the separator.
+func parseACLKey(key string) (models.ACL, error) {
+	fields := strings.Split(key, aclKeySeparator)
+	if len(fields) < 7 {
+		return models.ACL{}, fmt.Errorf("ACL key %q is not of the form %s", key, aclKeyFormat)
+	}
+	// fold any separator in the resource name back into it
+	if extra := len(fields) - 7; extra > 0 {
+		fields = append([]string{fields[0], strings.Join(fields[1:2+extra], aclKeySeparator)}, fields[2+extra:]...)
+	}
+	for _, f := range fields {
+		if f == "" {
+			return models.ACL{}, fmt.Errorf("ACL key %q is not of the form %s", key, aclKeyFormat)
+		}
+	}
+	if _, err := stringToACLResourceType(fields[0]); err != nil {
+		return models.ACL{}, err
+	}
+	if _, err := stringToACLResourcePatternType(fields[2]); err != nil {
+		return models.ACL{}, err
+	}
+	if _, err := stringToACLOperation(fields[5]); err != nil {
+		return models.ACL{}, err
+	}
+	if _, err := stringToACLPermissionType(fields[6]); err != nil {
+		return models.ACL{}, err
+	}
+	model := models.ACL{
+		ResourceType:        types.StringValue(fields[0]),
+		ResourceName:        types.StringValue(fields[1]),
+		ResourcePatternType: types.StringValue(fields[2]),
+		Principal:           types.StringValue(fields[3]),
+		Host:                types.StringValue(fields[4]),
+		Operation:           types.StringValue(fields[5]),
+		PermissionType:      types.StringValue(fields[6]),
+	}
+	model.ID = types.StringValue(aclKey(model))
+	return model, nil
+}
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // These are golden tests, to ensure we parse properly and the string types
@@ -56,7 +60,7 @@ func Test_aclResourceTypeToString(t *testing.T) {
 		{"delegation token", dataplanev1alpha2.ACL_RESOURCE_TYPE_DELEGATION_TOKEN, "DELEGATION_TOKEN"},
 		{"user", dataplanev1alpha2.ACL_RESOURCE_TYPE_USER, "USER"},
 		{"user", dataplanev1alpha2.ACL_RESOURCE_TYPE_CLUSTER, "CLUSTER"},
-		{"wrong input", 123, "UNKNOWN"},
+		{"wrong input", 123, "123"},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			got := aclResourceTypeToString(tt.input)
@@ -108,7 +112,7 @@ func Test_aclResourcePatternTypeToString(t *testing.T) {
 		{"match", dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_MATCH, "MATCH"},
 		{"literal", dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL, "LITERAL"},
 		{"prefixed", dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED, "PREFIXED"},
-		{"wrong input", 123, "UNKNOWN"},
+		{"wrong input", 123, "123"},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			got := aclResourcePatternTypeToString(tt.input)
@@ -187,3 +191,33 @@ func Test_stringToACLOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestACLKey(t *testing.T) {
+	model := models.ACL{
+		ResourceType:        types.StringValue("TOPIC"),
+		ResourceName:        types.StringValue("orders|eu"),
+		ResourcePatternType: types.StringValue("LITERAL"),
+		Principal:           types.StringValue("User:CN=alice,OU=eng"),
+		Host:                types.StringValue("*"),
+		Operation:           types.StringValue("READ"),
+		PermissionType:      types.StringValue("ALLOW"),
+	}
+	key := aclKey(model)
+	assert.Equal(t, "TOPIC|orders|eu|LITERAL|User:CN=alice,OU=eng|*|READ|ALLOW", key)
+
+	got, err := parseACLKey(key)
+	require.NoError(t, err)
+	model.ID = types.StringValue(key)
+	assert.Equal(t, model, got)
+
+	for _, bad := range []string{
+		"",
+		"TOPIC|orders|LITERAL|User:alice|*|READ",
+		"TOPIC||LITERAL|User:alice|*|READ|ALLOW",
+		"TOPICS|orders|LITERAL|User:alice|*|READ|ALLOW",
+		"TOPIC|orders|LITERAL|User:alice|*|READ|PERMIT",
+	} {
+		_, err := parseACLKey(bad)
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package acl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceACLs{}
+	_ datasource.DataSourceWithConfigure = &DataSourceACLs{}
+)
+
+// DataSourceACLs represents a data source listing the ACLs of a cluster.
+type DataSourceACLs struct {
+	ACLClient dataplanev1alpha2grpc.ACLServiceClient
+
+	dsData config.Datasource
+}
+
+// Metadata returns the metadata for the ACLs data source.
+func (*DataSourceACLs) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_acls"
+}
+
+// Configure uses provider level data to configure DataSourceACLs.
+func (d *DataSourceACLs) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	d.dsData = p
+}
+
+// Schema returns the schema for the ACLs data source.
+func (*DataSourceACLs) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = datasourceACLsSchema()
+}
+
+func datasourceACLsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_api_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The cluster API URL of the cluster to list the ACLs of",
+			},
+			"principal": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the ACLs of this principal, e.g. `User:alice`",
+			},
+			"resource_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the ACLs on this type of resource (TOPIC, GROUP, etc...)",
+				Validators:          aclResourceTypeValidator(),
+			},
+			"resource_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the ACLs on the resource of this name, whatever their pattern type",
+			},
+			"acls": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ACLs matching the filters, ordered by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the resource this ACL targets",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the resource this ACL is on",
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The pattern type matching the resource name against the actual resource names",
+						},
+						"principal": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The principal this ACL applies to",
+						},
+						"host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The host address this ACL applies to",
+						},
+						"operation": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The operation that is allowed or denied",
+						},
+						"permission_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the operation is ALLOWED or DENIED",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Identifier of the ACL on its cluster, as used by redpanda_acl, of the form " + aclKeyFormat + ".",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Data source listing the ACLs of a cluster, optionally filtered by principal, resource type or resource name",
+	}
+}
+
+// Read lists the ACLs matching the filters of the data source.
+func (d *DataSourceACLs) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var model models.ACLs
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	filter, err := aclsFilter(model)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("resource_type"), "invalid resource type", err.Error())
+		return
+	}
+
+	client := d.ACLClient
+	if client == nil {
+		conn, err := cloud.SpawnConn(model.ClusterAPIURL.ValueString(), d.dsData.AuthToken, d.dsData.Proxy)
+		if err != nil {
+			response.Diagnostics.AddError("failed to create ACL client", fmt.Sprintf("unable to open a connection with the cluster API: %v", err))
+			return
+		}
+		defer conn.Close()
+		client = dataplanev1alpha2grpc.NewACLServiceClient(conn)
+	}
+	list, err := client.ListACLs(ctx, &dataplanev1alpha2.ListACLsRequest{Filter: filter})
+	if err != nil {
+		response.Diagnostics.AddError("Failed to list ACLs", err.Error())
+		return
+	}
+	model.ACLs = aclEntries(list.GetResources())
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+// aclsFilter returns the filter listing the ACLs selected by model. Unset
+// attributes match any ACL.
+func aclsFilter(model models.ACLs) (*dataplanev1alpha2.ListACLsRequest_Filter, error) {
+	filter := &dataplanev1alpha2.ListACLsRequest_Filter{
+		ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_ANY,
+		ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_ANY,
+		Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+		PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ANY,
+	}
+	if !model.ResourceType.IsNull() {
+		resourceType, err := stringToACLResourceType(model.ResourceType.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		filter.ResourceType = resourceType
+	}
+	if !model.ResourceName.IsNull() {
+		filter.ResourceName = utils.StringToStringPointer(model.ResourceName.ValueString())
+	}
+	if !model.Principal.IsNull() {
+		filter.Principal = utils.StringToStringPointer(model.Principal.ValueString())
+	}
+	return filter, nil
+}
+
+// aclEntries flattens the ACLs of the listed resources, ordered by ID so that
+// the list is stable across reads.
+func aclEntries(resources []*dataplanev1alpha2.ListACLsResponse_Resource) []models.ACLEntry {
+	entries := []models.ACLEntry{}
+	for _, res := range resources {
+		for _, acl := range res.GetAcls() {
+			entry := models.ACL{
+				ResourceType:        types.StringValue(aclResourceTypeToString(res.GetResourceType())),
+				ResourceName:        types.StringValue(res.GetResourceName()),
+				ResourcePatternType: types.StringValue(aclResourcePatternTypeToString(res.GetResourcePatternType())),
+				Principal:           types.StringValue(acl.GetPrincipal()),
+				Host:                types.StringValue(acl.GetHost()),
+				Operation:           types.StringValue(aclOperationToString(acl.GetOperation())),
+				PermissionType:      types.StringValue(aclPermissionTypeToString(acl.GetPermissionType())),
+			}
+			entries = append(entries, models.ACLEntry{
+				ResourceType:        entry.ResourceType,
+				ResourceName:        entry.ResourceName,
+				ResourcePatternType: entry.ResourcePatternType,
+				Principal:           entry.Principal,
+				Host:                entry.Host,
+				Operation:           entry.Operation,
+				PermissionType:      entry.PermissionType,
+				ID:                  types.StringValue(aclKey(entry)),
+			})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID.ValueString() < entries[j].ID.ValueString()
+	})
+	return entries
+}
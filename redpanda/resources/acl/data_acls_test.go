@@ -0,0 +1,79 @@
+package acl
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDatasourceACLsSchema(t *testing.T) {
+	require.False(t, datasourceACLsSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestReadACLs(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockACLServiceClient(ctrl)
+	client.EXPECT().ListACLs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.ListACLsRequest, _ ...any) (*dataplanev1alpha2.ListACLsResponse, error) {
+		assert.True(t, proto.Equal(&dataplanev1alpha2.ListACLsRequest_Filter{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_ANY,
+			Principal:           utils.StringToStringPointer("User:alice"),
+			Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ANY,
+		}, req.GetFilter()), req.GetFilter())
+		return &dataplanev1alpha2.ListACLsResponse{Resources: []*dataplanev1alpha2.ListACLsResponse_Resource{
+			{
+				ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+				ResourceName:        "orders",
+				ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+				Acls: []*dataplanev1alpha2.ListACLsResponse_Policy{
+					{Principal: "User:alice", Host: "*", Operation: dataplanev1alpha2.ACL_OPERATION_WRITE, PermissionType: dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW},
+					{Principal: "User:alice", Host: "*", Operation: dataplanev1alpha2.ACL_OPERATION_READ, PermissionType: dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW},
+				},
+			},
+		}}, nil
+	})
+
+	s := datasourceACLsSchema()
+	cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		"principal":       tftypes.NewValue(tftypes.String, "User:alice"),
+		"resource_type":   tftypes.NewValue(tftypes.String, "TOPIC"),
+		"resource_name":   tftypes.NewValue(tftypes.String, nil),
+		"acls":            tftypes.NewValue(s.Attributes["acls"].GetType().TerraformType(ctx), nil),
+	})}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d := &DataSourceACLs{ACLClient: client}
+	d.Read(ctx, datasource.ReadRequest{Config: cfg}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.ACLs
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	entry := func(operation string) models.ACLEntry {
+		return models.ACLEntry{
+			ResourceType:        types.StringValue("TOPIC"),
+			ResourceName:        types.StringValue("orders"),
+			ResourcePatternType: types.StringValue("LITERAL"),
+			Principal:           types.StringValue("User:alice"),
+			Host:                types.StringValue("*"),
+			Operation:           types.StringValue(operation),
+			PermissionType:      types.StringValue("ALLOW"),
+			ID:                  types.StringValue("TOPIC|orders|LITERAL|User:alice|*|" + operation + "|ALLOW"),
+		}
+	}
+	assert.Equal(t, []models.ACLEntry{entry("READ"), entry("WRITE")}, got.ACLs)
+}
@@ -46,6 +46,7 @@ var (
 	_ resource.Resource                = &ACL{}
 	_ resource.ResourceWithConfigure   = &ACL{}
 	_ resource.ResourceWithImportState = &ACL{}
+	_ resource.ResourceWithModifyPlan  = &ACL{}
 )
 
 // Metadata returns the metadata for the resource.
@@ -55,16 +56,8 @@ func (*ACL) Metadata(_ context.Context, _ resource.MetadataRequest, response *re
 
 // Configure configures the ACL resource clients
 func (a *ACL) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Resource)
-
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData))
 		return
 	}
 	a.resData = p
@@ -79,59 +72,86 @@ func resourceACLSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"resource_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "The type of the resource (TOPIC, GROUP, etc...) this ACL shall target",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Validators:    aclResourceTypeValidator(),
+				Required:            true,
+				MarkdownDescription: "The type of the resource (TOPIC, GROUP, etc...) this ACL shall target",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          aclResourceTypeValidator(),
 			},
 			"resource_name": schema.StringAttribute{
-				Required:      true,
-				Description:   "The name of the resource this ACL entry will be on",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The name of the resource this ACL entry will be on",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"resource_pattern_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "The pattern type of the resource. It determines the strategy how the provided resource name is matched (LITERAL, MATCH, PREFIXED, etc ...) against the actual resource names",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Validators:    aclResourcePatternTypeValidator(),
+				Required:            true,
+				MarkdownDescription: "The pattern type of the resource. It determines the strategy how the provided resource name is matched (LITERAL, MATCH, PREFIXED, etc ...) against the actual resource names",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          aclResourcePatternTypeValidator(),
 			},
 			"principal": schema.StringAttribute{
-				Required:      true,
-				Description:   "The principal to apply this ACL for",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The principal to apply this ACL for",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"host": schema.StringAttribute{
-				Required:      true,
-				Description:   "The host address to use for this ACL",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The host address to use for this ACL",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"operation": schema.StringAttribute{
-				Required:      true,
-				Description:   "The operation type that shall be allowed or denied (e.g READ)",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Validators:    aclOperationValidator(),
+				Required:            true,
+				MarkdownDescription: "The operation type that shall be allowed or denied (e.g READ)",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          aclOperationValidator(),
 			},
 			"permission_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "The permission type. It determines whether the operation should be ALLOWED or DENIED",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Validators:    aclPermissionTypeValidator(),
+				Required:            true,
+				MarkdownDescription: "The permission type. It determines whether the operation should be ALLOWED or DENIED",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          aclPermissionTypeValidator(),
 			},
 			"cluster_api_url": schema.StringAttribute{
 				Required: true,
-				Description: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
 					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
 					"change this value unless you are planning to do state imports",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "Identifier of the ACL on its cluster, of the form " + aclKeyFormat + ".",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
 }
 
+// ModifyPlan reports an ACL declared by more than one redpanda_acl resource,
+// since creating it a second time fails.
+func (a *ACL) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var model models.ACL
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []types.String{model.ResourceType, model.ResourceName, model.ResourcePatternType, model.Principal, model.Host, model.Operation, model.PermissionType, model.ClusterAPIURL} {
+		if v.IsUnknown() {
+			return
+		}
+	}
+	key := aclKey(model)
+	if !a.resData.Registry.Claim("redpanda_acl/" + model.ClusterAPIURL.ValueString() + "/" + key) {
+		response.Diagnostics.AddError(
+			"duplicate ACL",
+			fmt.Sprintf("The ACL %s on cluster %s is declared by more than one redpanda_acl resource, e.g. through a repeated "+
+				"for_each value. Remove the duplicate declaration.", key, model.ClusterAPIURL.ValueString()),
+		)
+	}
+}
+
 // Create creates a new ACL resource.
 func (a *ACL) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var model models.ACL
@@ -190,6 +210,7 @@ func (a *ACL) Create(ctx context.Context, request resource.CreateRequest, respon
 		Operation:           model.Operation,
 		PermissionType:      model.PermissionType,
 		ClusterAPIURL:       model.ClusterAPIURL,
+		ID:                  types.StringValue(aclKey(model)),
 	})...)
 }
 
@@ -246,7 +267,7 @@ func (a *ACL) Read(ctx context.Context, request resource.ReadRequest, response *
 
 	for _, res := range aclList.Resources {
 		if res.ResourceName == model.ResourceName.ValueString() && res.ResourceType == resourceType && res.ResourcePatternType == resourcePatternType {
-			response.Diagnostics.Append(response.State.Set(ctx, &models.ACL{
+			persist := models.ACL{
 				ResourceType:        types.StringValue(aclResourceTypeToString(res.ResourceType)),
 				ResourceName:        types.StringValue(res.ResourceName),
 				ResourcePatternType: types.StringValue(aclResourcePatternTypeToString(res.ResourcePatternType)),
@@ -255,7 +276,9 @@ func (a *ACL) Read(ctx context.Context, request resource.ReadRequest, response *
 				Operation:           model.Operation,
 				PermissionType:      model.PermissionType,
 				ClusterAPIURL:       model.ClusterAPIURL,
-			})...)
+			}
+			persist.ID = types.StringValue(aclKey(persist))
+			response.Diagnostics.Append(response.State.Set(ctx, &persist)...)
 			return
 		}
 	}
@@ -331,8 +354,24 @@ func (a *ACL) Delete(ctx context.Context, request resource.DeleteRequest, respon
 }
 
 // ImportState imports an ACL resource
-func (*ACL) ImportState(_ context.Context, _ resource.ImportStateRequest, _ *resource.ImportStateResponse) {
-	// TODO implement me.
+func (a *ACL) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	clusterID, key, err := utils.ParseClusterImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", request.ID), "ADDR ID format is <cluster_id>/"+aclKeyFormat)
+		return
+	}
+	model, err := parseACLKey(key)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", request.ID), err.Error())
+		return
+	}
+	cluster, err := cloud.NewControlPlaneClientSet(a.resData.ControlPlaneConnection).ClusterForID(ctx, clusterID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/%s", clusterID, aclKeyFormat), err.Error())
+		return
+	}
+	model.ClusterAPIURL = types.StringValue(cluster.GetDataplaneApi().GetUrl())
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
 }
 
 func (a *ACL) createACLClient(clusterURL string) error {
@@ -340,7 +379,7 @@ func (a *ACL) createACLClient(clusterURL string) error {
 		return nil
 	}
 	if a.dataplaneConn == nil {
-		conn, err := cloud.SpawnConn(clusterURL, a.resData.AuthToken)
+		conn, err := cloud.SpawnConn(clusterURL, a.resData.AuthToken, a.resData.Proxy)
 		if err != nil {
 			return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
 		}
@@ -0,0 +1,53 @@
+package acl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func aclPlan(ctx context.Context, t *testing.T, clusterAPIURL, principal string) tfsdk.Plan {
+	t.Helper()
+	s := resourceACLSchema()
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, models.ACL{
+		ResourceType:        types.StringValue("TOPIC"),
+		ResourceName:        types.StringValue("orders"),
+		ResourcePatternType: types.StringValue("LITERAL"),
+		Principal:           types.StringValue(principal),
+		Host:                types.StringValue("*"),
+		Operation:           types.StringValue("READ"),
+		PermissionType:      types.StringValue("ALLOW"),
+		ClusterAPIURL:       types.StringValue(clusterAPIURL),
+		ID:                  types.StringUnknown(),
+	})
+	require.False(t, diags.HasError(), diags)
+	return plan
+}
+
+func TestModifyPlanDuplicateACL(t *testing.T) {
+	ctx := context.Background()
+	a := &ACL{resData: config.Resource{Registry: config.NewRegistry()}}
+	modifyPlan := func(plan tfsdk.Plan) *resource.ModifyPlanResponse {
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		a.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan}, resp)
+		return resp
+	}
+
+	assert.False(t, modifyPlan(aclPlan(ctx, t, "api-1.cluster.redpanda.com:443", "User:alice")).Diagnostics.HasError())
+	// the same rule on another cluster or for another principal is distinct
+	assert.False(t, modifyPlan(aclPlan(ctx, t, "api-2.cluster.redpanda.com:443", "User:alice")).Diagnostics.HasError())
+	assert.False(t, modifyPlan(aclPlan(ctx, t, "api-1.cluster.redpanda.com:443", "User:bob")).Diagnostics.HasError())
+
+	resp := modifyPlan(aclPlan(ctx, t, "api-1.cluster.redpanda.com:443", "User:alice"))
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "duplicate ACL", resp.Diagnostics.Errors()[0].Summary())
+}
@@ -0,0 +1,200 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package appidentity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// aclHost is the host of the ACLs created for an app identity.
+const aclHost = "*"
+
+var (
+	readOperations  = []dataplanev1alpha2.ACL_Operation{dataplanev1alpha2.ACL_OPERATION_READ, dataplanev1alpha2.ACL_OPERATION_DESCRIBE}
+	writeOperations = []dataplanev1alpha2.ACL_Operation{dataplanev1alpha2.ACL_OPERATION_WRITE, dataplanev1alpha2.ACL_OPERATION_DESCRIBE}
+)
+
+// aclRule is a prefixed ACL granted to the principal of an app identity.
+type aclRule struct {
+	resourceType dataplanev1alpha2.ACL_ResourceType
+	prefix       string
+	operation    dataplanev1alpha2.ACL_Operation
+}
+
+func compareRules(a, b aclRule) int {
+	if a.resourceType != b.resourceType {
+		return int(a.resourceType) - int(b.resourceType)
+	}
+	if c := strings.Compare(a.prefix, b.prefix); c != 0 {
+		return c
+	}
+	return int(a.operation) - int(b.operation)
+}
+
+// principal returns the ACL principal of the user of an app identity.
+func principal(name string) string {
+	return "User:" + name
+}
+
+// aclRules expands the prefixes of the app identity into the ACLs they grant,
+// sorted and without duplicates since read and write prefixes both grant
+// DESCRIBE.
+func aclRules(model models.AppIdentity) []aclRule {
+	var rules []aclRule
+	add := func(t dataplanev1alpha2.ACL_ResourceType, prefix string, ops []dataplanev1alpha2.ACL_Operation) {
+		for _, op := range ops {
+			rules = append(rules, aclRule{resourceType: t, prefix: prefix, operation: op})
+		}
+	}
+	for _, p := range utils.TypeListToStringSlice(model.ReadTopicPrefixes) {
+		add(dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC, p, readOperations)
+	}
+	for _, p := range utils.TypeListToStringSlice(model.WriteTopicPrefixes) {
+		add(dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC, p, writeOperations)
+	}
+	if g := model.ConsumerGroupPrefix.ValueString(); g != "" {
+		add(dataplanev1alpha2.ACL_RESOURCE_TYPE_GROUP, g, readOperations)
+	}
+	slices.SortFunc(rules, compareRules)
+	return slices.Compact(rules)
+}
+
+// syncACLs creates and deletes the ACLs of the principal so that the ACLs
+// granted by from become the ones granted by to.
+func syncACLs(ctx context.Context, client dataplanev1alpha2grpc.ACLServiceClient, principal string, from, to []aclRule) error {
+	for _, r := range from {
+		if slices.Contains(to, r) {
+			continue
+		}
+		_, err := client.DeleteACLs(ctx, &dataplanev1alpha2.DeleteACLsRequest{
+			Filter: &dataplanev1alpha2.DeleteACLsRequest_Filter{
+				ResourceType:        r.resourceType,
+				ResourceName:        utils.StringToStringPointer(r.prefix),
+				ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED,
+				Principal:           utils.StringToStringPointer(principal),
+				Host:                utils.StringToStringPointer(aclHost),
+				Operation:           r.operation,
+				PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("unable to delete the %s ACL on %s prefix %q: %v", r.operation, r.resourceType, r.prefix, err)
+		}
+	}
+	for _, r := range to {
+		if slices.Contains(from, r) {
+			continue
+		}
+		_, err := client.CreateACL(ctx, &dataplanev1alpha2.CreateACLRequest{
+			ResourceType:        r.resourceType,
+			ResourceName:        r.prefix,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED,
+			Principal:           principal,
+			Host:                aclHost,
+			Operation:           r.operation,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to create the %s ACL on %s prefix %q: %v", r.operation, r.resourceType, r.prefix, err)
+		}
+	}
+	return nil
+}
+
+// listACLs returns the prefixed ACLs granted to the principal.
+func listACLs(ctx context.Context, client dataplanev1alpha2grpc.ACLServiceClient, principal string) ([]aclRule, error) {
+	list, err := client.ListACLs(ctx, &dataplanev1alpha2.ListACLsRequest{
+		Filter: &dataplanev1alpha2.ListACLsRequest_Filter{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_ANY,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED,
+			Principal:           utils.StringToStringPointer(principal),
+			Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var rules []aclRule
+	for _, res := range list.GetResources() {
+		if res.GetResourcePatternType() != dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED {
+			continue
+		}
+		for _, acl := range res.GetAcls() {
+			if acl.GetPrincipal() == principal && acl.GetHost() == aclHost {
+				rules = append(rules, aclRule{resourceType: res.GetResourceType(), prefix: res.GetResourceName(), operation: acl.GetOperation()})
+			}
+		}
+	}
+	slices.SortFunc(rules, compareRules)
+	return slices.Compact(rules), nil
+}
+
+// readPrefixes returns the app identity with the prefixes whose ACLs all
+// exist in the cluster. Prefixes granted outside of Terraform are added, so
+// that they show up as drift and are filled in on import.
+func readPrefixes(model models.AppIdentity, existing []aclRule) models.AppIdentity {
+	granted := func(t dataplanev1alpha2.ACL_ResourceType, prefix string, ops []dataplanev1alpha2.ACL_Operation) bool {
+		for _, op := range ops {
+			if !slices.Contains(existing, aclRule{resourceType: t, prefix: prefix, operation: op}) {
+				return false
+			}
+		}
+		return true
+	}
+	prefixes := func(state []string, t dataplanev1alpha2.ACL_ResourceType, ops []dataplanev1alpha2.ACL_Operation) []string {
+		var found []string
+		for _, p := range state {
+			if granted(t, p, ops) {
+				found = append(found, p)
+			}
+		}
+		var extra []string
+		for _, r := range existing {
+			if r.resourceType == t && r.operation == ops[0] && !slices.Contains(state, r.prefix) && granted(t, r.prefix, ops) {
+				extra = append(extra, r.prefix)
+			}
+		}
+		found = append(found, extra...)
+		if found == nil && state != nil {
+			return []string{}
+		}
+		return found
+	}
+	model.ReadTopicPrefixes = utils.StringSliceToTypeList(prefixes(utils.TypeListToStringSlice(model.ReadTopicPrefixes), dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC, readOperations))
+	model.WriteTopicPrefixes = utils.StringSliceToTypeList(prefixes(utils.TypeListToStringSlice(model.WriteTopicPrefixes), dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC, writeOperations))
+
+	var group []string
+	if g := model.ConsumerGroupPrefix.ValueString(); g != "" {
+		group = []string{g}
+	}
+	switch groups := prefixes(group, dataplanev1alpha2.ACL_RESOURCE_TYPE_GROUP, readOperations); {
+	case len(groups) > 0:
+		model.ConsumerGroupPrefix = types.StringValue(groups[0])
+	case !model.ConsumerGroupPrefix.IsNull():
+		model.ConsumerGroupPrefix = types.StringValue("")
+	}
+	return model
+}
@@ -0,0 +1,108 @@
+package appidentity
+
+import (
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	topic = dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC
+	group = dataplanev1alpha2.ACL_RESOURCE_TYPE_GROUP
+
+	opRead     = dataplanev1alpha2.ACL_OPERATION_READ
+	opWrite    = dataplanev1alpha2.ACL_OPERATION_WRITE
+	opDescribe = dataplanev1alpha2.ACL_OPERATION_DESCRIBE
+)
+
+func TestACLRules(t *testing.T) {
+	got := aclRules(models.AppIdentity{
+		ReadTopicPrefixes:   utils.StringSliceToTypeList([]string{"orders."}),
+		WriteTopicPrefixes:  utils.StringSliceToTypeList([]string{"orders."}),
+		ConsumerGroupPrefix: types.StringValue("orders-"),
+	})
+	assert.Equal(t, []aclRule{
+		{topic, "orders.", opRead},
+		{topic, "orders.", opWrite},
+		{topic, "orders.", opDescribe},
+		{group, "orders-", opRead},
+		{group, "orders-", opDescribe},
+	}, got)
+	assert.Empty(t, aclRules(models.AppIdentity{
+		ReadTopicPrefixes:  types.ListNull(types.StringType),
+		WriteTopicPrefixes: types.ListNull(types.StringType),
+	}))
+}
+
+func TestReadPrefixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    models.AppIdentity
+		existing []aclRule
+		want     models.AppIdentity
+	}{
+		{
+			name: "all granted",
+			state: models.AppIdentity{
+				ReadTopicPrefixes:   utils.StringSliceToTypeList([]string{"payments."}),
+				WriteTopicPrefixes:  types.ListNull(types.StringType),
+				ConsumerGroupPrefix: types.StringValue("orders-"),
+			},
+			existing: []aclRule{
+				{topic, "payments.", opRead},
+				{topic, "payments.", opDescribe},
+				{group, "orders-", opRead},
+				{group, "orders-", opDescribe},
+			},
+			want: models.AppIdentity{
+				ReadTopicPrefixes:   utils.StringSliceToTypeList([]string{"payments."}),
+				WriteTopicPrefixes:  types.ListNull(types.StringType),
+				ConsumerGroupPrefix: types.StringValue("orders-"),
+			},
+		},
+		{
+			name: "deleted outside of terraform",
+			state: models.AppIdentity{
+				ReadTopicPrefixes:   utils.StringSliceToTypeList([]string{"payments."}),
+				WriteTopicPrefixes:  types.ListNull(types.StringType),
+				ConsumerGroupPrefix: types.StringValue("orders-"),
+			},
+			existing: []aclRule{
+				{topic, "payments.", opDescribe},
+			},
+			want: models.AppIdentity{
+				ReadTopicPrefixes:   utils.StringSliceToTypeList([]string{}),
+				WriteTopicPrefixes:  types.ListNull(types.StringType),
+				ConsumerGroupPrefix: types.StringValue(""),
+			},
+		},
+		{
+			name: "import",
+			state: models.AppIdentity{
+				ReadTopicPrefixes:   types.ListNull(types.StringType),
+				WriteTopicPrefixes:  types.ListNull(types.StringType),
+				ConsumerGroupPrefix: types.StringNull(),
+			},
+			existing: []aclRule{
+				{topic, "orders.", opWrite},
+				{topic, "orders.", opDescribe},
+				{group, "orders-", opRead},
+				{group, "orders-", opDescribe},
+			},
+			want: models.AppIdentity{
+				ReadTopicPrefixes:   types.ListNull(types.StringType),
+				WriteTopicPrefixes:  utils.StringSliceToTypeList([]string{"orders."}),
+				ConsumerGroupPrefix: types.StringValue("orders-"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, readPrefixes(tt.state, tt.existing))
+		})
+	}
+}
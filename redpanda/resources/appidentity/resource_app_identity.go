@@ -0,0 +1,296 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package appidentity contains the implementation of the AppIdentity resource
+// following the Terraform framework interfaces.
+package appidentity
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &AppIdentity{}
+	_ resource.ResourceWithConfigure   = &AppIdentity{}
+	_ resource.ResourceWithImportState = &AppIdentity{}
+)
+
+// AppIdentity represents the AppIdentity Terraform resource: a SCRAM user and
+// the prefixed ACLs an application needs to produce and consume.
+type AppIdentity struct {
+	UserClient dataplanev1alpha2grpc.UserServiceClient
+	ACLClient  dataplanev1alpha2grpc.ACLServiceClient
+
+	resData       config.Resource
+	dataplaneConn *grpc.ClientConn
+}
+
+// Metadata returns the metadata for the AppIdentity resource.
+func (*AppIdentity) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_app_identity"
+}
+
+// Configure configures the AppIdentity resource.
+func (a *AppIdentity) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	a.resData = p
+}
+
+// Schema returns the schema for the AppIdentity resource.
+func (*AppIdentity) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceAppIdentitySchema()
+}
+
+func resourceAppIdentitySchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Application identity: a SCRAM user and the ACLs it needs to read and write topics by prefix and to consume with a consumer group prefix, managed as a single resource.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the user, must be unique",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. Changing the password updates the user in place.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"mechanism": schema.StringAttribute{
+				MarkdownDescription: "SCRAM mechanism of the user, scram-sha-256 or scram-sha-512. Defaults to scram-sha-256.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("scram-sha-256"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("scram-sha-256", "scram-sha-512"),
+				},
+			},
+			"read_topic_prefixes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Prefixes of the topics the user can read, granting the READ and DESCRIBE operations.",
+			},
+			"write_topic_prefixes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Prefixes of the topics the user can write, granting the WRITE and DESCRIBE operations.",
+			},
+			"consumer_group_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Prefix of the consumer groups the user can join, granting the READ and DESCRIBE operations.",
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
+					"change this value unless you are planning to do state imports",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the app identity, equal to its name",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create creates the user and its ACLs. If the ACLs can't be created, the user
+// is kept in state without prefixes so that the next apply creates them.
+func (a *AppIdentity) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.AppIdentity
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createClients(model.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create app identity clients", err.Error())
+		return
+	}
+	defer a.closeConn()
+	_, err := a.UserClient.CreateUser(ctx, &dataplanev1alpha2.CreateUserRequest{
+		User: &dataplanev1alpha2.CreateUserRequest_User{
+			Name:      model.Name.ValueString(),
+			Password:  model.Password.ValueString(),
+			Mechanism: utils.StringToUserMechanism(model.Mechanism.ValueString()),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to create user %s", model.Name), err.Error())
+		return
+	}
+	model.ID = model.Name
+	if err := syncACLs(ctx, a.ACLClient, principal(model.Name.ValueString()), nil, aclRules(model)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to create the ACLs of user %s", model.Name), err.Error())
+		model.ReadTopicPrefixes = types.ListNull(types.StringType)
+		model.WriteTopicPrefixes = types.ListNull(types.StringType)
+		model.ConsumerGroupPrefix = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read reads the user and the prefixes whose ACLs exist in the cluster.
+func (a *AppIdentity) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.AppIdentity
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createClients(model.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create app identity clients", err.Error())
+		return
+	}
+	defer a.closeConn()
+	user, err := utils.FindUserByName(ctx, model.Name.ValueString(), a.UserClient)
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to find user %s", model.Name), err.Error())
+		return
+	}
+	if m := user.Mechanism; m != nil && *m != dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_UNSPECIFIED {
+		model.Mechanism = types.StringValue(utils.UserMechanismToString(m))
+	}
+	existing, err := listACLs(ctx, a.ACLClient, principal(model.Name.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list the ACLs of user %s", model.Name), err.Error())
+		return
+	}
+	model = readPrefixes(model, existing)
+	model.ID = model.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update updates the password and mechanism of the user, and creates and
+// deletes ACLs to match the prefixes.
+func (a *AppIdentity) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.AppIdentity
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createClients(plan.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create app identity clients", err.Error())
+		return
+	}
+	defer a.closeConn()
+	if !plan.Password.Equal(state.Password) || !plan.Mechanism.Equal(state.Mechanism) {
+		_, err := a.UserClient.UpdateUser(ctx, &dataplanev1alpha2.UpdateUserRequest{
+			User: &dataplanev1alpha2.UpdateUserRequest_User{
+				Name:      plan.Name.ValueString(),
+				Password:  plan.Password.ValueString(),
+				Mechanism: utils.StringToUserMechanism(plan.Mechanism.ValueString()),
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to update user %s", plan.Name), err.Error())
+			return
+		}
+	}
+	if err := syncACLs(ctx, a.ACLClient, principal(plan.Name.ValueString()), aclRules(state), aclRules(plan)); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to update the ACLs of user %s", plan.Name), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the ACLs of the user, then the user.
+func (a *AppIdentity) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model models.AppIdentity
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createClients(model.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create app identity clients", err.Error())
+		return
+	}
+	defer a.closeConn()
+	if err := syncACLs(ctx, a.ACLClient, principal(model.Name.ValueString()), aclRules(model), nil); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete the ACLs of user %s", model.Name), err.Error())
+		return
+	}
+	_, err := a.UserClient.DeleteUser(ctx, &dataplanev1alpha2.DeleteUserRequest{Name: model.Name.ValueString()})
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete user %s", model.Name), err.Error())
+	}
+}
+
+// ImportState imports the user and its prefixed ACLs from an ID of the form
+// <cluster_id>/<user_name>. The password can't be read back, so it is set
+// again on the next apply.
+func (a *AppIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterID, user, err := utils.ParseClusterImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<user_name>")
+		return
+	}
+
+	client := cloud.NewControlPlaneClientSet(a.resData.ControlPlaneConnection)
+	cluster, err := client.ClusterForID(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<user_name>", clusterID), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(user))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(user))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(cluster.GetDataplaneApi().GetUrl()))...)
+}
+
+func (a *AppIdentity) createClients(clusterURL string) error {
+	if a.UserClient != nil && a.ACLClient != nil { // Clients already started, no need to create others.
+		return nil
+	}
+	if a.dataplaneConn == nil {
+		conn, err := cloud.SpawnConn(clusterURL, a.resData.AuthToken, a.resData.Proxy)
+		if err != nil {
+			return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
+		}
+		a.dataplaneConn = conn
+	}
+	a.UserClient = dataplanev1alpha2grpc.NewUserServiceClient(a.dataplaneConn)
+	a.ACLClient = dataplanev1alpha2grpc.NewACLServiceClient(a.dataplaneConn)
+	return nil
+}
+
+func (a *AppIdentity) closeConn() {
+	if a.dataplaneConn != nil {
+		a.dataplaneConn.Close()
+	}
+}
@@ -0,0 +1,62 @@
+package appidentity
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	if d := resourceAppIdentitySchema().ValidateImplementation(context.Background()); d.HasError() {
+		t.Errorf("Unexpected error in schema: %s", d)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	users := mocks.NewMockUserServiceClient(ctrl)
+	acls := mocks.NewMockACLServiceClient(ctrl)
+	users.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateUserRequest, _ ...any) (*dataplanev1alpha2.CreateUserResponse, error) {
+		assert.Equal(t, "orders-service", req.GetUser().GetName())
+		assert.Equal(t, dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_256, req.GetUser().GetMechanism())
+		return &dataplanev1alpha2.CreateUserResponse{User: &dataplanev1alpha2.CreateUserResponse_User{Name: "orders-service"}}, nil
+	})
+	var created []aclRule
+	acls.EXPECT().CreateACL(gomock.Any(), gomock.Any()).Times(4).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateACLRequest, _ ...any) (*dataplanev1alpha2.CreateACLResponse, error) {
+		assert.Equal(t, "User:orders-service", req.GetPrincipal())
+		assert.Equal(t, dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_PREFIXED, req.GetResourcePatternType())
+		created = append(created, aclRule{req.GetResourceType(), req.GetResourceName(), req.GetOperation()})
+		return &dataplanev1alpha2.CreateACLResponse{}, nil
+	})
+
+	s := resourceAppIdentitySchema()
+	objType := s.Type().TerraformType(ctx)
+	plan := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"name":                  tftypes.NewValue(tftypes.String, "orders-service"),
+		"password":              tftypes.NewValue(tftypes.String, "hunter2"),
+		"mechanism":             tftypes.NewValue(tftypes.String, "scram-sha-256"),
+		"read_topic_prefixes":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "payments.")}),
+		"write_topic_prefixes":  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+		"consumer_group_prefix": tftypes.NewValue(tftypes.String, "orders-"),
+		"cluster_api_url":       tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		"id":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	(&AppIdentity{UserClient: users, ACLClient: acls}).Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []aclRule{
+		{topic, "payments.", opRead},
+		{topic, "payments.", opDescribe},
+		{group, "orders-", opRead},
+		{group, "orders-", opDescribe},
+	}, created)
+}
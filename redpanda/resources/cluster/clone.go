@@ -0,0 +1,207 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dataplaneClients groups the dataplane clients of a cluster, used when
+// clone_from_cluster_id or force_destroy are set.
+type dataplaneClients struct {
+	Topic dataplanev1alpha2grpc.TopicServiceClient
+	ACL   dataplanev1alpha2grpc.ACLServiceClient
+	User  dataplanev1alpha2grpc.UserServiceClient
+}
+
+// cloneCluster copies topics, topic configurations and ACLs from the source
+// cluster to the target cluster. It returns the names of the users found on
+// the source cluster that don't exist on the target, as their credentials
+// can't be copied.
+func (c *Cluster) cloneCluster(ctx context.Context, sourceID, targetURL string) ([]string, error) {
+	source, err := c.CpCl.ClusterForID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find source cluster %q: %v", sourceID, err)
+	}
+	if source.GetDataplaneApi().GetUrl() == "" {
+		return nil, fmt.Errorf("source cluster %q has no cluster API URL", sourceID)
+	}
+	srcConn, err := cloud.SpawnConn(source.GetDataplaneApi().GetUrl(), c.authToken, c.proxy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open a connection with the source cluster API: %v", err)
+	}
+	defer srcConn.Close()
+	dstConn, err := cloud.SpawnConn(targetURL, c.authToken, c.proxy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open a connection with the cluster API: %v", err)
+	}
+	defer dstConn.Close()
+
+	return cloneDataplane(ctx,
+		dataplaneClients{
+			Topic: dataplanev1alpha2grpc.NewTopicServiceClient(srcConn),
+			ACL:   dataplanev1alpha2grpc.NewACLServiceClient(srcConn),
+			User:  dataplanev1alpha2grpc.NewUserServiceClient(srcConn),
+		},
+		dataplaneClients{
+			Topic: dataplanev1alpha2grpc.NewTopicServiceClient(dstConn),
+			ACL:   dataplanev1alpha2grpc.NewACLServiceClient(dstConn),
+			User:  dataplanev1alpha2grpc.NewUserServiceClient(dstConn),
+		},
+	)
+}
+
+// cloneDataplane copies topics and ACLs from src to dst and returns the users
+// that must be recreated by hand.
+func cloneDataplane(ctx context.Context, src, dst dataplaneClients) ([]string, error) {
+	if err := cloneTopics(ctx, src.Topic, dst.Topic); err != nil {
+		return nil, err
+	}
+	if err := cloneACLs(ctx, src.ACL, dst.ACL); err != nil {
+		return nil, err
+	}
+	return missingUsers(ctx, src.User, dst.User)
+}
+
+func cloneTopics(ctx context.Context, src, dst dataplanev1alpha2grpc.TopicServiceClient) error {
+	var pageToken string
+	for {
+		list, err := src.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageToken: pageToken})
+		if err != nil {
+			return fmt.Errorf("unable to list topics of the source cluster: %v", err)
+		}
+		for _, tp := range list.GetTopics() {
+			if tp.GetInternal() {
+				continue
+			}
+			cfgs, err := src.GetTopicConfigurations(ctx, &dataplanev1alpha2.GetTopicConfigurationsRequest{TopicName: tp.GetName()})
+			if err != nil {
+				return fmt.Errorf("unable to retrieve %q topic configuration: %v", tp.GetName(), err)
+			}
+			var topicCfg []*dataplanev1alpha2.CreateTopicRequest_Topic_Config
+			for _, cfg := range cfgs.GetConfigurations() {
+				if cfg.GetSource() != dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG {
+					continue
+				}
+				topicCfg = append(topicCfg, &dataplanev1alpha2.CreateTopicRequest_Topic_Config{
+					Name:  cfg.GetName(),
+					Value: cfg.Value,
+				})
+			}
+			p, rf := tp.GetPartitionCount(), tp.GetReplicationFactor()
+			_, err = dst.CreateTopic(ctx, &dataplanev1alpha2.CreateTopicRequest{
+				Topic: &dataplanev1alpha2.CreateTopicRequest_Topic{
+					Name:              tp.GetName(),
+					PartitionCount:    &p,
+					ReplicationFactor: &rf,
+					Configs:           topicCfg,
+				},
+			})
+			if err != nil && !isAlreadyExistsError(err) {
+				return fmt.Errorf("unable to create topic %q: %v", tp.GetName(), err)
+			}
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" {
+			return nil
+		}
+	}
+}
+
+func cloneACLs(ctx context.Context, src, dst dataplanev1alpha2grpc.ACLServiceClient) error {
+	list, err := src.ListACLs(ctx, &dataplanev1alpha2.ListACLsRequest{
+		Filter: &dataplanev1alpha2.ListACLsRequest_Filter{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_ANY,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_ANY,
+			Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ANY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list ACLs of the source cluster: %v", err)
+	}
+	for _, res := range list.GetResources() {
+		for _, acl := range res.GetAcls() {
+			_, err := dst.CreateACL(ctx, &dataplanev1alpha2.CreateACLRequest{
+				ResourceType:        res.GetResourceType(),
+				ResourceName:        res.GetResourceName(),
+				ResourcePatternType: res.GetResourcePatternType(),
+				Principal:           acl.GetPrincipal(),
+				Host:                acl.GetHost(),
+				Operation:           acl.GetOperation(),
+				PermissionType:      acl.GetPermissionType(),
+			})
+			if err != nil {
+				return fmt.Errorf("unable to create ACL for principal %q on %q: %v", acl.GetPrincipal(), res.GetResourceName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func missingUsers(ctx context.Context, src, dst dataplanev1alpha2grpc.UserServiceClient) ([]string, error) {
+	srcUsers, err := listUserNames(ctx, src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users of the source cluster: %v", err)
+	}
+	dstUsers, err := listUserNames(ctx, dst)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list users of the cluster: %v", err)
+	}
+	existing := make(map[string]bool, len(dstUsers))
+	for _, u := range dstUsers {
+		existing[u] = true
+	}
+	var missing []string
+	for _, u := range srcUsers {
+		if !existing[u] {
+			missing = append(missing, u)
+		}
+	}
+	return missing, nil
+}
+
+func listUserNames(ctx context.Context, client dataplanev1alpha2grpc.UserServiceClient) ([]string, error) {
+	var (
+		names     []string
+		pageToken string
+	)
+	for {
+		list, err := client.ListUsers(ctx, &dataplanev1alpha2.ListUsersRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range list.GetUsers() {
+			names = append(names, u.GetName())
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" {
+			return names, nil
+		}
+	}
+}
+
+func isAlreadyExistsError(err error) bool {
+	return status.Code(err) == codes.AlreadyExists || strings.Contains(err.Error(), "TOPIC_ALREADY_EXISTS")
+}
@@ -0,0 +1,138 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCloneDataplane(t *testing.T) {
+	retention := "86400000"
+	tests := []struct {
+		name      string
+		setup     func(src, dst *mocks.DataplaneMocks)
+		wantUsers []string
+		wantErr   bool
+	}{
+		{
+			name: "copies_topics_and_acls",
+			setup: func(src, dst *mocks.DataplaneMocks) {
+				src.Topic.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+					Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{
+						{Name: "__consumer_offsets", Internal: true, PartitionCount: 3, ReplicationFactor: 3},
+						{Name: "orders", PartitionCount: 6, ReplicationFactor: 3},
+					},
+				}, nil)
+				src.Topic.EXPECT().GetTopicConfigurations(gomock.Any(), &dataplanev1alpha2.GetTopicConfigurationsRequest{TopicName: "orders"}).Return(&dataplanev1alpha2.GetTopicConfigurationsResponse{
+					Configurations: []*dataplanev1alpha2.Topic_Configuration{
+						{Name: "retention.ms", Value: &retention, Source: dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+						{Name: "segment.bytes", Source: dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+					},
+				}, nil)
+				dst.Topic.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateTopicRequest, _ ...any) (*dataplanev1alpha2.CreateTopicResponse, error) {
+					assert.Equal(t, "orders", req.Topic.Name)
+					assert.Equal(t, int32(6), req.Topic.GetPartitionCount())
+					assert.Equal(t, int32(3), req.Topic.GetReplicationFactor())
+					assert.Len(t, req.Topic.Configs, 1)
+					assert.Equal(t, "retention.ms", req.Topic.Configs[0].Name)
+					return &dataplanev1alpha2.CreateTopicResponse{}, nil
+				})
+				src.ACL.EXPECT().ListACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListACLsResponse{
+					Resources: []*dataplanev1alpha2.ListACLsResponse_Resource{{
+						ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+						ResourceName:        "orders",
+						ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+						Acls: []*dataplanev1alpha2.ListACLsResponse_Policy{{
+							Principal:      "User:app",
+							Host:           "*",
+							Operation:      dataplanev1alpha2.ACL_OPERATION_READ,
+							PermissionType: dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+						}},
+					}},
+				}, nil)
+				dst.ACL.EXPECT().CreateACL(gomock.Any(), &dataplanev1alpha2.CreateACLRequest{
+					ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+					ResourceName:        "orders",
+					ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+					Principal:           "User:app",
+					Host:                "*",
+					Operation:           dataplanev1alpha2.ACL_OPERATION_READ,
+					PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+				}).Return(&dataplanev1alpha2.CreateACLResponse{}, nil)
+				src.User.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListUsersResponse{
+					Users: []*dataplanev1alpha2.ListUsersResponse_User{{Name: "app"}, {Name: "admin"}},
+				}, nil)
+				dst.User.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListUsersResponse{
+					Users: []*dataplanev1alpha2.ListUsersResponse_User{{Name: "admin"}},
+				}, nil)
+			},
+			wantUsers: []string{"app"},
+		},
+		{
+			name: "existing_topic_is_skipped",
+			setup: func(src, dst *mocks.DataplaneMocks) {
+				src.Topic.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+					Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "_schemas", PartitionCount: 1, ReplicationFactor: 3}},
+				}, nil)
+				src.Topic.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.GetTopicConfigurationsResponse{}, nil)
+				dst.Topic.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, "topic exists"))
+				src.ACL.EXPECT().ListACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListACLsResponse{}, nil)
+				src.User.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListUsersResponse{}, nil)
+				dst.User.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListUsersResponse{}, nil)
+			},
+		},
+		{
+			name: "paginated_lists",
+			setup: func(src, dst *mocks.DataplaneMocks) {
+				mocks.ExpectListTopics(src.Topic, 2, mocks.Topic("a", 1), mocks.Topic("b", 1), mocks.Topic("c", 1))
+				src.Topic.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(mocks.TopicConfigurations(2), nil).Times(3)
+				dst.Topic.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateTopicRequest, _ ...any) (*dataplanev1alpha2.CreateTopicResponse, error) {
+					assert.Len(t, req.Topic.Configs, 2)
+					return &dataplanev1alpha2.CreateTopicResponse{}, nil
+				}).Times(3)
+				src.ACL.EXPECT().ListACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListACLsResponse{}, nil)
+				mocks.ExpectListUsers(src.User, 1, "app", "admin", "ops")
+				mocks.ExpectListUsers(dst.User, 0, "admin")
+			},
+			wantUsers: []string{"app", "ops"},
+		},
+		{
+			name: "topic_creation_fails",
+			setup: func(src, dst *mocks.DataplaneMocks) {
+				src.Topic.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+					Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "orders", PartitionCount: 6, ReplicationFactor: 3}},
+				}, nil)
+				src.Topic.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.GetTopicConfigurationsResponse{}, nil)
+				dst.Topic.EXPECT().CreateTopic(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			src, dst := mocks.NewDataplaneMocks(ctrl), mocks.NewDataplaneMocks(ctrl)
+			tt.setup(src, dst)
+
+			got, err := cloneDataplane(context.Background(), clientsOf(src), clientsOf(dst))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUsers, got)
+		})
+	}
+}
+
+func clientsOf(m *mocks.DataplaneMocks) dataplaneClients {
+	return dataplaneClients{Topic: m.Topic, ACL: m.ACL, User: m.User}
+}
@@ -17,13 +17,19 @@ package cluster
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc/codes"
 )
 
 func gcpConnectConsumerModelToStruct(accept []*models.GcpPrivateServiceConnectConsumer) []*controlplanev1beta2.GCPPrivateServiceConnectConsumer {
@@ -112,6 +118,174 @@ func isAwsPrivateLinkSpecNil(m *controlplanev1beta2.AWSPrivateLinkStatus) bool {
 	return m == nil || (!m.Enabled && !m.ConnectConsole && len(m.AllowedPrincipals) == 0)
 }
 
+// awsPrivateLinkListenerPortsType is the object type of the computed
+// aws_private_link.listener_ports attribute.
+var awsPrivateLinkListenerPortsType = map[string]attr.Type{
+	"kafka_api_seed_port":           types.Int64Type,
+	"kafka_api_node_base_port":      types.Int64Type,
+	"redpanda_proxy_seed_port":      types.Int64Type,
+	"redpanda_proxy_node_base_port": types.Int64Type,
+	"schema_registry_seed_port":     types.Int64Type,
+	"console_port":                  types.Int64Type,
+}
+
+// toAwsPrivateLinkListenerPorts returns the listener ports of the AWS Private
+// Link Endpoint Service, or a null object if the service is not yet reported.
+func toAwsPrivateLinkListenerPorts(status *controlplanev1beta2.AWSPrivateLinkStatus_Status) types.Object {
+	if status == nil {
+		return types.ObjectNull(awsPrivateLinkListenerPortsType)
+	}
+	return types.ObjectValueMust(awsPrivateLinkListenerPortsType, map[string]attr.Value{
+		"kafka_api_seed_port":           types.Int64Value(int64(status.KafkaApiSeedPort)),
+		"kafka_api_node_base_port":      types.Int64Value(int64(status.KafkaApiNodeBasePort)),
+		"redpanda_proxy_seed_port":      types.Int64Value(int64(status.RedpandaProxySeedPort)),
+		"redpanda_proxy_node_base_port": types.Int64Value(int64(status.RedpandaProxyNodeBasePort)),
+		"schema_registry_seed_port":     types.Int64Value(int64(status.SchemaRegistrySeedPort)),
+		"console_port":                  types.Int64Value(int64(status.ConsolePort)),
+	})
+}
+
+// toClusterEndpoints returns the connection information of the cluster.
+// Endpoints that are not yet reported are null.
+func toClusterEndpoints(cluster *controlplanev1beta2.Cluster) *models.ClusterEndpoints {
+	return &models.ClusterEndpoints{
+		BootstrapServers:           utils.StringSliceToTypeList(cluster.GetKafkaApi().GetSeedBrokers()),
+		SchemaRegistryURL:          nonEmptyString(cluster.GetSchemaRegistry().GetUrl()),
+		HTTPProxyURL:               nonEmptyString(cluster.GetHttpProxy().GetUrl()),
+		ConsoleURL:                 nonEmptyString(cluster.GetRedpandaConsole().GetUrl()),
+		ClusterAPIURL:              nonEmptyString(cluster.GetDataplaneApi().GetUrl()),
+		PrometheusURL:              nonEmptyString(cluster.GetPrometheus().GetUrl()),
+		KafkaAPIMtlsRequired:       types.BoolValue(cluster.GetKafkaApi().GetMtls().GetEnabled()),
+		HTTPProxyMtlsRequired:      types.BoolValue(cluster.GetHttpProxy().GetMtls().GetEnabled()),
+		SchemaRegistryMtlsRequired: types.BoolValue(cluster.GetSchemaRegistry().GetMtls().GetEnabled()),
+	}
+}
+
+// clusterConnectionURLs returns the kafka_bootstrap_servers,
+// schema_registry_url and http_proxy_url attributes. Unlike the sensitive
+// endpoints attribute, they can be passed to application configuration and
+// outputs as they are.
+func clusterConnectionURLs(cluster *controlplanev1beta2.Cluster) (types.List, types.String, types.String) {
+	seeds := types.ListValueMust(types.StringType, []attr.Value{})
+	if brokers := cluster.GetKafkaApi().GetSeedBrokers(); len(brokers) > 0 {
+		seeds = utils.StringSliceToTypeList(brokers)
+	}
+	return seeds, nonEmptyString(cluster.GetSchemaRegistry().GetUrl()), nonEmptyString(cluster.GetHttpProxy().GetUrl())
+}
+
+// toClusterListeners returns the host names and ports of the listeners of the
+// cluster, or nil if the seed brokers are not yet reported.
+func toClusterListeners(cluster *controlplanev1beta2.Cluster) *models.ClusterListeners {
+	seeds := cluster.GetKafkaApi().GetSeedBrokers()
+	if len(seeds) == 0 {
+		return nil
+	}
+	var hosts []string
+	var ports []int64
+	for _, seed := range seeds {
+		host, port, err := net.SplitHostPort(seed)
+		if err != nil {
+			// a seed broker without port
+			host = seed
+		}
+		if !slices.Contains(hosts, host) {
+			hosts = append(hosts, host)
+		}
+		if p, err := strconv.ParseInt(port, 10, 64); err == nil && !slices.Contains(ports, p) {
+			ports = append(ports, p)
+		}
+	}
+	slices.Sort(ports)
+	portValues := make([]attr.Value, 0, len(ports))
+	for _, p := range ports {
+		portValues = append(portValues, types.Int64Value(p))
+	}
+	return &models.ClusterListeners{
+		SeedBrokerCount:    types.Int64Value(int64(len(seeds))),
+		KafkaAPIHosts:      utils.StringSliceToTypeList(hosts),
+		KafkaAPIPorts:      types.ListValueMust(types.Int64Type, portValues),
+		HTTPProxyPort:      urlPort(cluster.GetHttpProxy().GetUrl()),
+		SchemaRegistryPort: urlPort(cluster.GetSchemaRegistry().GetUrl()),
+	}
+}
+
+// urlPort returns the port of the URL, the default port of its scheme if it
+// has none, or null if the URL is not reported.
+func urlPort(rawURL string) types.Int64 {
+	u, err := url.Parse(rawURL)
+	if rawURL == "" || err != nil {
+		return types.Int64Null()
+	}
+	if p, err := strconv.ParseInt(u.Port(), 10, 64); err == nil {
+		return types.Int64Value(p)
+	}
+	switch u.Scheme {
+	case "https":
+		return types.Int64Value(443)
+	case "http":
+		return types.Int64Value(80)
+	}
+	return types.Int64Null()
+}
+
+func nonEmptyString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// gcpConsumerStatusType is the object type of the elements of the computed
+// gcp_private_service_connect.consumer_status attribute.
+var gcpConsumerStatusType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"source":           types.StringType,
+	"connection_id":    types.StringType,
+	"consumer_network": types.StringType,
+	"endpoint":         types.StringType,
+	"status":           types.StringType,
+}}
+
+// toGcpConsumerStatus returns the status of the connections to the GCP Private
+// Service Connect service attachment: one element per connected endpoint,
+// with the accept list entry of its project as source, followed by one
+// element without connection for each accept list entry that has none.
+func toGcpConsumerStatus(psc *controlplanev1beta2.GCPPrivateServiceConnectStatus) types.List {
+	var (
+		elems     []attr.Value
+		connected = map[string]bool{}
+	)
+	for _, ep := range psc.GetStatus().GetConnectedEndpoints() {
+		source := types.StringNull()
+		for _, c := range psc.GetConsumerAcceptList() {
+			if strings.Contains(ep.GetConsumerNetwork(), "projects/"+c.GetSource()+"/") {
+				source = types.StringValue(c.GetSource())
+				connected[c.GetSource()] = true
+				break
+			}
+		}
+		elems = append(elems, types.ObjectValueMust(gcpConsumerStatusType.AttrTypes, map[string]attr.Value{
+			"source":           source,
+			"connection_id":    nonEmptyString(ep.GetConnectionId()),
+			"consumer_network": nonEmptyString(ep.GetConsumerNetwork()),
+			"endpoint":         nonEmptyString(ep.GetEndpoint()),
+			"status":           nonEmptyString(ep.GetStatus()),
+		}))
+	}
+	for _, c := range psc.GetConsumerAcceptList() {
+		if connected[c.GetSource()] {
+			continue
+		}
+		elems = append(elems, types.ObjectValueMust(gcpConsumerStatusType.AttrTypes, map[string]attr.Value{
+			"source":           types.StringValue(c.GetSource()),
+			"connection_id":    types.StringNull(),
+			"consumer_network": types.StringNull(),
+			"endpoint":         types.StringNull(),
+			"status":           types.StringNull(),
+		}))
+	}
+	return types.ListValueMust(gcpConsumerStatusType, elems)
+}
+
 func isAzurePrivateLinkStructNil(m *models.AzurePrivateLink) bool {
 	return m == nil || (m.Enabled.IsNull() && m.AllowedSubscriptions.IsNull() && m.ConnectConsole.IsNull())
 }
@@ -151,7 +325,7 @@ func generateClusterRequest(model models.Cluster) (*controlplanev1beta2.ClusterC
 		ResourceGroupId:   model.ResourceGroupID.ValueString(),
 		NetworkId:         model.NetworkID.ValueString(),
 		Type:              clusterType,
-		CloudProviderTags: utils.TypeMapToStringMap(model.Tags),
+		CloudProviderTags: clusterTags(model),
 	}
 	if !isAwsPrivateLinkStructNil(model.AwsPrivateLink) {
 		output.AwsPrivateLink = &controlplanev1beta2.AWSPrivateLinkSpec{
@@ -194,6 +368,8 @@ func generateClusterRequest(model models.Cluster) (*controlplanev1beta2.ClusterC
 	if !model.ReadReplicaClusterIDs.IsNull() {
 		output.ReadReplicaClusterIds = utils.TypeListToStringSlice(model.ReadReplicaClusterIDs)
 	}
+	output.MaintenanceWindowConfig = toMaintenanceWindowConfig(model.MaintenanceWindowConfig)
+	output.CustomerManagedResources = toCustomerManagedResources(model.CustomerManagedResources)
 
 	return output, nil
 }
@@ -206,7 +382,9 @@ func generateClusterUpdate(cluster models.Cluster) *controlplanev1beta2.ClusterU
 		Id:                    cluster.ID.ValueString(),
 		Name:                  cluster.Name.ValueString(),
 		ReadReplicaClusterIds: utils.TypeListToStringSlice(cluster.ReadReplicaClusterIDs),
+		CloudProviderTags:     clusterTags(cluster),
 	}
+	update.MaintenanceWindowConfig = toMaintenanceWindowConfig(cluster.MaintenanceWindowConfig)
 
 	if !isAwsPrivateLinkStructNil(cluster.AwsPrivateLink) {
 		update.AwsPrivateLink = &controlplanev1beta2.AWSPrivateLinkSpec{
@@ -258,7 +436,11 @@ func generateUpdateRequest(plan, state models.Cluster) *controlplanev1beta2.Upda
 	planUpdate := generateClusterUpdate(plan)
 	stateUpdate := generateClusterUpdate(state)
 
-	update, fieldmask := utils.GenerateProtobufDiffAndUpdateMask(planUpdate, stateUpdate)
+	// these lists are sets to the API, only the order of mTLS principal
+	// mapping rules is significant
+	update, fieldmask := utils.GenerateProtobufDiffAndUpdateMask(planUpdate, stateUpdate,
+		utils.WithUnorderedFields("allowed_principals", "allowed_subscriptions", "consumer_accept_list", "read_replica_cluster_ids", "ca_certificates_pem"),
+	)
 	update.Id = planUpdate.Id
 	return &controlplanev1beta2.UpdateClusterRequest{
 		Cluster:    update,
@@ -278,16 +460,29 @@ func generateModel(cfg models.Cluster, cluster *controlplanev1beta2.Cluster) (*m
 		Region:                types.StringValue(cluster.Region),
 		AllowDeletion:         cfg.AllowDeletion,
 		Tags:                  cfg.Tags,
-		ResourceGroupID:       types.StringValue(cluster.ResourceGroupId),
-		NetworkID:             types.StringValue(cluster.NetworkId),
+		TagsAll:               cfg.TagsAll,
+		ResourceGroupID:       referenceValue(cfg.ResourceGroupID, cluster.ResourceGroupId),
+		NetworkID:             referenceValue(cfg.NetworkID, cluster.NetworkId),
 		ID:                    types.StringValue(cluster.Id),
 		ReadReplicaClusterIDs: utils.StringSliceToTypeList(cluster.ReadReplicaClusterIds),
 		Zones:                 utils.StringSliceToTypeList(cluster.Zones),
 	}
 
+	if output.TagsAll.IsNull() || output.TagsAll.IsUnknown() {
+		// imported, or planned before tags_all existed
+		output.TagsAll = tagsAllValue(cluster.CloudProviderTags)
+	}
 	if cluster.GetDataplaneApi() != nil {
 		output.ClusterAPIURL = types.StringValue(cluster.DataplaneApi.Url)
 	}
+	output.Status, output.StatusReasons = clusterStatus(cluster)
+	output.ByocAgentStatus = byocAgentStatus(cluster)
+	output.IsReadReplicaSource = isReadReplicaSource(output.ReadReplicaClusterIDs)
+	output.Endpoints = toClusterEndpoints(cluster)
+	output.KafkaBootstrapServers, output.SchemaRegistryURL, output.HTTPProxyURL = clusterConnectionURLs(cluster)
+	output.Listeners = toClusterListeners(cluster)
+	output.MaintenanceWindowConfig = toMaintenanceWindowModel(cluster.GetMaintenanceWindowConfig())
+	output.CustomerManagedResources = toCustomerManagedResourcesModel(cluster.GetCustomerManagedResources())
 
 	if !isAwsPrivateLinkSpecNil(cluster.AwsPrivateLink) {
 		ap := utils.StringSliceToTypeList(cluster.AwsPrivateLink.AllowedPrincipals)
@@ -299,6 +494,7 @@ func generateModel(cfg models.Cluster, cluster *controlplanev1beta2.Cluster) (*m
 			Enabled:           types.BoolValue(cluster.AwsPrivateLink.Enabled),
 			ConnectConsole:    types.BoolValue(cluster.AwsPrivateLink.ConnectConsole),
 			AllowedPrincipals: ap,
+			ListenerPorts:     toAwsPrivateLinkListenerPorts(cluster.AwsPrivateLink.GetStatus()),
 		}
 	}
 	if !isGcpPrivateServiceConnectSpecNil(cluster.GcpPrivateServiceConnect) {
@@ -306,6 +502,7 @@ func generateModel(cfg models.Cluster, cluster *controlplanev1beta2.Cluster) (*m
 			Enabled:             types.BoolValue(cluster.GcpPrivateServiceConnect.Enabled),
 			GlobalAccessEnabled: types.BoolValue(cluster.GcpPrivateServiceConnect.GlobalAccessEnabled),
 			ConsumerAcceptList:  gcpConnectConsumerStructToModel(cluster.GcpPrivateServiceConnect.ConsumerAcceptList),
+			ConsumerStatus:      toGcpConsumerStatus(cluster.GcpPrivateServiceConnect),
 		}
 	}
 
@@ -343,18 +540,96 @@ func generateModel(cfg models.Cluster, cluster *controlplanev1beta2.Cluster) (*m
 	return output, nil
 }
 
+// isClusterDeleting reports whether the cluster is in one of the deleting states.
+func isClusterDeleting(cluster *controlplanev1beta2.Cluster) bool {
+	return cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING || cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING_AGENT
+}
+
 // generateMinimalModel populates a Cluster model with only enough state for Terraform to
 // track an existing cluster and to delete it, if necessary. Used in creation to track
-// partially created clusters, and on reading to null out cluster that are found in the
-// deleting state and force them to be recreated.
+// partially created clusters.
 func generateMinimalModel(clusterID string) models.Cluster {
 	// Terraform requires us to explicitly pass types to the collection values, even
 	// when null :/
 	return models.Cluster{
 		AllowDeletion:         types.BoolValue(true),
 		ID:                    types.StringValue(clusterID),
+		KafkaBootstrapServers: types.ListNull(types.StringType),
 		ReadReplicaClusterIDs: types.ListNull(types.StringType),
+		StatusReasons:         types.ListNull(types.StringType),
 		Tags:                  types.MapNull(types.StringType),
+		TagsAll:               types.MapNull(types.StringType),
 		Zones:                 types.ListNull(types.StringType),
 	}
 }
+
+const (
+	statusDescription = "Lifecycle status of the cluster, derived from its state: provisioning, ready, degraded, " +
+		"upgrading, failed, deleting, suspended or unknown. A ready cluster reporting an error is degraded."
+	statusReasonsDescription   = "Reasons reported by Redpanda Cloud for the current status, if any."
+	byocAgentStatusDescription = "Status of the Redpanda agent that provisions a BYOC cluster in your cloud account: " +
+		"pending while the agent has not been deployed, provisioned once it has, deleting while it is torn down, or unknown. " +
+		"Null for clusters that are not BYOC."
+	listenersDescription = "Host names and ports of the listeners of the cluster, to open firewalls or security " +
+		"groups to it without hard-coding ports that differ between cluster types. Null until the seed brokers are reported."
+)
+
+// byocAgentStatus maps the state of a BYOC cluster to the status of its agent.
+// The agent is deployed by the byoc plugin when the cluster reaches
+// STATE_CREATING_AGENT, and removed by it at STATE_DELETING_AGENT.
+func byocAgentStatus(cluster *controlplanev1beta2.Cluster) types.String {
+	if cluster.GetType() != controlplanev1beta2.Cluster_TYPE_BYOC {
+		return types.StringNull()
+	}
+	switch cluster.GetState() {
+	case controlplanev1beta2.Cluster_STATE_CREATING_AGENT:
+		return types.StringValue("pending")
+	case controlplanev1beta2.Cluster_STATE_CREATING,
+		controlplanev1beta2.Cluster_STATE_READY,
+		controlplanev1beta2.Cluster_STATE_UPGRADING,
+		controlplanev1beta2.Cluster_STATE_SUSPENDED,
+		controlplanev1beta2.Cluster_STATE_DELETING:
+		return types.StringValue("provisioned")
+	case controlplanev1beta2.Cluster_STATE_DELETING_AGENT:
+		return types.StringValue("deleting")
+	default:
+		return types.StringValue("unknown")
+	}
+}
+
+// clusterStatus maps the state of the cluster and its description to the
+// status and status_reasons attributes.
+func clusterStatus(cluster *controlplanev1beta2.Cluster) (types.String, types.List) {
+	desc := cluster.GetStateDescription()
+	var reasons []string
+	if msg := strings.TrimSpace(desc.GetMessage()); msg != "" {
+		reasons = append(reasons, msg)
+	}
+
+	var status string
+	switch cluster.GetState() {
+	case controlplanev1beta2.Cluster_STATE_CREATING_AGENT, controlplanev1beta2.Cluster_STATE_CREATING:
+		status = "provisioning"
+	case controlplanev1beta2.Cluster_STATE_READY:
+		status = "ready"
+		if desc.GetCode() != int32(codes.OK) {
+			status = "degraded"
+		}
+	case controlplanev1beta2.Cluster_STATE_UPGRADING:
+		status = "upgrading"
+	case controlplanev1beta2.Cluster_STATE_FAILED:
+		status = "failed"
+	case controlplanev1beta2.Cluster_STATE_DELETING, controlplanev1beta2.Cluster_STATE_DELETING_AGENT:
+		status = "deleting"
+	case controlplanev1beta2.Cluster_STATE_SUSPENDED:
+		status = "suspended"
+	default:
+		status = "unknown"
+	}
+
+	list := types.ListValueMust(types.StringType, []attr.Value{})
+	if len(reasons) > 0 {
+		list = utils.StringSliceToTypeList(reasons)
+	}
+	return types.StringValue(status), list
+}
@@ -1,17 +1,32 @@
 package cluster
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"testing"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/testutil"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"github.com/stretchr/testify/assert"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 func TestGenerateClusterRequest(t *testing.T) {
@@ -93,6 +108,7 @@ func TestGenerateModel(t *testing.T) {
 			},
 			cluster: &controlplanev1beta2.Cluster{
 				Id:              "cl-789",
+				State:           controlplanev1beta2.Cluster_STATE_READY,
 				Name:            "test-cluster",
 				ConnectionType:  controlplanev1beta2.Cluster_CONNECTION_TYPE_PUBLIC,
 				CloudProvider:   controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS,
@@ -104,7 +120,9 @@ func TestGenerateModel(t *testing.T) {
 				ResourceGroupId: "rg-123",
 				NetworkId:       "net-456",
 				DataplaneApi:    &controlplanev1beta2.Cluster_DataplaneAPI{Url: "https://test-cluster.rptest.io:443"},
+				RedpandaConsole: &controlplanev1beta2.Cluster_RedpandaConsole{Url: "https://console-test-cluster.rptest.io"},
 				KafkaApi: &controlplanev1beta2.Cluster_KafkaAPI{
+					SeedBrokers: []string{"seed-test-cluster.rptest.io:9092"},
 					Mtls: &controlplanev1beta2.MTLSSpec{
 						Enabled:               false,
 						CaCertificatesPem:     make([]string, 0),
@@ -112,6 +130,7 @@ func TestGenerateModel(t *testing.T) {
 					},
 				},
 				HttpProxy: &controlplanev1beta2.Cluster_HTTPProxyStatus{
+					Url: "https://pandaproxy-test-cluster.rptest.io:30082",
 					Mtls: &controlplanev1beta2.MTLSSpec{
 						Enabled:               false,
 						CaCertificatesPem:     make([]string, 0),
@@ -119,6 +138,7 @@ func TestGenerateModel(t *testing.T) {
 					},
 				},
 				SchemaRegistry: &controlplanev1beta2.Cluster_SchemaRegistryStatus{
+					Url: "https://schema-registry-test-cluster.rptest.io:30081",
 					Mtls: &controlplanev1beta2.MTLSSpec{
 						Enabled:               false,
 						CaCertificatesPem:     make([]string, 0),
@@ -127,6 +147,7 @@ func TestGenerateModel(t *testing.T) {
 				},
 			},
 			expected: &models.Cluster{
+				TagsAll:               types.MapNull(types.StringType),
 				Name:                  types.StringValue("test-cluster"),
 				ConnectionType:        types.StringValue("public"),
 				CloudProvider:         types.StringValue("aws"),
@@ -138,9 +159,33 @@ func TestGenerateModel(t *testing.T) {
 				NetworkID:             types.StringValue("net-456"),
 				ID:                    types.StringValue("cl-789"),
 				ClusterAPIURL:         types.StringValue("https://test-cluster.rptest.io:443"),
+				KafkaBootstrapServers: utils.StringSliceToTypeList([]string{"seed-test-cluster.rptest.io:9092"}),
+				SchemaRegistryURL:     types.StringValue("https://schema-registry-test-cluster.rptest.io:30081"),
+				HTTPProxyURL:          types.StringValue("https://pandaproxy-test-cluster.rptest.io:30082"),
+				Status:                types.StringValue("ready"),
+				StatusReasons:         types.ListValueMust(types.StringType, []attr.Value{}),
 				ReadReplicaClusterIDs: basetypes.NewListNull(types.StringType),
+				IsReadReplicaSource:   types.BoolValue(false),
 				Zones:                 utils.StringSliceToTypeList([]string{"us-west-2a", "us-west-2b"}),
 				AllowDeletion:         types.BoolValue(false),
+				Endpoints: &models.ClusterEndpoints{
+					BootstrapServers:           utils.StringSliceToTypeList([]string{"seed-test-cluster.rptest.io:9092"}),
+					SchemaRegistryURL:          types.StringValue("https://schema-registry-test-cluster.rptest.io:30081"),
+					HTTPProxyURL:               types.StringValue("https://pandaproxy-test-cluster.rptest.io:30082"),
+					ConsoleURL:                 types.StringValue("https://console-test-cluster.rptest.io"),
+					ClusterAPIURL:              types.StringValue("https://test-cluster.rptest.io:443"),
+					PrometheusURL:              types.StringNull(),
+					KafkaAPIMtlsRequired:       types.BoolValue(false),
+					HTTPProxyMtlsRequired:      types.BoolValue(false),
+					SchemaRegistryMtlsRequired: types.BoolValue(false),
+				},
+				Listeners: &models.ClusterListeners{
+					SeedBrokerCount:    types.Int64Value(1),
+					KafkaAPIHosts:      utils.StringSliceToTypeList([]string{"seed-test-cluster.rptest.io"}),
+					KafkaAPIPorts:      types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(9092)}),
+					HTTPProxyPort:      types.Int64Value(30082),
+					SchemaRegistryPort: types.Int64Value(30081),
+				},
 			},
 			wantErr: false,
 		},
@@ -174,6 +219,7 @@ func TestGenerateModel(t *testing.T) {
 				DataplaneApi:    &controlplanev1beta2.Cluster_DataplaneAPI{Url: "https://gcp-private-cluster.rptest.io:443"},
 			},
 			expected: &models.Cluster{
+				TagsAll:               types.MapNull(types.StringType),
 				Name:                  types.StringValue("gcp-private-cluster"),
 				ConnectionType:        types.StringValue("private"),
 				CloudProvider:         types.StringValue("gcp"),
@@ -185,9 +231,16 @@ func TestGenerateModel(t *testing.T) {
 				NetworkID:             types.StringValue("net-789"),
 				ID:                    types.StringValue("cl-101"),
 				ClusterAPIURL:         types.StringValue("https://gcp-private-cluster.rptest.io:443"),
+				KafkaBootstrapServers: types.ListValueMust(types.StringType, []attr.Value{}),
+				SchemaRegistryURL:     types.StringNull(),
+				HTTPProxyURL:          types.StringNull(),
+				Endpoints:             testEndpoints("https://gcp-private-cluster.rptest.io:443", false),
+				Status:                types.StringValue("unknown"),
+				StatusReasons:         types.ListValueMust(types.StringType, []attr.Value{}),
 				Zones:                 utils.StringSliceToTypeList([]string{"us-central1-a", "us-central1-b", "us-central1-c"}),
 				AllowDeletion:         types.BoolValue(true),
 				ReadReplicaClusterIDs: basetypes.NewListNull(types.StringType),
+				IsReadReplicaSource:   types.BoolValue(false),
 			},
 			wantErr: false,
 		},
@@ -222,6 +275,7 @@ func TestGenerateModel(t *testing.T) {
 				},
 			},
 			expected: &models.Cluster{
+				TagsAll:               types.MapNull(types.StringType),
 				Name:                  types.StringValue("aws-mtls-cluster"),
 				ConnectionType:        types.StringValue("public"),
 				CloudProvider:         types.StringValue("aws"),
@@ -232,7 +286,14 @@ func TestGenerateModel(t *testing.T) {
 				ResourceGroupID:       types.StringValue("rg-789"),
 				NetworkID:             types.StringValue("net-101"),
 				ClusterAPIURL:         types.StringValue("https://aws-mtls-cluster.rptest.io:443"),
+				KafkaBootstrapServers: types.ListValueMust(types.StringType, []attr.Value{}),
+				SchemaRegistryURL:     types.StringNull(),
+				HTTPProxyURL:          types.StringNull(),
+				Endpoints:             testEndpoints("https://aws-mtls-cluster.rptest.io:443", true),
+				Status:                types.StringValue("unknown"),
+				StatusReasons:         types.ListValueMust(types.StringType, []attr.Value{}),
 				ReadReplicaClusterIDs: utils.StringSliceToTypeList([]string{""}),
+				IsReadReplicaSource:   types.BoolValue(true),
 				Zones:                 utils.StringSliceToTypeList([]string{"eu-west-1a"}),
 				KafkaAPI: &models.KafkaAPI{
 					Mtls: &models.Mtls{
@@ -272,25 +333,49 @@ func TestGenerateModel(t *testing.T) {
 				AwsPrivateLink: &controlplanev1beta2.AWSPrivateLinkStatus{
 					Enabled:           true,
 					AllowedPrincipals: []string{"arn:aws:iam::123456789012:root"},
+					Status: &controlplanev1beta2.AWSPrivateLinkStatus_Status{
+						KafkaApiSeedPort:          30292,
+						KafkaApiNodeBasePort:      32000,
+						RedpandaProxySeedPort:     30282,
+						RedpandaProxyNodeBasePort: 35000,
+						SchemaRegistrySeedPort:    30081,
+						ConsolePort:               443,
+					},
 				},
 			},
 			expected: &models.Cluster{
+				TagsAll:               types.MapNull(types.StringType),
 				Name:                  types.StringValue("gcp-aws-pl-cluster"),
 				ConnectionType:        types.StringValue("public"),
 				CloudProvider:         types.StringValue("gcp"),
 				ClusterType:           types.StringValue("dedicated"),
 				ID:                    types.StringValue("cl-303"),
 				ClusterAPIURL:         types.StringValue("https://gcp-aws-pl-cluster.rptest.io:443"),
+				KafkaBootstrapServers: types.ListValueMust(types.StringType, []attr.Value{}),
+				SchemaRegistryURL:     types.StringNull(),
+				HTTPProxyURL:          types.StringNull(),
+				Endpoints:             testEndpoints("https://gcp-aws-pl-cluster.rptest.io:443", false),
+				Status:                types.StringValue("unknown"),
+				StatusReasons:         types.ListValueMust(types.StringType, []attr.Value{}),
 				ThroughputTier:        types.StringValue("t3"),
 				NetworkID:             types.StringValue("net-303"),
 				ResourceGroupID:       types.StringValue("123"),
 				Zones:                 utils.StringSliceToTypeList([]string{"eu-west-1a"}),
 				ReadReplicaClusterIDs: utils.StringSliceToTypeList([]string{""}),
+				IsReadReplicaSource:   types.BoolValue(true),
 				Region:                types.StringValue("eu-west-1"),
 				AwsPrivateLink: &models.AwsPrivateLink{
 					Enabled:           types.BoolValue(true),
 					AllowedPrincipals: utils.StringSliceToTypeList([]string{"arn:aws:iam::123456789012:root"}),
 					ConnectConsole:    types.BoolValue(false),
+					ListenerPorts: types.ObjectValueMust(awsPrivateLinkListenerPortsType, map[string]attr.Value{
+						"kafka_api_seed_port":           types.Int64Value(30292),
+						"kafka_api_node_base_port":      types.Int64Value(32000),
+						"redpanda_proxy_seed_port":      types.Int64Value(30282),
+						"redpanda_proxy_node_base_port": types.Int64Value(35000),
+						"schema_registry_seed_port":     types.Int64Value(30081),
+						"console_port":                  types.Int64Value(443),
+					}),
 				},
 			},
 			wantErr: false,
@@ -328,10 +413,22 @@ func TestGenerateModel(t *testing.T) {
 					GlobalAccessEnabled: false,
 					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
 						{Source: "projects/123456789012/regions/us-central1/serviceAttachments/sa-1"},
+						{Source: "consumer-project"},
+					},
+					Status: &controlplanev1beta2.GCPPrivateServiceConnectStatus_Status{
+						ConnectedEndpoints: []*controlplanev1beta2.GCPPrivateServiceConnectStatus_Status_ConnectedEndpoint{
+							{
+								ConnectionId:    "1234",
+								ConsumerNetwork: "https://www.googleapis.com/compute/v1/projects/consumer-project/global/networks/default",
+								Endpoint:        "10.0.0.5",
+								Status:          "ACCEPTED",
+							},
+						},
 					},
 				},
 			},
 			expected: &models.Cluster{
+				TagsAll:               types.MapNull(types.StringType),
 				Name:                  types.StringValue("aws-gcp-psc-cluster"),
 				ConnectionType:        types.StringValue("private"),
 				CloudProvider:         types.StringValue("aws"),
@@ -342,14 +439,38 @@ func TestGenerateModel(t *testing.T) {
 				ResourceGroupID:       types.StringValue("rg-404"),
 				NetworkID:             types.StringValue("net-404"),
 				ReadReplicaClusterIDs: utils.StringSliceToTypeList([]string{""}),
+				IsReadReplicaSource:   types.BoolValue(true),
 				Zones:                 utils.StringSliceToTypeList([]string{"us-central1-a"}),
 				ClusterAPIURL:         types.StringValue("https://aws-gcp-psc-cluster.rptest.io:443"),
+				KafkaBootstrapServers: types.ListValueMust(types.StringType, []attr.Value{}),
+				SchemaRegistryURL:     types.StringNull(),
+				HTTPProxyURL:          types.StringNull(),
+				Endpoints:             testEndpoints("https://aws-gcp-psc-cluster.rptest.io:443", false),
+				Status:                types.StringValue("unknown"),
+				StatusReasons:         types.ListValueMust(types.StringType, []attr.Value{}),
 				GcpPrivateServiceConnect: &models.GcpPrivateServiceConnect{
 					Enabled:             types.BoolValue(true),
 					GlobalAccessEnabled: types.BoolValue(false),
 					ConsumerAcceptList: []*models.GcpPrivateServiceConnectConsumer{
 						{Source: "projects/123456789012/regions/us-central1/serviceAttachments/sa-1"},
+						{Source: "consumer-project"},
 					},
+					ConsumerStatus: types.ListValueMust(gcpConsumerStatusType, []attr.Value{
+						types.ObjectValueMust(gcpConsumerStatusType.AttrTypes, map[string]attr.Value{
+							"source":           types.StringValue("consumer-project"),
+							"connection_id":    types.StringValue("1234"),
+							"consumer_network": types.StringValue("https://www.googleapis.com/compute/v1/projects/consumer-project/global/networks/default"),
+							"endpoint":         types.StringValue("10.0.0.5"),
+							"status":           types.StringValue("ACCEPTED"),
+						}),
+						types.ObjectValueMust(gcpConsumerStatusType.AttrTypes, map[string]attr.Value{
+							"source":           types.StringValue("projects/123456789012/regions/us-central1/serviceAttachments/sa-1"),
+							"connection_id":    types.StringNull(),
+							"consumer_network": types.StringNull(),
+							"endpoint":         types.StringNull(),
+							"status":           types.StringNull(),
+						}),
+					}),
 				},
 			},
 			wantErr: false,
@@ -370,6 +491,70 @@ func TestGenerateModel(t *testing.T) {
 	}
 }
 
+func TestClusterStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		cluster     *controlplanev1beta2.Cluster
+		wantStatus  string
+		wantReasons []string
+	}{
+		{name: "creating", cluster: &controlplanev1beta2.Cluster{State: controlplanev1beta2.Cluster_STATE_CREATING_AGENT}, wantStatus: "provisioning"},
+		{name: "ready", cluster: &controlplanev1beta2.Cluster{State: controlplanev1beta2.Cluster_STATE_READY}, wantStatus: "ready"},
+		{
+			name: "ready with an error is degraded",
+			cluster: &controlplanev1beta2.Cluster{
+				State:            controlplanev1beta2.Cluster_STATE_READY,
+				StateDescription: &rpcstatus.Status{Code: int32(grpccodes.Unavailable), Message: "broker 2 is unreachable"},
+			},
+			wantStatus:  "degraded",
+			wantReasons: []string{"broker 2 is unreachable"},
+		},
+		{
+			name: "failed",
+			cluster: &controlplanev1beta2.Cluster{
+				State:            controlplanev1beta2.Cluster_STATE_FAILED,
+				StateDescription: &rpcstatus.Status{Code: int32(grpccodes.Internal), Message: "quota exceeded"},
+			},
+			wantStatus:  "failed",
+			wantReasons: []string{"quota exceeded"},
+		},
+		{name: "deleting agent", cluster: &controlplanev1beta2.Cluster{State: controlplanev1beta2.Cluster_STATE_DELETING_AGENT}, wantStatus: "deleting"},
+		{name: "unspecified", cluster: &controlplanev1beta2.Cluster{}, wantStatus: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, reasons := clusterStatus(tt.cluster)
+			assert.Equal(t, types.StringValue(tt.wantStatus), status)
+			want := types.ListValueMust(types.StringType, []attr.Value{})
+			if tt.wantReasons != nil {
+				want = utils.StringSliceToTypeList(tt.wantReasons)
+			}
+			assert.Equal(t, want, reasons)
+		})
+	}
+}
+
+func TestByocAgentStatus(t *testing.T) {
+	byoc := controlplanev1beta2.Cluster_TYPE_BYOC
+	tests := []struct {
+		name    string
+		cluster *controlplanev1beta2.Cluster
+		want    types.String
+	}{
+		{name: "dedicated", cluster: &controlplanev1beta2.Cluster{Type: controlplanev1beta2.Cluster_TYPE_DEDICATED, State: controlplanev1beta2.Cluster_STATE_READY}, want: types.StringNull()},
+		{name: "creating agent", cluster: &controlplanev1beta2.Cluster{Type: byoc, State: controlplanev1beta2.Cluster_STATE_CREATING_AGENT}, want: types.StringValue("pending")},
+		{name: "creating", cluster: &controlplanev1beta2.Cluster{Type: byoc, State: controlplanev1beta2.Cluster_STATE_CREATING}, want: types.StringValue("provisioned")},
+		{name: "ready", cluster: &controlplanev1beta2.Cluster{Type: byoc, State: controlplanev1beta2.Cluster_STATE_READY}, want: types.StringValue("provisioned")},
+		{name: "deleting agent", cluster: &controlplanev1beta2.Cluster{Type: byoc, State: controlplanev1beta2.Cluster_STATE_DELETING_AGENT}, want: types.StringValue("deleting")},
+		{name: "failed", cluster: &controlplanev1beta2.Cluster{Type: byoc, State: controlplanev1beta2.Cluster_STATE_FAILED}, want: types.StringValue("unknown")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, byocAgentStatus(tt.cluster))
+		})
+	}
+}
+
 func TestIsMtlsNil(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -432,3 +617,219 @@ func TestIsMtlsNil(t *testing.T) {
 		})
 	}
 }
+
+func TestReadDeletingCluster(t *testing.T) {
+	testCases := []struct {
+		name      string
+		wait      bool
+		mockSetup func(m *mocks.MockClusterServiceClient)
+		wantErr   bool
+	}{
+		{
+			name: "deleting cluster is removed from state",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_DELETING), nil)
+			},
+		},
+		{
+			name: "deleting agent cluster is removed from state",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_DELETING_AGENT), nil)
+			},
+		},
+		{
+			name: "waits for pending deletion before removing from state",
+			wait: true,
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				gomock.InOrder(
+					m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_DELETING), nil),
+					m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_DELETING), nil),
+					m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(nil, grpcstatus.Error(grpccodes.NotFound, "not found")),
+				)
+			},
+		},
+		{
+			name: "fails when cluster leaves the deleting state while waiting",
+			wait: true,
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				gomock.InOrder(
+					m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_DELETING), nil),
+					m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(clusterResponse(controlplanev1beta2.Cluster_STATE_READY), nil),
+				)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockClient := mocks.NewMockClusterServiceClient(ctrl)
+			tc.mockSetup(mockClient)
+			c := &Cluster{CpCl: &cloud.ControlPlaneClientSet{Cluster: mockClient}}
+
+			model := models.ClusterResource{
+				Cluster:                generateMinimalModel("cl-123"),
+				WaitForPendingDeletion: types.BoolValue(tc.wait),
+				Timeouts:               testutil.NullTimeouts("create", "update", "delete"),
+			}
+			state := emptyClusterState(ctx)
+			if d := state.Set(ctx, model); d.HasError() {
+				t.Fatalf("unable to set state: %v", d)
+			}
+
+			resp := &resource.ReadResponse{State: state}
+			c.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if tc.wantErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			assert.False(t, resp.Diagnostics.HasError(), "unexpected diagnostics: %v", resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull(), "expected cluster to be removed from state")
+		})
+	}
+}
+
+func clusterResponse(state controlplanev1beta2.Cluster_State) *controlplanev1beta2.GetClusterResponse {
+	return &controlplanev1beta2.GetClusterResponse{
+		Cluster: &controlplanev1beta2.Cluster{
+			Id:    "cl-123",
+			State: state,
+			Type:  controlplanev1beta2.Cluster_TYPE_DEDICATED,
+		},
+	}
+}
+
+func emptyClusterState(ctx context.Context) tfsdk.State {
+	s := resourceClusterSchema()
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+}
+
+// testEndpoints returns the endpoints of a cluster that only reports its
+// cluster API URL.
+func testEndpoints(clusterAPIURL string, kafkaAPIMtls bool) *models.ClusterEndpoints {
+	return &models.ClusterEndpoints{
+		BootstrapServers:           types.ListNull(types.StringType),
+		SchemaRegistryURL:          types.StringNull(),
+		HTTPProxyURL:               types.StringNull(),
+		ConsoleURL:                 types.StringNull(),
+		ClusterAPIURL:              types.StringValue(clusterAPIURL),
+		PrometheusURL:              types.StringNull(),
+		KafkaAPIMtlsRequired:       types.BoolValue(kafkaAPIMtls),
+		HTTPProxyMtlsRequired:      types.BoolValue(false),
+		SchemaRegistryMtlsRequired: types.BoolValue(false),
+	}
+}
+
+func TestClusterSpecJSON(t *testing.T) {
+	c := &controlplanev1beta2.Cluster{
+		Id:                "cluster-1",
+		RedpandaVersion:   "v24.2.1",
+		CloudProviderTags: map[string]string{"owner": "alice@example.com"},
+	}
+	got, err := clusterSpecJSON(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec map[string]any
+	if err := json.Unmarshal([]byte(got), &spec); err != nil {
+		t.Fatalf("invalid JSON %q: %v", got, err)
+	}
+	assert.Equal(t, "v24.2.1", spec["redpanda_version"])
+	assert.Equal(t, map[string]any{"owner": "REDACTED"}, spec["cloud_provider_tags"])
+	assert.Equal(t, "alice@example.com", c.CloudProviderTags["owner"], "the cluster must not be modified")
+}
+
+func TestToClusterListeners(t *testing.T) {
+	assert.Nil(t, toClusterListeners(&controlplanev1beta2.Cluster{}))
+
+	got := toClusterListeners(&controlplanev1beta2.Cluster{
+		KafkaApi: &controlplanev1beta2.Cluster_KafkaAPI{
+			SeedBrokers: []string{"seed-0.rptest.io:30292", "seed-0.rptest.io:9092", "seed-1.rptest.io:9092"},
+		},
+		SchemaRegistry: &controlplanev1beta2.Cluster_SchemaRegistryStatus{Url: "https://schema-registry.rptest.io"},
+	})
+	assert.Equal(t, &models.ClusterListeners{
+		SeedBrokerCount:    types.Int64Value(3),
+		KafkaAPIHosts:      utils.StringSliceToTypeList([]string{"seed-0.rptest.io", "seed-1.rptest.io"}),
+		KafkaAPIPorts:      types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(9092), types.Int64Value(30292)}),
+		HTTPProxyPort:      types.Int64Null(),
+		SchemaRegistryPort: types.Int64Value(443),
+	}, got)
+}
+
+func TestKeepUnknownEnums(t *testing.T) {
+	cluster := &controlplanev1beta2.Cluster{
+		CloudProvider:  controlplanev1beta2.CloudProvider(42),
+		Type:           controlplanev1beta2.Cluster_TYPE_BYOC,
+		ConnectionType: controlplanev1beta2.Cluster_ConnectionType(42),
+	}
+	prior := models.Cluster{
+		CloudProvider:  types.StringValue("oci"),
+		ClusterType:    types.StringValue("byoc"),
+		ConnectionType: types.StringValue("peered"),
+	}
+	persist := &models.Cluster{
+		CloudProvider:  types.StringValue(utils.CloudProviderToString(cluster.GetCloudProvider())),
+		ClusterType:    types.StringValue(utils.ClusterTypeToString(cluster.GetType())),
+		ConnectionType: types.StringValue(utils.ConnectionTypeToString(cluster.GetConnectionType())),
+	}
+	var diags diag.Diagnostics
+	keepUnknownEnums(&diags, cluster, prior, persist)
+	assert.Equal(t, prior, *persist)
+	assert.Equal(t, 2, diags.WarningsCount())
+}
+
+func TestPlanEndpoints(t *testing.T) {
+	ctx := context.Background()
+	mtls := &models.Mtls{
+		Enabled:               types.BoolValue(true),
+		CaCertificatesPem:     utils.StringSliceToTypeList([]string{"-----BEGIN CERTIFICATE-----"}),
+		PrincipalMappingRules: types.ListNull(types.StringType),
+	}
+	tests := []struct {
+		name        string
+		kafkaAPI    *models.KafkaAPI
+		wantUnknown bool
+	}{
+		{name: "unchanged mtls keeps the endpoints"},
+		{name: "changed mtls", kafkaAPI: &models.KafkaAPI{Mtls: mtls}, wantUnknown: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := resourceClusterSchema()
+			model := func(kafkaAPI *models.KafkaAPI) models.ClusterResource {
+				return models.ClusterResource{
+					Cluster: plannedCluster(func(m *models.Cluster) {
+						m.KafkaAPI = kafkaAPI
+						m.Endpoints = testEndpoints("https://api.cluster.redpanda.com:443", false)
+					}),
+					Timeouts: testutil.NullTimeouts("create", "update", "delete"),
+				}
+			}
+			state := tfsdk.State{Schema: s}
+			plan := tfsdk.Plan{Schema: s}
+			if d := state.Set(ctx, model(nil)); d.HasError() {
+				t.Fatalf("unable to set state: %v", d)
+			}
+			if d := plan.Set(ctx, model(tt.kafkaAPI)); d.HasError() {
+				t.Fatalf("unable to set plan: %v", d)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			planEndpoints(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			var endpoints types.Object
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("endpoints"), &endpoints)...)
+			assert.Equal(t, tt.wantUnknown, endpoints.IsUnknown())
+		})
+	}
+}
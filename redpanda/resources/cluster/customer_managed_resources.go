@@ -0,0 +1,189 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// toCustomerManagedResources converts the customer-managed resources of the
+// model to their API representation. Returns nil if none are set.
+func toCustomerManagedResources(cmr *models.ClusterCustomerManagedResources) *controlplanev1beta2.CustomerManagedResources {
+	switch {
+	case cmr == nil:
+		return nil
+	case cmr.AWS != nil:
+		a := cmr.AWS
+		return &controlplanev1beta2.CustomerManagedResources{
+			CloudProvider: &controlplanev1beta2.CustomerManagedResources_Aws{Aws: &controlplanev1beta2.CustomerManagedResources_AWS{
+				AgentInstanceProfile:               awsInstanceProfile(a.AgentInstanceProfileArn),
+				ConnectorsNodeGroupInstanceProfile: awsInstanceProfile(a.ConnectorsNodeGroupInstanceProfileArn),
+				UtilityNodeGroupInstanceProfile:    awsInstanceProfile(a.UtilityNodeGroupInstanceProfileArn),
+				RedpandaNodeGroupInstanceProfile:   awsInstanceProfile(a.RedpandaNodeGroupInstanceProfileArn),
+				K8SClusterRole:                     awsRole(a.K8sClusterRoleArn),
+				ConsoleSecretsManagerRole:          awsRole(a.ConsoleSecretsManagerRoleArn),
+				ConnectorsSecretsManagerRole:       awsRole(a.ConnectorsSecretsManagerRoleArn),
+				RedpandaCloudStorageManagerRole:    awsRole(a.RedpandaCloudStorageManagerRoleArn),
+				RedpandaAgentSecurityGroup:         awsSecurityGroup(a.RedpandaAgentSecurityGroupArn),
+				ConnectorsSecurityGroup:            awsSecurityGroup(a.ConnectorsSecurityGroupArn),
+				RedpandaNodeGroupSecurityGroup:     awsSecurityGroup(a.RedpandaNodeGroupSecurityGroupArn),
+				UtilitySecurityGroup:               awsSecurityGroup(a.UtilitySecurityGroupArn),
+				ClusterSecurityGroup:               awsSecurityGroup(a.ClusterSecurityGroupArn),
+				NodeSecurityGroup:                  awsSecurityGroup(a.NodeSecurityGroupArn),
+				CloudStorageBucket:                 &controlplanev1beta2.CustomerManagedAWSCloudStorageBucket{Arn: a.CloudStorageBucketArn.ValueString()},
+			}},
+		}
+	case cmr.GCP != nil:
+		g := cmr.GCP
+		gcp := &controlplanev1beta2.CustomerManagedResources_GCP{
+			AgentServiceAccount:           gcpServiceAccount(g.AgentServiceAccountEmail),
+			ConsoleServiceAccount:         gcpServiceAccount(g.ConsoleServiceAccountEmail),
+			ConnectorServiceAccount:       gcpServiceAccount(g.ConnectorServiceAccountEmail),
+			RedpandaClusterServiceAccount: gcpServiceAccount(g.RedpandaClusterServiceAccountEmail),
+			GkeServiceAccount:             gcpServiceAccount(g.GkeServiceAccountEmail),
+			TieredStorageBucket:           &controlplanev1beta2.CustomerManagedGoogleCloudStorageBucket{Name: g.TieredStorageBucketName.ValueString()},
+			PscNatSubnetName:              g.PscNatSubnetName.ValueString(),
+		}
+		if g.Subnet != nil {
+			gcp.Subnet = &controlplanev1beta2.CustomerManagedResources_GCP_Subnet{
+				Name:                       g.Subnet.Name.ValueString(),
+				SecondaryIpv4RangePods:     &controlplanev1beta2.CustomerManagedResources_GCP_Subnet_SecondaryIPv4Range{Name: g.Subnet.SecondaryIPv4RangePodsName.ValueString()},
+				SecondaryIpv4RangeServices: &controlplanev1beta2.CustomerManagedResources_GCP_Subnet_SecondaryIPv4Range{Name: g.Subnet.SecondaryIPv4RangeServicesName.ValueString()},
+				K8SMasterIpv4Range:         g.Subnet.K8sMasterIPv4Range.ValueString(),
+			}
+		}
+		return &controlplanev1beta2.CustomerManagedResources{
+			CloudProvider: &controlplanev1beta2.CustomerManagedResources_Gcp{Gcp: gcp},
+		}
+	}
+	return nil
+}
+
+// awsInstanceProfile, awsRole, awsSecurityGroup and gcpServiceAccount return
+// nil for unset values so that optional resources are left out of the request.
+func awsInstanceProfile(arn types.String) *controlplanev1beta2.CustomerManagedResources_AWS_InstanceProfile {
+	if arn.ValueString() == "" {
+		return nil
+	}
+	return &controlplanev1beta2.CustomerManagedResources_AWS_InstanceProfile{Arn: arn.ValueString()}
+}
+
+func awsRole(arn types.String) *controlplanev1beta2.CustomerManagedResources_AWS_Role {
+	if arn.ValueString() == "" {
+		return nil
+	}
+	return &controlplanev1beta2.CustomerManagedResources_AWS_Role{Arn: arn.ValueString()}
+}
+
+func awsSecurityGroup(arn types.String) *controlplanev1beta2.CustomerManagedResources_AWS_SecurityGroup {
+	if arn.ValueString() == "" {
+		return nil
+	}
+	return &controlplanev1beta2.CustomerManagedResources_AWS_SecurityGroup{Arn: arn.ValueString()}
+}
+
+func gcpServiceAccount(email types.String) *controlplanev1beta2.CustomerManagedResources_GCP_ServiceAccount {
+	if email.ValueString() == "" {
+		return nil
+	}
+	return &controlplanev1beta2.CustomerManagedResources_GCP_ServiceAccount{Email: email.ValueString()}
+}
+
+// toCustomerManagedResourcesModel converts the customer-managed resources
+// reported by the API to the model. Returns nil if the cluster has none.
+func toCustomerManagedResourcesModel(cmr *controlplanev1beta2.CustomerManagedResources) *models.ClusterCustomerManagedResources {
+	if a := cmr.GetAws(); a != nil {
+		return &models.ClusterCustomerManagedResources{AWS: &models.ClusterCustomerManagedAWS{
+			AgentInstanceProfileArn:               types.StringValue(a.GetAgentInstanceProfile().GetArn()),
+			ConnectorsNodeGroupInstanceProfileArn: types.StringValue(a.GetConnectorsNodeGroupInstanceProfile().GetArn()),
+			UtilityNodeGroupInstanceProfileArn:    types.StringValue(a.GetUtilityNodeGroupInstanceProfile().GetArn()),
+			RedpandaNodeGroupInstanceProfileArn:   types.StringValue(a.GetRedpandaNodeGroupInstanceProfile().GetArn()),
+			K8sClusterRoleArn:                     types.StringValue(a.GetK8SClusterRole().GetArn()),
+			ConsoleSecretsManagerRoleArn:          nonEmptyString(a.GetConsoleSecretsManagerRole().GetArn()),
+			ConnectorsSecretsManagerRoleArn:       nonEmptyString(a.GetConnectorsSecretsManagerRole().GetArn()),
+			RedpandaCloudStorageManagerRoleArn:    nonEmptyString(a.GetRedpandaCloudStorageManagerRole().GetArn()),
+			RedpandaAgentSecurityGroupArn:         types.StringValue(a.GetRedpandaAgentSecurityGroup().GetArn()),
+			ConnectorsSecurityGroupArn:            types.StringValue(a.GetConnectorsSecurityGroup().GetArn()),
+			RedpandaNodeGroupSecurityGroupArn:     types.StringValue(a.GetRedpandaNodeGroupSecurityGroup().GetArn()),
+			UtilitySecurityGroupArn:               types.StringValue(a.GetUtilitySecurityGroup().GetArn()),
+			ClusterSecurityGroupArn:               types.StringValue(a.GetClusterSecurityGroup().GetArn()),
+			NodeSecurityGroupArn:                  types.StringValue(a.GetNodeSecurityGroup().GetArn()),
+			CloudStorageBucketArn:                 types.StringValue(a.GetCloudStorageBucket().GetArn()),
+		}}
+	}
+	if g := cmr.GetGcp(); g != nil {
+		gcp := &models.ClusterCustomerManagedGCP{
+			AgentServiceAccountEmail:           types.StringValue(g.GetAgentServiceAccount().GetEmail()),
+			ConsoleServiceAccountEmail:         types.StringValue(g.GetConsoleServiceAccount().GetEmail()),
+			ConnectorServiceAccountEmail:       types.StringValue(g.GetConnectorServiceAccount().GetEmail()),
+			RedpandaClusterServiceAccountEmail: types.StringValue(g.GetRedpandaClusterServiceAccount().GetEmail()),
+			GkeServiceAccountEmail:             types.StringValue(g.GetGkeServiceAccount().GetEmail()),
+			TieredStorageBucketName:            types.StringValue(g.GetTieredStorageBucket().GetName()),
+			PscNatSubnetName:                   nonEmptyString(g.GetPscNatSubnetName()),
+		}
+		if s := g.GetSubnet(); s != nil {
+			gcp.Subnet = &models.ClusterCustomerManagedGCPSubnet{
+				Name:                           types.StringValue(s.GetName()),
+				SecondaryIPv4RangePodsName:     types.StringValue(s.GetSecondaryIpv4RangePods().GetName()),
+				SecondaryIPv4RangeServicesName: types.StringValue(s.GetSecondaryIpv4RangeServices().GetName()),
+				K8sMasterIPv4Range:             types.StringValue(s.GetK8SMasterIpv4Range()),
+			}
+		}
+		return &models.ClusterCustomerManagedResources{GCP: gcp}
+	}
+	return nil
+}
+
+// validateCustomerManagedResources checks that customer-managed resources are
+// only given for BYOC clusters, in the block of the cluster's cloud provider.
+// Unknown values and an unset cloud provider are not checked.
+func validateCustomerManagedResources(model models.Cluster) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cmrPath := path.Root("customer_managed_resources")
+	cmr := model.CustomerManagedResources
+	if cmr == nil {
+		return diags
+	}
+	if !model.ClusterType.IsUnknown() && model.ClusterType.ValueString() != utils.ClusterTypeToString(controlplanev1beta2.Cluster_TYPE_BYOC) {
+		diags.AddAttributeError(cmrPath, "Invalid Configuration",
+			fmt.Sprintf("customer_managed_resources can only be set when cluster_type is byoc, but it is set to %s", model.ClusterType.ValueString()))
+	}
+	if cmr.AWS != nil && cmr.GCP != nil {
+		diags.AddAttributeError(cmrPath, "Invalid Configuration", "only one of aws or gcp can be set in customer_managed_resources")
+		return diags
+	}
+	if model.CloudProvider.IsUnknown() || model.CloudProvider.IsNull() {
+		return diags
+	}
+	provider := model.CloudProvider.ValueString()
+	switch {
+	case provider == utils.CloudProviderStringAws && cmr.AWS == nil,
+		provider == utils.CloudProviderStringGcp && cmr.GCP == nil:
+		diags.AddAttributeError(cmrPath.AtName(provider), "Missing Configuration",
+			fmt.Sprintf("customer_managed_resources.%s is required when cloud_provider is %s", provider, provider))
+	case provider != utils.CloudProviderStringAws && provider != utils.CloudProviderStringGcp:
+		diags.AddAttributeError(cmrPath, "Invalid Configuration",
+			fmt.Sprintf("customer_managed_resources are only supported on aws and gcp, but cloud_provider is set to %s", provider))
+	}
+	return diags
+}
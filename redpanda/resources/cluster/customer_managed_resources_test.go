@@ -0,0 +1,53 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCustomerManagedResources(t *testing.T) {
+	aws := &models.ClusterCustomerManagedResources{AWS: &models.ClusterCustomerManagedAWS{
+		AgentInstanceProfileArn: types.StringValue("arn:aws:iam::123456789012:instance-profile/redpanda-agent"),
+	}}
+	gcp := &models.ClusterCustomerManagedResources{GCP: &models.ClusterCustomerManagedGCP{
+		AgentServiceAccountEmail: types.StringValue("redpanda-agent@acme.iam.gserviceaccount.com"),
+	}}
+	cluster := func(provider types.String, clusterType string, cmr *models.ClusterCustomerManagedResources) models.Cluster {
+		return models.Cluster{
+			CloudProvider:            provider,
+			ClusterType:              types.StringValue(clusterType),
+			CustomerManagedResources: cmr,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		model   models.Cluster
+		wantErr string
+	}{
+		{name: "none", model: cluster(types.StringValue("aws"), "dedicated", nil)},
+		{name: "aws byovpc", model: cluster(types.StringValue("aws"), "byoc", aws)},
+		{name: "gcp byovpc", model: cluster(types.StringValue("gcp"), "byoc", gcp)},
+		{name: "unknown provider", model: cluster(types.StringUnknown(), "byoc", gcp)},
+		{name: "unset provider", model: cluster(types.StringNull(), "byoc", gcp)},
+		{name: "dedicated", model: cluster(types.StringValue("aws"), "dedicated", aws), wantErr: "only be set when cluster_type is byoc"},
+		{name: "wrong provider block", model: cluster(types.StringValue("aws"), "byoc", gcp), wantErr: "customer_managed_resources.aws is required"},
+		{name: "azure", model: cluster(types.StringValue("azure"), "byoc", aws), wantErr: "only supported on aws and gcp"},
+		{name: "both blocks", model: cluster(types.StringValue("aws"), "byoc", &models.ClusterCustomerManagedResources{AWS: aws.AWS, GCP: gcp.GCP}), wantErr: "only one of aws or gcp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateCustomerManagedResources(tt.model)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			if assert.True(t, diags.HasError()) {
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+			}
+		})
+	}
+}
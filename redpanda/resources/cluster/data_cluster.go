@@ -48,16 +48,8 @@ func (*DataSourceCluster) Metadata(_ context.Context, _ datasource.MetadataReque
 
 // Configure uses provider level data to configure DataSourceCluster's client.
 func (d *DataSourceCluster) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	p, ok := req.ProviderData.(config.Datasource)
-
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -83,8 +75,8 @@ func (d *DataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest
 		tags[k] = types.StringValue(v)
 	}
 	tagsValue, diags := types.MapValue(types.StringType, tags)
-	if diags.HasError() {
-		resp.Diagnostics.AddError("unable to parse Cloud tags", err.Error())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -99,6 +91,7 @@ func (d *DataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest
 		Region:                types.StringValue(cluster.Region),
 		Zones:                 utils.StringSliceToTypeList(cluster.Zones),
 		Tags:                  tagsValue,
+		TagsAll:               tagsValue,
 		ResourceGroupID:       types.StringValue(cluster.ResourceGroupId),
 		NetworkID:             types.StringValue(cluster.NetworkId),
 		ID:                    types.StringValue(cluster.Id),
@@ -117,12 +110,21 @@ func (d *DataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest
 	if cluster.DataplaneApi != nil {
 		persist.ClusterAPIURL = types.StringValue(cluster.DataplaneApi.Url)
 	}
+	persist.Endpoints = toClusterEndpoints(cluster)
+	persist.KafkaBootstrapServers, persist.SchemaRegistryURL, persist.HTTPProxyURL = clusterConnectionURLs(cluster)
+	persist.Listeners = toClusterListeners(cluster)
+	persist.Status, persist.StatusReasons = clusterStatus(cluster)
+	persist.ByocAgentStatus = byocAgentStatus(cluster)
+	persist.IsReadReplicaSource = isReadReplicaSource(persist.ReadReplicaClusterIDs)
+	persist.MaintenanceWindowConfig = toMaintenanceWindowModel(cluster.GetMaintenanceWindowConfig())
+	persist.CustomerManagedResources = toCustomerManagedResourcesModel(cluster.GetCustomerManagedResources())
 
 	if !isAwsPrivateLinkSpecNil(cluster.AwsPrivateLink) {
 		persist.AwsPrivateLink = &models.AwsPrivateLink{
 			Enabled:           types.BoolValue(cluster.AwsPrivateLink.Enabled),
 			ConnectConsole:    types.BoolValue(cluster.AwsPrivateLink.ConnectConsole),
 			AllowedPrincipals: utils.StringSliceToTypeList(cluster.AwsPrivateLink.AllowedPrincipals),
+			ListenerPorts:     toAwsPrivateLinkListenerPorts(cluster.AwsPrivateLink.GetStatus()),
 		}
 	}
 	if !isGcpPrivateServiceConnectSpecNil(cluster.GcpPrivateServiceConnect) {
@@ -131,6 +133,7 @@ func (d *DataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest
 				Enabled:             types.BoolValue(cluster.GcpPrivateServiceConnect.Enabled),
 				GlobalAccessEnabled: types.BoolValue(cluster.GcpPrivateServiceConnect.GlobalAccessEnabled),
 				ConsumerAcceptList:  gcpConnectConsumerStructToModel(cluster.GcpPrivateServiceConnect.ConsumerAcceptList),
+				ConsumerStatus:      toGcpConsumerStatus(cluster.GcpPrivateServiceConnect),
 			}
 		}
 	}
@@ -143,6 +146,9 @@ func (d *DataSourceCluster) Read(ctx context.Context, req datasource.ReadRequest
 		}
 	}
 
+	utils.WarnUnknownEnum(&resp.Diagnostics, "cloud_provider", cluster.GetCloudProvider())
+	utils.WarnUnknownEnum(&resp.Diagnostics, "cluster_type", cluster.GetType())
+	utils.WarnUnknownEnum(&resp.Diagnostics, "connection_type", cluster.GetConnectionType())
 	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
 }
 
@@ -155,121 +161,438 @@ func datasourceClusterSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of the cluster. ID is an output from the Create Cluster endpoint and cannot be set by the caller.",
+				Required:            true,
+				MarkdownDescription: "ID of the cluster. ID is an output from the Create Cluster endpoint and cannot be set by the caller.",
 			},
 			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Unique name of the cluster.",
+				Computed:            true,
+				MarkdownDescription: "Unique name of the cluster.",
 			},
 			"cluster_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "Cluster type. Type is immutable and can only be set on cluster creation.",
+				Computed:            true,
+				MarkdownDescription: "Cluster type. Type is immutable and can only be set on cluster creation.",
 			},
 			"connection_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "Cluster connection type. Private clusters are not exposed to the internet. For BYOC clusters, Private is best-practice.",
+				Computed:            true,
+				MarkdownDescription: "Cluster connection type. Private clusters are not exposed to the internet. For BYOC clusters, Private is best-practice.",
 			},
 			"cloud_provider": schema.StringAttribute{
-				Computed:    true,
-				Description: "Cloud provider where resources are created.",
+				Computed:            true,
+				MarkdownDescription: "Cloud provider where resources are created.",
 			},
 			"redpanda_version": schema.StringAttribute{
-				Computed:    true,
-				Description: "Current Redpanda version of the cluster.",
+				Computed:            true,
+				MarkdownDescription: "Current Redpanda version of the cluster.",
 			},
 			"throughput_tier": schema.StringAttribute{
-				Computed:    true,
-				Description: "Throughput tier of the cluster.",
+				Computed:            true,
+				MarkdownDescription: "Throughput tier of the cluster.",
 			},
 			"region": schema.StringAttribute{
-				Computed:    true,
-				Description: "Cloud provider region. Region represents the name of the region where the cluster will be provisioned.",
+				Computed:            true,
+				MarkdownDescription: "Cloud provider region. Region represents the name of the region where the cluster will be provisioned.",
 			},
 			"zones": schema.ListAttribute{
-				Computed:    true,
-				Description: "Zones of the cluster. Must be valid zones within the selected region. If multiple zones are used, the cluster is a multi-AZ cluster.",
-				ElementType: types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Zones of the cluster. Must be valid zones within the selected region. If multiple zones are used, the cluster is a multi-AZ cluster.",
+				ElementType:         types.StringType,
 			},
 			"allow_deletion": schema.BoolAttribute{
-				Computed:    true,
-				Description: "Allows deletion of the cluster. Defaults to true. Not recommended for production use.",
+				Computed:            true,
+				MarkdownDescription: "Allows deletion of the cluster. Defaults to true. Not recommended for production use.",
 			},
 			"tags": schema.MapAttribute{
-				Computed:    true,
-				Description: "Tags placed on cloud resources. If the cloud provider is GCP and the name of a tag has the prefix \"gcp.network-tag.\", the tag is a network tag that will be added to the Redpanda cluster GKE nodes. Otherwise, the tag is a normal tag. For example, if the name of a tag is \"gcp.network-tag.network-tag-foo\", the network tag named \"network-tag-foo\" will be added to the Redpanda cluster GKE nodes. Note: The value of a network tag will be ignored. See the details on network tags at https://cloud.google.com/vpc/docs/add-remove-network-tags.",
-				ElementType: types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Tags placed on cloud resources. If the cloud provider is GCP and the name of a tag has the prefix \"gcp.network-tag.\", the tag is a network tag that will be added to the Redpanda cluster GKE nodes. Otherwise, the tag is a normal tag. For example, if the name of a tag is \"gcp.network-tag.network-tag-foo\", the network tag named \"network-tag-foo\" will be added to the Redpanda cluster GKE nodes. Note: The value of a network tag will be ignored. See the details on network tags at https://cloud.google.com/vpc/docs/add-remove-network-tags.",
+				ElementType:         types.StringType,
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "Tags placed on cloud resources, the same as tags.",
+				ElementType:         types.StringType,
 			},
 			"resource_group_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "Resource group ID of the cluster.",
+				Computed:            true,
+				MarkdownDescription: "Resource group ID of the cluster.",
 			},
 			"network_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "Network ID where cluster is placed.",
+				Computed:            true,
+				MarkdownDescription: "Network ID where cluster is placed.",
 			},
 			"cluster_api_url": schema.StringAttribute{
-				Computed:    true,
-				Description: "The URL of the cluster API.",
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster API.",
+			},
+			"kafka_bootstrap_servers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Kafka API seed brokers, to use as the bootstrap servers of Kafka clients. Empty until the cluster is ready.",
+			},
+			"schema_registry_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the Schema Registry, null until the cluster is ready.",
+			},
+			"http_proxy_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the HTTP Proxy, null until the cluster is ready.",
+			},
+			"endpoints": schema.SingleNestedAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Connection information of the cluster, grouped so that it can be referenced or encoded to JSON as a single object. Endpoints that are not yet available are null.",
+				Attributes: map[string]schema.Attribute{
+					"bootstrap_servers": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Kafka API bootstrap servers.",
+					},
+					"schema_registry_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Schema Registry URL.",
+					},
+					"http_proxy_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "HTTP Proxy URL.",
+					},
+					"console_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Redpanda Console URL.",
+					},
+					"cluster_api_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Cluster API URL.",
+					},
+					"prometheus_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Prometheus metrics endpoint URL.",
+					},
+					"kafka_api_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the Kafka API must authenticate with mTLS.",
+					},
+					"http_proxy_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the HTTP Proxy must authenticate with mTLS.",
+					},
+					"schema_registry_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the Schema Registry must authenticate with mTLS.",
+					},
+				},
+			},
+			"maintenance_window_config": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Window in which Redpanda Cloud upgrades the cluster. Only one of `day_hour`, `anytime` or `unspecified` is set.",
+				Attributes: map[string]schema.Attribute{
+					"day_hour": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Weekly window starting at an hour of a day of the week.",
+						Attributes: map[string]schema.Attribute{
+							"hour_of_day": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Hour of the day the window starts at, in UTC.",
+							},
+							"day_of_week": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Day of the week of the window, e.g. MONDAY.",
+							},
+						},
+					},
+					"anytime": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether upgrades are allowed at any time.",
+					},
+					"unspecified": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether Redpanda Cloud picks the window.",
+					},
+				},
+			},
+			"customer_managed_resources": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud resources created and managed by the customer for a BYOVPC cluster, if any.",
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Customer-managed resources on AWS.",
+						Attributes: map[string]schema.Attribute{
+							"agent_instance_profile_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the instance profile of the Redpanda Cloud agent.",
+							},
+							"connectors_node_group_instance_profile_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the instance profile of the connectors node group.",
+							},
+							"utility_node_group_instance_profile_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the instance profile of the utility node group.",
+							},
+							"redpanda_node_group_instance_profile_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the instance profile of the Redpanda node group.",
+							},
+							"k8s_cluster_role_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the IAM role of the EKS cluster.",
+							},
+							"console_secrets_manager_role_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the IAM role Redpanda Console uses to access AWS Secrets Manager.",
+							},
+							"connectors_secrets_manager_role_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the IAM role managed connectors use to access AWS Secrets Manager.",
+							},
+							"redpanda_cloud_storage_manager_role_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the IAM role Redpanda uses to manage the cloud storage bucket.",
+							},
+							"redpanda_agent_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group of the Redpanda Cloud agent.",
+							},
+							"connectors_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group of the connectors node group.",
+							},
+							"redpanda_node_group_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group of the Redpanda node group.",
+							},
+							"utility_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group of the utility node group.",
+							},
+							"cluster_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group of the EKS cluster.",
+							},
+							"node_security_group_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the security group shared by the EKS nodes.",
+							},
+							"cloud_storage_bucket_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the S3 bucket used for Tiered Storage.",
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Customer-managed resources on GCP.",
+						Attributes: map[string]schema.Attribute{
+							"subnet": schema.SingleNestedAttribute{
+								Computed:            true,
+								MarkdownDescription: "Subnet the Redpanda cluster is deployed into.",
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Name of the subnet.",
+									},
+									"secondary_ipv4_range_pods_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Name of the secondary IPv4 range of the subnet for pods.",
+									},
+									"secondary_ipv4_range_services_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Name of the secondary IPv4 range of the subnet for services.",
+									},
+									"k8s_master_ipv4_range": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "IPv4 range of the Kubernetes control plane, e.g. 10.0.0.0/24.",
+									},
+								},
+							},
+							"agent_service_account_email": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Email of the service account of the Redpanda Cloud agent.",
+							},
+							"console_service_account_email": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Email of the service account of Redpanda Console.",
+							},
+							"connector_service_account_email": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Email of the service account of managed connectors.",
+							},
+							"redpanda_cluster_service_account_email": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Email of the service account of the Redpanda cluster.",
+							},
+							"gke_service_account_email": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Email of the service account of the GKE cluster.",
+							},
+							"tiered_storage_bucket_name": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Name of the Cloud Storage bucket used for Tiered Storage.",
+							},
+							"psc_nat_subnet_name": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Name of the NAT subnet of Private Service Connect, if any.",
+							},
+						},
+					},
+				},
+			},
+			"listeners": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: listenersDescription,
+				Attributes: map[string]schema.Attribute{
+					"seed_broker_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of seed brokers of the Kafka API.",
+					},
+					"kafka_api_hosts": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "DNS names of the seed brokers of the Kafka API.",
+					},
+					"kafka_api_ports": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "Ports of the seed brokers of the Kafka API, in ascending order.",
+					},
+					"http_proxy_port": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Port of the HTTP Proxy.",
+					},
+					"schema_registry_port": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Port of the Schema Registry.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: statusDescription,
+			},
+			"status_reasons": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: statusReasonsDescription,
+			},
+			"byoc_agent_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: byocAgentStatusDescription,
 			},
 			"aws_private_link": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "The AWS Private Link configuration.",
+				Computed:            true,
+				MarkdownDescription: "The AWS Private Link configuration.",
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Redpanda AWS Private Link Endpoint Service is enabled.",
+						Computed:            true,
+						MarkdownDescription: "Whether Redpanda AWS Private Link Endpoint Service is enabled.",
 					},
 					"connect_console": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Console is connected in Redpanda AWS Private Link Service.",
+						Computed:            true,
+						MarkdownDescription: "Whether Console is connected in Redpanda AWS Private Link Service.",
 					},
 					"allowed_principals": schema.ListAttribute{
-						ElementType: types.StringType,
-						Computed:    true,
-						Description: "The ARN of the principals that can access the Redpanda AWS PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+						ElementType:         types.StringType,
+						Computed:            true,
+						MarkdownDescription: "The ARN of the principals that can access the Redpanda AWS PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+					},
+					"listener_ports": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Ports assigned to each listener of the Redpanda AWS Private Link Endpoint Service.",
+						Attributes: map[string]schema.Attribute{
+							"kafka_api_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Kafka API seed port.",
+							},
+							"kafka_api_node_base_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Kafka API base port for the brokers. Each broker is reachable on the base port plus its node index.",
+							},
+							"redpanda_proxy_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "HTTP Proxy seed port.",
+							},
+							"redpanda_proxy_node_base_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "HTTP Proxy base port for the brokers. Each broker is reachable on the base port plus its node index.",
+							},
+							"schema_registry_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Schema Registry seed port.",
+							},
+							"console_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Console port.",
+							},
+						},
 					},
 				},
 			},
 			"azure_private_link": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "The Azure Private Link configuration.",
+				Computed:            true,
+				MarkdownDescription: "The Azure Private Link configuration.",
 				Attributes: map[string]schema.Attribute{
 					"allowed_subscriptions": schema.ListAttribute{
-						ElementType: types.StringType,
-						Computed:    true,
-						Description: "The subscriptions that can access the Redpanda Azure PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+						ElementType:         types.StringType,
+						Computed:            true,
+						MarkdownDescription: "The subscriptions that can access the Redpanda Azure PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
 					},
 					"connect_console": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Console is connected in Redpanda Azure Private Link Service.",
+						Computed:            true,
+						MarkdownDescription: "Whether Console is connected in Redpanda Azure Private Link Service.",
 					},
 					"enabled": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Redpanda Azure Private Link Endpoint Service is enabled.",
+						Computed:            true,
+						MarkdownDescription: "Whether Redpanda Azure Private Link Endpoint Service is enabled.",
 					},
 				},
 			},
 			"gcp_private_service_connect": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "The GCP Private Service Connect configuration.",
+				Computed:            true,
+				MarkdownDescription: "The GCP Private Service Connect configuration.",
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether Redpanda GCP Private Service Connect is enabled.",
+						Computed:            true,
+						MarkdownDescription: "Whether Redpanda GCP Private Service Connect is enabled.",
 					},
 					"global_access_enabled": schema.BoolAttribute{
-						Computed:    true,
-						Description: "Whether global access is enabled.",
+						Computed:            true,
+						MarkdownDescription: "Whether global access is enabled.",
 					},
 					"consumer_accept_list": schema.ListNestedAttribute{
-						Computed:    true,
-						Description: "List of consumers that are allowed to connect to Redpanda GCP PSC (Private Service Connect) service attachment.",
+						Computed:            true,
+						MarkdownDescription: "List of consumers that are allowed to connect to Redpanda GCP PSC (Private Service Connect) service attachment.",
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"source": schema.StringAttribute{
-									Computed:    true,
-									Description: "Either the GCP project number or its alphanumeric ID.",
+									Computed:            true,
+									MarkdownDescription: "Either the GCP project number or its alphanumeric ID.",
+								},
+							},
+						},
+					},
+					"consumer_status": schema.ListNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Status of the connections of the consumers to the Redpanda GCP PSC service attachment. There is one element per connected endpoint, and one element without connection for each consumer of the accept list that has none.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"source": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Entry of the consumer accept list matching the project of the endpoint.",
+								},
+								"connection_id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "ID of the connection.",
+								},
+								"consumer_network": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Network of the consumer endpoint.",
+								},
+								"endpoint": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "IP address of the consumer endpoint.",
+								},
+								"status": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Status of the connection, e.g. PENDING, ACCEPTED or REJECTED.",
 								},
 							},
 						},
@@ -277,89 +600,93 @@ func datasourceClusterSchema() schema.Schema {
 				},
 			},
 			"kafka_api": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "Cluster's Kafka API properties.",
+				Computed:            true,
+				MarkdownDescription: "Cluster's Kafka API properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "mTLS configuration.",
+						Computed:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Computed:    true,
-								Description: "Whether mTLS is enabled.",
+								Computed:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"http_proxy": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "HTTP Proxy properties.",
+				Computed:            true,
+				MarkdownDescription: "HTTP Proxy properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "mTLS configuration.",
+						Computed:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Computed:    true,
-								Description: "Whether mTLS is enabled.",
+								Computed:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"schema_registry": schema.SingleNestedAttribute{
-				Computed:    true,
-				Description: "Cluster's Schema Registry properties.",
+				Computed:            true,
+				MarkdownDescription: "Cluster's Schema Registry properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Computed:    true,
-						Description: "mTLS configuration.",
+						Computed:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Computed:    true,
-								Description: "Whether mTLS is enabled.",
+								Computed:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Computed:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Computed:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"read_replica_cluster_ids": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-				Description: "IDs of clusters which may create read-only topics from this cluster.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "IDs of clusters which may create read-only topics from this cluster.",
+			},
+			"is_read_replica_source": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: isReadReplicaSourceDescription,
 			},
 		},
-		Description: "Data source for a Redpanda Cloud cluster",
+		MarkdownDescription: "Data source for a Redpanda Cloud cluster",
 	}
 }
@@ -0,0 +1,116 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// redacted replaces the values removed from the cluster spec.
+const redacted = "REDACTED"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DataSourceClusterSpec{}
+)
+
+// DataSourceClusterSpec represents a data source rendering the full cluster
+// as reported by the control plane, to attach to support tickets.
+type DataSourceClusterSpec struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the metadata for the ClusterSpec data source.
+func (*DataSourceClusterSpec) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_cluster_spec"
+}
+
+// Schema returns the schema for the ClusterSpec data source.
+func (*DataSourceClusterSpec) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = datasourceClusterSpecSchema()
+}
+
+func datasourceClusterSpecSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Renders a cluster as reported by the Redpanda Cloud control plane as JSON, including the fields not exposed by redpanda_cluster, to attach to support tickets. Cloud provider tag values are redacted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster",
+			},
+			"json": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The cluster, rendered as indented JSON",
+			},
+		},
+	}
+}
+
+// Configure uses provider level data to configure DataSourceClusterSpec's
+// client.
+func (d *DataSourceClusterSpec) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// Read reads the cluster and renders it as JSON.
+func (d *DataSourceClusterSpec) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.ClusterSpec
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := d.CpCl.ClusterForID(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", model.ID), err.Error())
+		return
+	}
+	spec, err := clusterSpecJSON(cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to render cluster %s", model.ID), err.Error())
+		return
+	}
+	model.JSON = types.StringValue(spec)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// clusterSpecJSON renders the cluster as indented JSON, with the values of
+// the cloud provider tags redacted since they may hold customer data.
+func clusterSpecJSON(cluster *controlplanev1beta2.Cluster) (string, error) {
+	c := proto.Clone(cluster).(*controlplanev1beta2.Cluster)
+	for k := range c.CloudProviderTags {
+		c.CloudProviderTags[k] = redacted
+	}
+	b, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
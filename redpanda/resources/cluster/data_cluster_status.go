@@ -0,0 +1,177 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// dataplaneProbeTimeout bounds the request checking that the cluster API is
+// reachable, so an unreachable cluster doesn't stall the plan.
+const dataplaneProbeTimeout = 30 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceClusterStatus{}
+	_ datasource.DataSourceWithConfigure = &DataSourceClusterStatus{}
+)
+
+// DataSourceClusterStatus represents a data source reporting the health of a
+// cluster.
+type DataSourceClusterStatus struct {
+	CpCl *cloud.ControlPlaneClientSet
+	// TopicClient is used to probe the cluster API, a client connected to
+	// the cluster API of the cluster is created when nil.
+	TopicClient dataplanev1alpha2grpc.TopicServiceClient
+
+	dsData config.Datasource
+}
+
+// Metadata returns the metadata for the ClusterStatus data source.
+func (*DataSourceClusterStatus) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_cluster_status"
+}
+
+// Configure uses provider level data to configure DataSourceClusterStatus.
+func (d *DataSourceClusterStatus) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	d.dsData = p
+}
+
+// Schema returns the schema for the ClusterStatus data source.
+func (*DataSourceClusterStatus) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceClusterStatusSchema()
+}
+
+func datasourceClusterStatusSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of the cluster as reported by the API, e.g. ready or creating",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Summarized status of the cluster: provisioning, ready, degraded, upgrading, failed, deleting, suspended or unknown",
+			},
+			"status_reasons": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Human readable reasons for the current status, empty when the API reports none",
+			},
+			"state_description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the state of the cluster, null when the API reports none",
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster API, null until the cluster is ready",
+			},
+			"dataplane_reachable": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the cluster API answered a request when the data source was read",
+			},
+			"dataplane_error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Why the cluster API couldn't be reached, null when it is reachable",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the status of the cluster is ready and its cluster API is reachable",
+			},
+		},
+		MarkdownDescription: "Data source reporting the health of a cluster, e.g. to gate the deployment of its topics and users on a healthy cluster",
+	}
+}
+
+// Read reads the state of the cluster and probes its cluster API.
+func (d *DataSourceClusterStatus) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.ClusterStatus
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := d.CpCl.ClusterForID(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", model.ID), err.Error())
+		return
+	}
+	model.State = types.StringValue(clusterStateToString(cluster.GetState()))
+	model.Status, model.StatusReasons = clusterStatus(cluster)
+	model.StateDescription = types.StringNull()
+	if msg := cluster.GetStateDescription().GetMessage(); msg != "" {
+		model.StateDescription = types.StringValue(msg)
+	}
+	model.ClusterAPIURL = types.StringNull()
+	if url := cluster.GetDataplaneApi().GetUrl(); url != "" {
+		model.ClusterAPIURL = types.StringValue(url)
+	}
+
+	probeErr := d.probeDataplane(ctx, cluster)
+	model.DataplaneReachable = types.BoolValue(probeErr == nil)
+	model.DataplaneError = types.StringNull()
+	if probeErr != nil {
+		model.DataplaneError = types.StringValue(probeErr.Error())
+	}
+	model.Healthy = types.BoolValue(model.Status.ValueString() == "ready" && probeErr == nil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// probeDataplane returns why the cluster API of the cluster can't be
+// reached, or nil when it answers a request.
+func (d *DataSourceClusterStatus) probeDataplane(ctx context.Context, cluster *controlplanev1beta2.Cluster) error {
+	url := cluster.GetDataplaneApi().GetUrl()
+	if url == "" {
+		return errors.New("the cluster has no cluster API URL yet")
+	}
+	client := d.TopicClient
+	if client == nil {
+		conn, err := cloud.SpawnConn(url, d.dsData.AuthToken, d.dsData.Proxy)
+		if err != nil {
+			return fmt.Errorf("unable to open a connection with the cluster API: %w", err)
+		}
+		defer conn.Close()
+		client = dataplanev1alpha2grpc.NewTopicServiceClient(conn)
+	}
+	ctx, cancel := context.WithTimeout(ctx, dataplaneProbeTimeout)
+	defer cancel()
+	if _, err := client.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageSize: 1}); err != nil {
+		return fmt.Errorf("the cluster API didn't answer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDatasourceClusterStatusSchema(t *testing.T) {
+	require.False(t, datasourceClusterStatusSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestReadClusterStatus(t *testing.T) {
+	apiURL := "https://api-1234.cluster.redpanda.com"
+	tests := []struct {
+		name          string
+		cluster       *controlplanev1beta2.Cluster
+		probeErr      error
+		wantStatus    string
+		wantReachable bool
+		wantHealthy   bool
+	}{
+		{
+			name:          "ready and reachable",
+			cluster:       &controlplanev1beta2.Cluster{Id: "cl-1", State: controlplanev1beta2.Cluster_STATE_READY, DataplaneApi: &controlplanev1beta2.Cluster_DataplaneAPI{Url: apiURL}},
+			wantStatus:    "ready",
+			wantReachable: true,
+			wantHealthy:   true,
+		},
+		{
+			name:       "ready but unreachable",
+			cluster:    &controlplanev1beta2.Cluster{Id: "cl-1", State: controlplanev1beta2.Cluster_STATE_READY, DataplaneApi: &controlplanev1beta2.Cluster_DataplaneAPI{Url: apiURL}},
+			probeErr:   errors.New("connection refused"),
+			wantStatus: "ready",
+		},
+		{
+			name: "degraded",
+			cluster: &controlplanev1beta2.Cluster{
+				Id:               "cl-1",
+				State:            controlplanev1beta2.Cluster_STATE_READY,
+				StateDescription: &rpcstatus.Status{Code: int32(codes.Unavailable), Message: "broker 2 is down"},
+				DataplaneApi:     &controlplanev1beta2.Cluster_DataplaneAPI{Url: apiURL},
+			},
+			wantStatus:    "degraded",
+			wantReachable: true,
+		},
+		{
+			name:       "creating",
+			cluster:    &controlplanev1beta2.Cluster{Id: "cl-1", State: controlplanev1beta2.Cluster_STATE_CREATING},
+			wantStatus: "provisioning",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			clusters := mocks.NewMockClusterServiceClient(ctrl)
+			clusters.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.GetClusterResponse{Cluster: tt.cluster}, nil)
+			topics := mocks.NewMockTopicServiceClient(ctrl)
+			if tt.cluster.GetDataplaneApi().GetUrl() != "" {
+				topics.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{}, tt.probeErr)
+			}
+			d := &DataSourceClusterStatus{CpCl: &cloud.ControlPlaneClientSet{Cluster: clusters}, TopicClient: topics}
+
+			s := datasourceClusterStatusSchema()
+			cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":                  tftypes.NewValue(tftypes.String, "cl-1"),
+				"state":               tftypes.NewValue(tftypes.String, nil),
+				"status":              tftypes.NewValue(tftypes.String, nil),
+				"status_reasons":      tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"state_description":   tftypes.NewValue(tftypes.String, nil),
+				"cluster_api_url":     tftypes.NewValue(tftypes.String, nil),
+				"dataplane_reachable": tftypes.NewValue(tftypes.Bool, nil),
+				"dataplane_error":     tftypes.NewValue(tftypes.String, nil),
+				"healthy":             tftypes.NewValue(tftypes.Bool, nil),
+			})}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: cfg}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var got models.ClusterStatus
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, types.StringValue(tt.wantStatus), got.Status)
+			assert.Equal(t, types.BoolValue(tt.wantReachable), got.DataplaneReachable)
+			assert.Equal(t, tt.wantReachable, got.DataplaneError.IsNull())
+			assert.Equal(t, types.BoolValue(tt.wantHealthy), got.Healthy)
+		})
+	}
+}
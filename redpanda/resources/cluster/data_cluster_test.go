@@ -0,0 +1,60 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+func TestDatasourceClusterSchema(t *testing.T) {
+	require.False(t, datasourceClusterSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestReadDatasourceCluster(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	clusters := mocks.NewMockClusterServiceClient(ctrl)
+	clusters.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.GetClusterResponse{Cluster: &controlplanev1beta2.Cluster{
+		Id:               "cl-1",
+		Name:             "orders",
+		State:            controlplanev1beta2.Cluster_STATE_READY,
+		StateDescription: &rpcstatus.Status{Code: int32(codes.Unavailable), Message: "broker 2 is down"},
+		CloudProvider:    controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS,
+		Type:             controlplanev1beta2.Cluster_TYPE_DEDICATED,
+		ConnectionType:   controlplanev1beta2.Cluster_CONNECTION_TYPE_PUBLIC,
+		DataplaneApi:     &controlplanev1beta2.Cluster_DataplaneAPI{Url: "https://api-1234.cluster.redpanda.com"},
+	}}, nil)
+	d := &DataSourceCluster{CpCl: &cloud.ControlPlaneClientSet{Cluster: clusters}}
+
+	s := datasourceClusterSchema()
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, nil)
+	}
+	attrs["id"] = tftypes.NewValue(tftypes.String, "cl-1")
+	cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objType, attrs)}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: cfg}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.Cluster
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.StringValue("orders"), got.Name)
+	assert.Equal(t, types.StringValue("degraded"), got.Status)
+	assert.Equal(t, 1, len(got.StatusReasons.Elements()))
+}
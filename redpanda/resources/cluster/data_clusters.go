@@ -0,0 +1,185 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceClusters{}
+	_ datasource.DataSourceWithConfigure = &DataSourceClusters{}
+)
+
+// DataSourceClusters represents a data source listing the clusters of a
+// resource group.
+type DataSourceClusters struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the metadata for the Clusters data source.
+func (*DataSourceClusters) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_clusters"
+}
+
+// Configure uses provider level data to configure DataSourceClusters' client.
+func (d *DataSourceClusters) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// Schema returns the schema for the Clusters data source.
+func (*DataSourceClusters) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceClustersSchema()
+}
+
+func datasourceClustersSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the resource group to list the clusters of",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the clusters whose name starts with this prefix",
+			},
+			"clusters": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The clusters of the resource group, ordered by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the cluster",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the cluster",
+						},
+						"cloud_provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud provider of the cluster",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Cloud provider region of the cluster",
+						},
+						"cluster_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the cluster, e.g. dedicated or byoc",
+						},
+						"network_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the network of the cluster",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "State of the cluster, e.g. ready or creating",
+						},
+						"cluster_api_url": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The URL of the cluster API, null until the cluster is ready",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Data source listing the clusters of a resource group, e.g. to manage the same topics on every cluster with for_each",
+	}
+}
+
+// Read lists the clusters of the resource group.
+func (d *DataSourceClusters) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.Clusters
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusters, err := listClusters(ctx, d.CpCl.Cluster, model.ResourceGroupID.ValueString(), model.NamePrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list the clusters of resource group %s", model.ResourceGroupID), err.Error())
+		return
+	}
+	model.Clusters = clusters
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// listClusters returns the clusters of the resource group whose name starts
+// with prefix, ordered by name.
+func listClusters(ctx context.Context, client controlplanev1beta2grpc.ClusterServiceClient, resourceGroupID, prefix string) ([]models.ClustersEntry, error) {
+	clusters := []models.ClustersEntry{}
+	var pageToken string
+	for {
+		list, err := client.ListClusters(ctx, &controlplanev1beta2.ListClustersRequest{
+			Filter:    &controlplanev1beta2.ListClustersRequest_Filter{ResourceGroupId: resourceGroupID},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range list.GetClusters() {
+			// The API filter is best effort, check the resource group too.
+			if c.GetResourceGroupId() != resourceGroupID || !strings.HasPrefix(c.GetName(), prefix) {
+				continue
+			}
+			entry := models.ClustersEntry{
+				ID:            types.StringValue(c.GetId()),
+				Name:          types.StringValue(c.GetName()),
+				CloudProvider: types.StringValue(utils.CloudProviderToString(c.GetCloudProvider())),
+				Region:        types.StringValue(c.GetRegion()),
+				ClusterType:   types.StringValue(utils.ClusterTypeToString(c.GetType())),
+				NetworkID:     types.StringValue(c.GetNetworkId()),
+				State:         types.StringValue(clusterStateToString(c.GetState())),
+				ClusterAPIURL: types.StringNull(),
+			}
+			if url := c.GetDataplaneApi().GetUrl(); url != "" {
+				entry.ClusterAPIURL = types.StringValue(url)
+			}
+			clusters = append(clusters, entry)
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" || len(list.GetClusters()) == 0 {
+			break
+		}
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Name.ValueString() < clusters[j].Name.ValueString()
+	})
+	return clusters, nil
+}
+
+func clusterStateToString(s controlplanev1beta2.Cluster_State) string {
+	return strings.ToLower(strings.TrimPrefix(s.String(), "STATE_"))
+}
@@ -0,0 +1,70 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasourceClustersSchema(t *testing.T) {
+	require.False(t, datasourceClustersSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestListClusters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockClusterServiceClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().ListClusters(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListClustersRequest, _ ...any) (*controlplanev1beta2.ListClustersResponse, error) {
+			assert.Equal(t, "rg-1", req.GetFilter().GetResourceGroupId())
+			assert.Empty(t, req.GetPageToken())
+			return &controlplanev1beta2.ListClustersResponse{
+				Clusters: []*controlplanev1beta2.Cluster{
+					{
+						Id: "cl-2", Name: "prod-us", ResourceGroupId: "rg-1", NetworkId: "net-2",
+						CloudProvider: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS, Region: "us-east-2",
+						Type: controlplanev1beta2.Cluster_TYPE_DEDICATED, State: controlplanev1beta2.Cluster_STATE_READY,
+						DataplaneApi: &controlplanev1beta2.Cluster_DataplaneAPI{Url: "api-2.cluster.redpanda.com:443"},
+					},
+					{Id: "cl-3", Name: "staging", ResourceGroupId: "rg-1"},
+				},
+				NextPageToken: "next",
+			}, nil
+		}),
+		client.EXPECT().ListClusters(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListClustersRequest, _ ...any) (*controlplanev1beta2.ListClustersResponse, error) {
+			assert.Equal(t, "next", req.GetPageToken())
+			return &controlplanev1beta2.ListClustersResponse{
+				Clusters: []*controlplanev1beta2.Cluster{
+					{
+						Id: "cl-1", Name: "prod-eu", ResourceGroupId: "rg-1", NetworkId: "net-1",
+						CloudProvider: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_GCP, Region: "europe-west1",
+						Type: controlplanev1beta2.Cluster_TYPE_BYOC, State: controlplanev1beta2.Cluster_STATE_CREATING,
+					},
+					{Id: "cl-4", Name: "prod-other", ResourceGroupId: "rg-2"},
+				},
+			}, nil
+		}),
+	)
+
+	got, err := listClusters(context.Background(), client, "rg-1", "prod-")
+	require.NoError(t, err)
+	assert.Equal(t, []models.ClustersEntry{
+		{
+			ID: types.StringValue("cl-1"), Name: types.StringValue("prod-eu"), CloudProvider: types.StringValue("gcp"),
+			Region: types.StringValue("europe-west1"), ClusterType: types.StringValue("byoc"), NetworkID: types.StringValue("net-1"),
+			State: types.StringValue("creating"), ClusterAPIURL: types.StringNull(),
+		},
+		{
+			ID: types.StringValue("cl-2"), Name: types.StringValue("prod-us"), CloudProvider: types.StringValue("aws"),
+			Region: types.StringValue("us-east-2"), ClusterType: types.StringValue("dedicated"), NetworkID: types.StringValue("net-2"),
+			State: types.StringValue("ready"), ClusterAPIURL: types.StringValue("api-2.cluster.redpanda.com:443"),
+		},
+	}, got)
+}
@@ -0,0 +1,176 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourcePrivateConnectivity{}
+	_ datasource.DataSourceWithConfigure = &DataSourcePrivateConnectivity{}
+)
+
+// DataSourcePrivateConnectivity represents a data source reporting the
+// private connectivity service of a cluster and the endpoints connected to
+// it.
+type DataSourcePrivateConnectivity struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the metadata for the PrivateConnectivity data source.
+func (*DataSourcePrivateConnectivity) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_private_connectivity"
+}
+
+// Configure uses provider level data to configure DataSourcePrivateConnectivity's client.
+func (d *DataSourcePrivateConnectivity) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// Schema returns the schema for the PrivateConnectivity data source.
+func (*DataSourcePrivateConnectivity) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourcePrivateConnectivitySchema()
+}
+
+func datasourcePrivateConnectivitySchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster",
+			},
+			"cloud_provider": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud provider of the cluster",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether AWS PrivateLink, GCP Private Service Connect or Azure Private Link is enabled on the cluster",
+			},
+			"service_name": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The service consumer endpoints connect to: the AWS PrivateLink endpoint service name, the GCP " +
+					"Private Service Connect service attachment or the Azure Private Link service name. Null until the service is created",
+			},
+			"service_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the AWS PrivateLink endpoint service or of the Azure Private Link service, null on GCP",
+			},
+			"connections": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The consumer endpoints connected to the service, ordered by endpoint ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the consumer endpoint: the VPC endpoint ID on AWS, the forwarding rule on GCP, or the private endpoint ID on Azure",
+						},
+						"connection_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the connection between the endpoint and the service",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "State of the connection as reported by the cloud provider, e.g. available, ACCEPTED or Approved",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Data source reporting the private connectivity service of a cluster, to create the consumer endpoints in the same apply and check that they are accepted",
+	}
+}
+
+// Read reads the private connectivity status of the cluster.
+func (d *DataSourcePrivateConnectivity) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.PrivateConnectivity
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := d.CpCl.ClusterForID(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", model.ID), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, privateConnectivityModel(cluster))...)
+}
+
+// privateConnectivityModel returns the private connectivity status of the
+// cluster, whichever its cloud provider.
+func privateConnectivityModel(cluster *controlplanev1beta2.Cluster) *models.PrivateConnectivity {
+	m := &models.PrivateConnectivity{
+		ID:            types.StringValue(cluster.GetId()),
+		CloudProvider: types.StringValue(utils.CloudProviderToString(cluster.GetCloudProvider())),
+		Enabled:       types.BoolValue(false),
+		ServiceName:   types.StringNull(),
+		ServiceID:     types.StringNull(),
+		Connections:   []models.PrivateConnectivityConnection{},
+	}
+	connection := func(endpointID, connectionID, state string) models.PrivateConnectivityConnection {
+		return models.PrivateConnectivityConnection{
+			EndpointID:   nonEmptyString(endpointID),
+			ConnectionID: nonEmptyString(connectionID),
+			State:        nonEmptyString(state),
+		}
+	}
+	switch {
+	case cluster.GetAwsPrivateLink() != nil:
+		status := cluster.GetAwsPrivateLink().GetStatus()
+		m.Enabled = types.BoolValue(cluster.GetAwsPrivateLink().GetEnabled())
+		m.ServiceName = nonEmptyString(status.GetServiceName())
+		m.ServiceID = nonEmptyString(status.GetServiceId())
+		for _, c := range status.GetVpcEndpointConnections() {
+			m.Connections = append(m.Connections, connection(c.GetId(), c.GetConnectionId(), c.GetState()))
+		}
+	case cluster.GetGcpPrivateServiceConnect() != nil:
+		status := cluster.GetGcpPrivateServiceConnect().GetStatus()
+		m.Enabled = types.BoolValue(cluster.GetGcpPrivateServiceConnect().GetEnabled())
+		m.ServiceName = nonEmptyString(status.GetServiceAttachment())
+		for _, c := range status.GetConnectedEndpoints() {
+			m.Connections = append(m.Connections, connection(c.GetEndpoint(), c.GetConnectionId(), c.GetStatus()))
+		}
+	case cluster.GetAzurePrivateLink() != nil:
+		status := cluster.GetAzurePrivateLink().GetStatus()
+		m.Enabled = types.BoolValue(cluster.GetAzurePrivateLink().GetEnabled())
+		m.ServiceName = nonEmptyString(status.GetServiceName())
+		m.ServiceID = nonEmptyString(status.GetServiceId())
+		for _, c := range status.GetPrivateEndpointConnections() {
+			m.Connections = append(m.Connections, connection(c.GetPrivateEndpointId(), c.GetConnectionId(), c.GetStatus()))
+		}
+	}
+	sort.Slice(m.Connections, func(i, j int) bool {
+		return m.Connections[i].EndpointID.ValueString() < m.Connections[j].EndpointID.ValueString()
+	})
+	return m
+}
@@ -0,0 +1,114 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasourcePrivateConnectivitySchema(t *testing.T) {
+	require.False(t, datasourcePrivateConnectivitySchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestPrivateConnectivityModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster *controlplanev1beta2.Cluster
+		want    *models.PrivateConnectivity
+	}{
+		{
+			name: "aws",
+			cluster: &controlplanev1beta2.Cluster{
+				Id:            "cl-1",
+				CloudProvider: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS,
+				AwsPrivateLink: &controlplanev1beta2.AWSPrivateLinkStatus{
+					Enabled: true,
+					Status: &controlplanev1beta2.AWSPrivateLinkStatus_Status{
+						ServiceId:   "vpce-svc-1",
+						ServiceName: "com.amazonaws.vpce.us-east-2.vpce-svc-1",
+						VpcEndpointConnections: []*controlplanev1beta2.AWSPrivateLinkStatus_Status_VPCEndpointConnection{
+							{Id: "vpce-2", ConnectionId: "conn-2", State: "pendingAcceptance"},
+							{Id: "vpce-1", ConnectionId: "conn-1", State: "available"},
+						},
+					},
+				},
+			},
+			want: &models.PrivateConnectivity{
+				ID:            types.StringValue("cl-1"),
+				CloudProvider: types.StringValue("aws"),
+				Enabled:       types.BoolValue(true),
+				ServiceName:   types.StringValue("com.amazonaws.vpce.us-east-2.vpce-svc-1"),
+				ServiceID:     types.StringValue("vpce-svc-1"),
+				Connections: []models.PrivateConnectivityConnection{
+					{EndpointID: types.StringValue("vpce-1"), ConnectionID: types.StringValue("conn-1"), State: types.StringValue("available")},
+					{EndpointID: types.StringValue("vpce-2"), ConnectionID: types.StringValue("conn-2"), State: types.StringValue("pendingAcceptance")},
+				},
+			},
+		},
+		{
+			name: "gcp service not created yet",
+			cluster: &controlplanev1beta2.Cluster{
+				Id:                       "cl-2",
+				CloudProvider:            controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_GCP,
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectStatus{Enabled: true},
+			},
+			want: &models.PrivateConnectivity{
+				ID:            types.StringValue("cl-2"),
+				CloudProvider: types.StringValue("gcp"),
+				Enabled:       types.BoolValue(true),
+				ServiceName:   types.StringNull(),
+				ServiceID:     types.StringNull(),
+				Connections:   []models.PrivateConnectivityConnection{},
+			},
+		},
+		{
+			name: "azure",
+			cluster: &controlplanev1beta2.Cluster{
+				Id:            "cl-3",
+				CloudProvider: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AZURE,
+				AzurePrivateLink: &controlplanev1beta2.AzurePrivateLinkStatus{
+					Enabled: true,
+					Status: &controlplanev1beta2.AzurePrivateLinkStatus_Status{
+						ServiceId:   "/subscriptions/sub/providers/Microsoft.Network/privateLinkServices/pls",
+						ServiceName: "pls",
+						PrivateEndpointConnections: []*controlplanev1beta2.AzurePrivateLinkStatus_Status_PrivateEndpointConnection{
+							{PrivateEndpointId: "pe-1", ConnectionId: "conn-1", Status: "Approved"},
+						},
+					},
+				},
+			},
+			want: &models.PrivateConnectivity{
+				ID:            types.StringValue("cl-3"),
+				CloudProvider: types.StringValue("azure"),
+				Enabled:       types.BoolValue(true),
+				ServiceName:   types.StringValue("pls"),
+				ServiceID:     types.StringValue("/subscriptions/sub/providers/Microsoft.Network/privateLinkServices/pls"),
+				Connections: []models.PrivateConnectivityConnection{
+					{EndpointID: types.StringValue("pe-1"), ConnectionID: types.StringValue("conn-1"), State: types.StringValue("Approved")},
+				},
+			},
+		},
+		{
+			name:    "public cluster",
+			cluster: &controlplanev1beta2.Cluster{Id: "cl-4", CloudProvider: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS},
+			want: &models.PrivateConnectivity{
+				ID:            types.StringValue("cl-4"),
+				CloudProvider: types.StringValue("aws"),
+				Enabled:       types.BoolValue(false),
+				ServiceName:   types.StringNull(),
+				ServiceID:     types.StringNull(),
+				Connections:   []models.PrivateConnectivityConnection{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, privateConnectivityModel(tt.cluster))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// forceDestroy deletes the topics, users and ACLs of the cluster before it is
+// deleted when force_destroy is set.
+func (c *Cluster) forceDestroy(ctx context.Context, clusterURL string) error {
+	conn, err := cloud.SpawnConn(clusterURL, c.authToken, c.proxy)
+	if err != nil {
+		return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
+	}
+	defer conn.Close()
+	return emptyDataplane(ctx, dataplaneClients{
+		Topic: dataplanev1alpha2grpc.NewTopicServiceClient(conn),
+		ACL:   dataplanev1alpha2grpc.NewACLServiceClient(conn),
+		User:  dataplanev1alpha2grpc.NewUserServiceClient(conn),
+	})
+}
+
+// emptyDataplane deletes all the ACLs, users and non-internal topics of a
+// cluster. ACLs are deleted first so that no principal is left with access to
+// the topics while they are being deleted.
+func emptyDataplane(ctx context.Context, cl dataplaneClients) error {
+	_, err := cl.ACL.DeleteACLs(ctx, &dataplanev1alpha2.DeleteACLsRequest{
+		Filter: &dataplanev1alpha2.DeleteACLsRequest_Filter{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_ANY,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_ANY,
+			Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ANY,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to delete ACLs: %v", err)
+	}
+
+	users, err := listUserNames(ctx, cl.User)
+	if err != nil {
+		return fmt.Errorf("unable to list users: %v", err)
+	}
+	for _, u := range users {
+		if _, err := cl.User.DeleteUser(ctx, &dataplanev1alpha2.DeleteUserRequest{Name: u}); err != nil && !utils.IsNotFound(err) {
+			return fmt.Errorf("unable to delete user %q: %v", u, err)
+		}
+	}
+
+	topics, err := listTopicNames(ctx, cl.Topic)
+	if err != nil {
+		return fmt.Errorf("unable to list topics: %v", err)
+	}
+	for _, tp := range topics {
+		if _, err := cl.Topic.DeleteTopic(ctx, &dataplanev1alpha2.DeleteTopicRequest{Name: tp}); err != nil && !utils.IsNotFound(err) {
+			return fmt.Errorf("unable to delete topic %q: %v", tp, err)
+		}
+	}
+	return nil
+}
+
+// listTopicNames returns the names of the non-internal topics. Topics are
+// listed before any is deleted so that deletions don't shift the pages.
+func listTopicNames(ctx context.Context, client dataplanev1alpha2grpc.TopicServiceClient) ([]string, error) {
+	var (
+		names     []string
+		pageToken string
+	)
+	for {
+		list, err := client.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, tp := range list.GetTopics() {
+			if !tp.GetInternal() {
+				names = append(names, tp.GetName())
+			}
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" {
+			return names, nil
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestEmptyDataplane(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(m *mocks.DataplaneMocks)
+		wantErr string
+	}{
+		{
+			name: "deletes_acls_users_and_topics",
+			setup: func(m *mocks.DataplaneMocks) {
+				gomock.InOrder(
+					m.ACL.EXPECT().DeleteACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.DeleteACLsResponse{}, nil),
+					m.User.EXPECT().DeleteUser(gomock.Any(), &dataplanev1alpha2.DeleteUserRequest{Name: "app"}).Return(&dataplanev1alpha2.DeleteUserResponse{}, nil),
+					m.User.EXPECT().DeleteUser(gomock.Any(), &dataplanev1alpha2.DeleteUserRequest{Name: "admin"}).Return(nil, status.Error(codes.NotFound, "gone")),
+				)
+				mocks.ExpectListUsers(m.User, 1, "app", "admin")
+				mocks.ExpectListTopics(m.Topic, 2,
+					&dataplanev1alpha2.ListTopicsResponse_Topic{Name: "__consumer_offsets", Internal: true},
+					mocks.Topic("orders", 3),
+					mocks.Topic("payments", 3),
+				)
+				m.Topic.EXPECT().DeleteTopic(gomock.Any(), &dataplanev1alpha2.DeleteTopicRequest{Name: "orders"}).Return(&dataplanev1alpha2.DeleteTopicResponse{}, nil)
+				m.Topic.EXPECT().DeleteTopic(gomock.Any(), &dataplanev1alpha2.DeleteTopicRequest{Name: "payments"}).Return(&dataplanev1alpha2.DeleteTopicResponse{}, nil)
+			},
+		},
+		{
+			name: "acl_failure_stops",
+			setup: func(m *mocks.DataplaneMocks) {
+				m.ACL.EXPECT().DeleteACLs(gomock.Any(), gomock.Any()).Return(nil, errors.New("denied"))
+			},
+			wantErr: "unable to delete ACLs",
+		},
+		{
+			name: "topic_failure",
+			setup: func(m *mocks.DataplaneMocks) {
+				m.ACL.EXPECT().DeleteACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.DeleteACLsResponse{}, nil)
+				mocks.ExpectListUsers(m.User, 10)
+				mocks.ExpectListTopics(m.Topic, 10, mocks.Topic("orders", 3))
+				m.Topic.EXPECT().DeleteTopic(gomock.Any(), gomock.Any()).Return(nil, errors.New("denied"))
+			},
+			wantErr: `unable to delete topic "orders"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := mocks.NewDataplaneMocks(ctrl)
+			tt.setup(m)
+
+			err := emptyDataplane(context.Background(), clientsOf(m))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"google.golang.org/genproto/googleapis/type/dayofweek"
+)
+
+// daysOfWeek are the accepted values of maintenance_window_config.day_hour.day_of_week.
+var daysOfWeek = []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY", "SUNDAY"}
+
+// toMaintenanceWindowConfig returns the maintenance window of the model, or nil
+// if none is set so that the cluster keeps its current window.
+func toMaintenanceWindowConfig(m *models.MaintenanceWindowConfig) *controlplanev1beta2.MaintenanceWindowConfig {
+	switch {
+	case m == nil:
+		return nil
+	case m.DayHour != nil:
+		return &controlplanev1beta2.MaintenanceWindowConfig{
+			Window: &controlplanev1beta2.MaintenanceWindowConfig_DayHour_{
+				DayHour: &controlplanev1beta2.MaintenanceWindowConfig_DayHour{
+					HourOfDay: int32(m.DayHour.HourOfDay.ValueInt64()),
+					DayOfWeek: dayofweek.DayOfWeek(dayofweek.DayOfWeek_value[m.DayHour.DayOfWeek.ValueString()]),
+				},
+			},
+		}
+	case m.Anytime.ValueBool():
+		return &controlplanev1beta2.MaintenanceWindowConfig{
+			Window: &controlplanev1beta2.MaintenanceWindowConfig_Anytime_{Anytime: &controlplanev1beta2.MaintenanceWindowConfig_Anytime{}},
+		}
+	case m.Unspecified.ValueBool():
+		return &controlplanev1beta2.MaintenanceWindowConfig{
+			Window: &controlplanev1beta2.MaintenanceWindowConfig_Unspecified_{Unspecified: &controlplanev1beta2.MaintenanceWindowConfig_Unspecified{}},
+		}
+	}
+	return nil
+}
+
+// toMaintenanceWindowModel returns the maintenance window of the cluster, or
+// nil if the cluster doesn't report one.
+func toMaintenanceWindowModel(c *controlplanev1beta2.MaintenanceWindowConfig) *models.MaintenanceWindowConfig {
+	switch w := c.GetWindow().(type) {
+	case *controlplanev1beta2.MaintenanceWindowConfig_DayHour_:
+		return &models.MaintenanceWindowConfig{
+			DayHour: &models.MaintenanceWindowDayHour{
+				HourOfDay: types.Int64Value(int64(w.DayHour.GetHourOfDay())),
+				DayOfWeek: types.StringValue(w.DayHour.GetDayOfWeek().String()),
+			},
+			Anytime:     types.BoolNull(),
+			Unspecified: types.BoolNull(),
+		}
+	case *controlplanev1beta2.MaintenanceWindowConfig_Anytime_:
+		return &models.MaintenanceWindowConfig{Anytime: types.BoolValue(true), Unspecified: types.BoolNull()}
+	case *controlplanev1beta2.MaintenanceWindowConfig_Unspecified_:
+		return &models.MaintenanceWindowConfig{Anytime: types.BoolNull(), Unspecified: types.BoolValue(true)}
+	}
+	return nil
+}
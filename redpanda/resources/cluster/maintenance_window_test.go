@@ -0,0 +1,63 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceWindowConversion(t *testing.T) {
+	tests := []struct {
+		name  string
+		model *models.MaintenanceWindowConfig
+	}{
+		{
+			name: "day_hour",
+			model: &models.MaintenanceWindowConfig{
+				DayHour: &models.MaintenanceWindowDayHour{
+					HourOfDay: types.Int64Value(22),
+					DayOfWeek: types.StringValue("WEDNESDAY"),
+				},
+				Anytime:     types.BoolNull(),
+				Unspecified: types.BoolNull(),
+			},
+		},
+		{
+			name:  "anytime",
+			model: &models.MaintenanceWindowConfig{Anytime: types.BoolValue(true), Unspecified: types.BoolNull()},
+		},
+		{
+			name:  "unspecified",
+			model: &models.MaintenanceWindowConfig{Anytime: types.BoolNull(), Unspecified: types.BoolValue(true)},
+		},
+		{
+			name: "unset",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.model, toMaintenanceWindowModel(toMaintenanceWindowConfig(tt.model)))
+		})
+	}
+}
+
+func TestMaintenanceWindowUpdate(t *testing.T) {
+	state := plannedCluster(func(m *models.Cluster) {
+		m.ID = types.StringValue("cqj0r0meag2gl7rs2mg0")
+		m.MaintenanceWindowConfig = &models.MaintenanceWindowConfig{Anytime: types.BoolValue(true), Unspecified: types.BoolNull()}
+	})
+	plan := state
+	plan.MaintenanceWindowConfig = &models.MaintenanceWindowConfig{
+		DayHour: &models.MaintenanceWindowDayHour{
+			HourOfDay: types.Int64Value(4),
+			DayOfWeek: types.StringValue("SATURDAY"),
+		},
+		Anytime:     types.BoolNull(),
+		Unspecified: types.BoolNull(),
+	}
+	req := generateUpdateRequest(plan, state)
+	assert.Equal(t, []string{"maintenance_window_config"}, req.GetUpdateMask().GetPaths())
+	assert.Equal(t, int32(4), req.GetCluster().GetMaintenanceWindowConfig().GetDayHour().GetHourOfDay())
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+const isReadReplicaSourceDescription = "Whether other clusters can create read-only topics from this cluster, i.e. " +
+	"`read_replica_cluster_ids` is not empty."
+
+// checkReadReplicas verifies that the read replica clusters exist and are in
+// the cloud provider and region of the source cluster, since read replicas
+// read the topics from its object storage. It returns a description of each
+// invalid cluster. Errors other than a missing cluster are returned so the
+// check can be skipped.
+func checkReadReplicas(ctx context.Context, client controlplanev1beta2grpc.ClusterServiceClient, cloudProvider, region string, ids []string) ([]string, error) {
+	var invalid []string
+	for _, id := range ids {
+		resp, err := client.GetCluster(ctx, &controlplanev1beta2.GetClusterRequest{Id: id})
+		if err != nil {
+			if utils.IsNotFound(err) {
+				invalid = append(invalid, fmt.Sprintf("cluster %q does not exist", id))
+				continue
+			}
+			return nil, fmt.Errorf("unable to get cluster %q: %v", id, err)
+		}
+		replica := resp.GetCluster()
+		if p := utils.CloudProviderToString(replica.GetCloudProvider()); p != cloudProvider {
+			invalid = append(invalid, fmt.Sprintf("cluster %q is on cloud provider %q, not %q", id, p, cloudProvider))
+			continue
+		}
+		if replica.GetRegion() != region {
+			invalid = append(invalid, fmt.Sprintf("cluster %q is in region %q, not %q", id, replica.GetRegion(), region))
+		}
+	}
+	return invalid, nil
+}
+
+// validatePlanReadReplicas checks the read replica clusters added by the plan
+// so that typos in their IDs fail the plan rather than the apply.
+func (c *Cluster) validatePlanReadReplicas(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var ids types.List
+	var cloudProvider, region types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("read_replica_cluster_ids"), &ids)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cloud_provider"), &cloudProvider)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() || ids.IsNull() || ids.IsUnknown() || cloudProvider.IsUnknown() || region.IsUnknown() {
+		return
+	}
+	var prior []string
+	if !req.State.Raw.IsNull() {
+		var stateIDs types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("read_replica_cluster_ids"), &stateIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = utils.TypeListToStringSlice(stateIDs)
+	}
+	var added []string
+	for _, v := range ids.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() || s.IsNull() {
+			// IDs of clusters created in the same apply are not known yet
+			continue
+		}
+		if !slices.Contains(prior, s.ValueString()) {
+			added = append(added, s.ValueString())
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+	invalid, err := checkReadReplicas(ctx, c.CpCl.Cluster, cloudProvider.ValueString(), region.ValueString(), added)
+	if err != nil {
+		// the check is best effort, the API validates the clusters again
+		tflog.Warn(ctx, "unable to validate the read replica clusters", map[string]any{"error": err.Error()})
+		return
+	}
+	for _, e := range invalid {
+		resp.Diagnostics.AddAttributeError(path.Root("read_replica_cluster_ids"), "invalid read replica cluster", e)
+	}
+}
+
+// planIsReadReplicaSource plans is_read_replica_source from the planned read
+// replica clusters.
+func planIsReadReplicaSource(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("read_replica_cluster_ids"), &ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_read_replica_source"), isReadReplicaSource(ids))...)
+}
+
+// isReadReplicaSource returns whether a cluster with the given read replica
+// clusters is the source of read replicas.
+func isReadReplicaSource(ids types.List) types.Bool {
+	if ids.IsUnknown() {
+		return types.BoolUnknown()
+	}
+	return types.BoolValue(len(ids.Elements()) > 0)
+}
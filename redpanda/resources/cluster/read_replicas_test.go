@@ -0,0 +1,89 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/stretchr/testify/assert"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func replicaResponse(provider controlplanev1beta2.CloudProvider, region string) *controlplanev1beta2.GetClusterResponse {
+	return &controlplanev1beta2.GetClusterResponse{
+		Cluster: &controlplanev1beta2.Cluster{CloudProvider: provider, Region: region},
+	}
+}
+
+func TestCheckReadReplicas(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func(m *mocks.MockClusterServiceClient)
+		wantInvalid []string
+		wantErr     bool
+	}{
+		{
+			name: "cluster in the same provider and region",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(replicaResponse(controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS, "us-east-2"), nil)
+			},
+		},
+		{
+			name: "missing cluster",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(nil, grpcstatus.Error(grpccodes.NotFound, "not found"))
+			},
+			wantInvalid: []string{`cluster "cl-replica" does not exist`},
+		},
+		{
+			name: "cluster on another cloud provider",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(replicaResponse(controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_GCP, "us-east-2"), nil)
+			},
+			wantInvalid: []string{`cluster "cl-replica" is on cloud provider "gcp", not "aws"`},
+		},
+		{
+			name: "cluster in another region",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(replicaResponse(controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS, "eu-west-1"), nil)
+			},
+			wantInvalid: []string{`cluster "cl-replica" is in region "eu-west-1", not "us-east-2"`},
+		},
+		{
+			name: "API error",
+			mockSetup: func(m *mocks.MockClusterServiceClient) {
+				m.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mocks.NewMockClusterServiceClient(ctrl)
+			tt.mockSetup(m)
+			invalid, err := checkReadReplicas(context.Background(), m, "aws", "us-east-2", []string{"cl-replica"})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantInvalid, invalid)
+		})
+	}
+}
+
+func TestIsReadReplicaSource(t *testing.T) {
+	assert.Equal(t, types.BoolValue(false), isReadReplicaSource(types.ListNull(types.StringType)))
+	assert.Equal(t, types.BoolValue(false), isReadReplicaSource(utils.StringSliceToTypeList(nil)))
+	assert.Equal(t, types.BoolValue(true), isReadReplicaSource(utils.StringSliceToTypeList([]string{"cl-replica"})))
+	assert.Equal(t, types.BoolUnknown(), isReadReplicaSource(types.ListUnknown(types.StringType)))
+}
@@ -0,0 +1,144 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// notAnID reports whether a lookup by ID failed because no object has that
+// ID, as opposed to the API being unreachable. resource_group_id and
+// network_id accept either the ID or the name of the referenced object: a
+// value is an ID when the API finds an object with that ID, anything else is
+// resolved as a name. Values are not matched against the format of IDs, since
+// a valid name can look like an ID.
+func notAnID(err error) bool {
+	return utils.IsNotFound(err) || grpcstatus.Code(err) == grpccodes.InvalidArgument
+}
+
+// resolveResourceGroupID returns the ID of the resource group referenced by
+// either its ID or its name.
+func (c *Cluster) resolveResourceGroupID(ctx context.Context, v string) (string, error) {
+	rg, err := c.CpCl.ResourceGroupForID(ctx, v)
+	if err == nil {
+		return rg.GetId(), nil
+	}
+	if !notAnID(err) {
+		return "", err
+	}
+	rg, err = c.CpCl.ResourceGroupForName(ctx, v)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither the ID nor the name of a resource group: %v", v, err)
+	}
+	return rg.GetId(), nil
+}
+
+// resolveNetworkID returns the ID of the network referenced by either its ID
+// or its name.
+func (c *Cluster) resolveNetworkID(ctx context.Context, v string) (string, error) {
+	n, err := c.CpCl.NetworkForID(ctx, v)
+	if err == nil {
+		return n.GetId(), nil
+	}
+	if !notAnID(err) {
+		return "", err
+	}
+	n, err = c.CpCl.NetworkForName(ctx, v)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither the ID nor the name of a network: %v", v, err)
+	}
+	return n.GetId(), nil
+}
+
+// referenceResolvers returns the resolver of each reference attribute.
+func (c *Cluster) referenceResolvers() map[string]func(context.Context, string) (string, error) {
+	return map[string]func(context.Context, string) (string, error){
+		"resource_group_id": c.resolveResourceGroupID,
+		"network_id":        c.resolveNetworkID,
+	}
+}
+
+// planReferences resolves the planned reference attributes that are new or
+// changed, so that a missing or ambiguous name fails the plan rather than the
+// apply. The attribute keeps the configured value, the resolved ID is planned
+// for its resolved_ attribute, and a warning points there when a name was
+// resolved.
+func (c *Cluster) planReferences(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	for attr, resolve := range c.referenceResolvers() {
+		p := path.Root(attr)
+		var plan, state types.String
+		diags := req.Plan.GetAttribute(ctx, p, &plan)
+		if !req.State.Raw.IsNull() {
+			diags.Append(req.State.GetAttribute(ctx, p, &state)...)
+		}
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+		if plan.IsUnknown() || plan.IsNull() || plan.Equal(state) {
+			continue
+		}
+		id, err := resolve(ctx, plan.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(p, fmt.Sprintf("unable to resolve %s", attr), err.Error())
+			continue
+		}
+		tflog.Info(ctx, "resolved cluster reference", map[string]any{"attribute": attr, "value": plan.ValueString(), "id": id})
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resolved_"+attr), id)...)
+		if id != plan.ValueString() {
+			resp.Diagnostics.AddAttributeWarning(p, fmt.Sprintf("%s is a name", attr),
+				fmt.Sprintf("%q was resolved to the ID %s. %s keeps the name, so references to it get the name; use resolved_%s where an ID is expected.",
+					plan.ValueString(), id, attr, attr))
+		}
+	}
+}
+
+// sameReference reports whether the planned and prior values of a reference
+// attribute resolve to the same object, e.g. when the configuration switches
+// from an ID to the name of the same resource group.
+func sameReference(ctx context.Context, plan, state types.String, resolve func(context.Context, string) (string, error)) (bool, error) {
+	if plan.IsUnknown() || plan.IsNull() || state.IsNull() {
+		return false, nil
+	}
+	planID, err := resolve(ctx, plan.ValueString())
+	if err != nil {
+		return false, err
+	}
+	stateID, err := resolve(ctx, state.ValueString())
+	if err != nil {
+		return false, err
+	}
+	return planID == stateID, nil
+}
+
+// referenceValue returns the value to persist for a reference attribute. The
+// configured ID or name is kept as is so that it matches the plan, the ID
+// reported by the API is used when nothing is configured, e.g. on import.
+func referenceValue(cfg types.String, id string) types.String {
+	if !cfg.IsNull() && !cfg.IsUnknown() && cfg.ValueString() != "" {
+		return cfg
+	}
+	return types.StringValue(id)
+}
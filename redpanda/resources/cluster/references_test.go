@@ -0,0 +1,245 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReferenceValue(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  types.String
+		id   string
+		want types.String
+	}{
+		{
+			name: "name is kept",
+			cfg:  types.StringValue("my-rg"),
+			id:   "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e",
+			want: types.StringValue("my-rg"),
+		},
+		{
+			name: "ID is kept",
+			cfg:  types.StringValue("cqcq4vb2n1jt9pp1bp2g"),
+			id:   "cqcq4vb2n1jt9pp1bp2g",
+			want: types.StringValue("cqcq4vb2n1jt9pp1bp2g"),
+		},
+		{
+			name: "null on import uses the ID",
+			cfg:  types.StringNull(),
+			id:   "cqcq4vb2n1jt9pp1bp2g",
+			want: types.StringValue("cqcq4vb2n1jt9pp1bp2g"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, referenceValue(tt.cfg, tt.id))
+		})
+	}
+}
+
+func TestResolveNetworkID(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		setup   func(m *mocks.MockNetworkServiceClient)
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "ID",
+			value: "cqcq4vb2n1jt9pp1bp2g",
+			setup: func(m *mocks.MockNetworkServiceClient) {
+				m.EXPECT().GetNetwork(gomock.Any(), &controlplanev1beta2.GetNetworkRequest{Id: "cqcq4vb2n1jt9pp1bp2g"}).
+					Return(&controlplanev1beta2.GetNetworkResponse{Network: &controlplanev1beta2.Network{Id: "cqcq4vb2n1jt9pp1bp2g"}}, nil)
+			},
+			want: "cqcq4vb2n1jt9pp1bp2g",
+		},
+		{
+			name:  "name that looks like an ID",
+			value: "prodnetworkuseast2ab",
+			setup: func(m *mocks.MockNetworkServiceClient) {
+				m.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "network not found"))
+				m.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{
+					Networks: []*controlplanev1beta2.Network{{Id: "cqcq4vb2n1jt9pp1bp2g", Name: "prodnetworkuseast2ab"}},
+				}, nil)
+			},
+			want: "cqcq4vb2n1jt9pp1bp2g",
+		},
+		{
+			name:  "name rejected as an ID",
+			value: "my-network",
+			setup: func(m *mocks.MockNetworkServiceClient) {
+				m.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "invalid id"))
+				m.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{
+					Networks: []*controlplanev1beta2.Network{{Id: "cqcq4vb2n1jt9pp1bp2g", Name: "my-network"}},
+				}, nil)
+			},
+			want: "cqcq4vb2n1jt9pp1bp2g",
+		},
+		{
+			name:  "unknown name",
+			value: "missing",
+			setup: func(m *mocks.MockNetworkServiceClient) {
+				m.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "network not found"))
+				m.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{}, nil)
+			},
+			wantErr: `"missing" is neither the ID nor the name of a network`,
+		},
+		{
+			name:  "API unavailable",
+			value: "cqcq4vb2n1jt9pp1bp2g",
+			setup: func(m *mocks.MockNetworkServiceClient) {
+				m.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unavailable, "unavailable"))
+			},
+			wantErr: "unavailable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks.NewMockNetworkServiceClient(ctrl)
+			tt.setup(m)
+			c := &Cluster{CpCl: &cloud.ControlPlaneClientSet{Network: m}}
+			got, err := c.resolveNetworkID(context.Background(), tt.value)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveResourceGroupID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mocks.NewMockResourceGroupServiceClient(ctrl)
+	m.EXPECT().GetResourceGroup(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "invalid id"))
+	m.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{
+		ResourceGroups: []*controlplanev1beta2.ResourceGroup{{Id: "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e", Name: "my-rg"}},
+	}, nil)
+	c := &Cluster{CpCl: &cloud.ControlPlaneClientSet{ResourceGroup: m}}
+	got, err := c.resolveResourceGroupID(context.Background(), "my-rg")
+	assert.NoError(t, err)
+	assert.Equal(t, "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e", got)
+}
+
+func TestSameReference(t *testing.T) {
+	resolve := func(_ context.Context, v string) (string, error) {
+		switch v {
+		case "my-network", "cqcq4vb2n1jt9pp1bp2g":
+			return "cqcq4vb2n1jt9pp1bp2g", nil
+		case "other-network":
+			return "cqcq50r2n1jt9pp1bp40", nil
+		}
+		return "", errors.New("network not found")
+	}
+	tests := []struct {
+		name    string
+		plan    types.String
+		state   types.String
+		want    bool
+		wantErr bool
+	}{
+		{name: "name of the same network", plan: types.StringValue("my-network"), state: types.StringValue("cqcq4vb2n1jt9pp1bp2g"), want: true},
+		{name: "name of another network", plan: types.StringValue("other-network"), state: types.StringValue("cqcq4vb2n1jt9pp1bp2g"), want: false},
+		{name: "unknown plan", plan: types.StringUnknown(), state: types.StringValue("cqcq4vb2n1jt9pp1bp2g"), want: false},
+		{name: "unknown name", plan: types.StringValue("missing"), state: types.StringValue("cqcq4vb2n1jt9pp1bp2g"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sameReference(context.Background(), tt.plan, tt.state, resolve)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPlanReferences(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	rg := mocks.NewMockResourceGroupServiceClient(ctrl)
+	rg.EXPECT().GetResourceGroup(gomock.Any(), gomock.Any()).
+		Return(&controlplanev1beta2.GetResourceGroupResponse{ResourceGroup: &controlplanev1beta2.ResourceGroup{Id: "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e"}}, nil)
+	network := mocks.NewMockNetworkServiceClient(ctrl)
+	network.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "network not found"))
+	network.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{}, nil)
+	c := &Cluster{CpCl: &cloud.ControlPlaneClientSet{ResourceGroup: rg, Network: network}}
+
+	plan := tfsdk.Plan{Schema: resourceClusterSchema()}
+	model := models.ClusterResource{
+		Cluster: plannedCluster(func(m *models.Cluster) {
+			m.ResourceGroupID = types.StringValue("0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e")
+			m.NetworkID = types.StringValue("missing")
+		}),
+		Timeouts: testutil.NullTimeouts("create", "update", "delete"),
+	}
+	if d := plan.Set(ctx, model); d.HasError() {
+		t.Fatalf("unable to set plan: %v", d)
+	}
+	state := emptyClusterState(ctx)
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	c.planReferences(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+	assert.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), `"missing" is neither the ID nor the name of a network`)
+}
+
+func TestPlanReferencesResolvesNames(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	rg := mocks.NewMockResourceGroupServiceClient(ctrl)
+	rg.EXPECT().GetResourceGroup(gomock.Any(), gomock.Any()).
+		Return(&controlplanev1beta2.GetResourceGroupResponse{ResourceGroup: &controlplanev1beta2.ResourceGroup{Id: "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e"}}, nil)
+	network := mocks.NewMockNetworkServiceClient(ctrl)
+	network.EXPECT().GetNetwork(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "network not found"))
+	network.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{
+		Networks: []*controlplanev1beta2.Network{{Id: "cqcq4vb2n1jt9pp1bp2g", Name: "my-network"}},
+	}, nil)
+	c := &Cluster{CpCl: &cloud.ControlPlaneClientSet{ResourceGroup: rg, Network: network}}
+
+	plan := tfsdk.Plan{Schema: resourceClusterSchema()}
+	model := models.ClusterResource{
+		Cluster: plannedCluster(func(m *models.Cluster) {
+			m.ResourceGroupID = types.StringValue("0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e")
+			m.NetworkID = types.StringValue("my-network")
+		}),
+		Timeouts: testutil.NullTimeouts("create", "update", "delete"),
+	}
+	if d := plan.Set(ctx, model); d.HasError() {
+		t.Fatalf("unable to set plan: %v", d)
+	}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	c.planReferences(ctx, resource.ModifyPlanRequest{Plan: plan, State: emptyClusterState(ctx)}, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	if assert.Equal(t, 1, resp.Diagnostics.WarningsCount(), resp.Diagnostics) {
+		assert.Equal(t, path.Root("network_id"), resp.Diagnostics.Warnings()[0].(diag.DiagnosticWithPath).Path())
+	}
+	var got models.ClusterResource
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &got)...)
+	assert.Equal(t, types.StringValue("my-network"), got.NetworkID)
+	assert.Equal(t, types.StringValue("cqcq4vb2n1jt9pp1bp2g"), got.ResolvedNetworkID)
+	assert.Equal(t, types.StringValue("0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e"), got.ResolvedResourceGroupID)
+}
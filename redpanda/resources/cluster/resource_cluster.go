@@ -20,18 +20,26 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
@@ -41,15 +49,26 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &Cluster{}
-	_ resource.ResourceWithConfigure   = &Cluster{}
-	_ resource.ResourceWithImportState = &Cluster{}
+	_ resource.Resource                   = &Cluster{}
+	_ resource.ResourceWithConfigure      = &Cluster{}
+	_ resource.ResourceWithImportState    = &Cluster{}
+	_ resource.ResourceWithModifyPlan     = &Cluster{}
+	_ resource.ResourceWithIdentity       = &Cluster{}
+	_ resource.ResourceWithValidateConfig = &Cluster{}
 )
 
 // Cluster represents a cluster managed resource.
 type Cluster struct {
 	CpCl *cloud.ControlPlaneClientSet
 	Byoc *utils.ByocClient
+
+	authToken   string
+	proxy       *cloud.Proxy
+	defaultTags map[string]string
+	timeouts    config.Timeouts
+	polling     config.Polling
+
+	preventDuplicateNames bool
 }
 
 // Metadata returns the full name of the Cluster resource.
@@ -59,20 +78,18 @@ func (*Cluster) Metadata(_ context.Context, _ resource.MetadataRequest, resp *re
 
 // Configure uses provider level data to configure Cluster's clients.
 func (c *Cluster) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	p, ok := req.ProviderData.(config.Resource)
-
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 
 	c.Byoc = p.ByocClient
+	c.authToken = p.AuthToken
+	c.proxy = p.Proxy
+	c.defaultTags = p.DefaultTags
+	c.timeouts = p.Timeouts
+	c.polling = p.Polling
+	c.preventDuplicateNames = p.PreventDuplicateNames
 	c.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
 }
 
@@ -81,98 +98,423 @@ func (*Cluster) Schema(_ context.Context, _ resource.SchemaRequest, resp *resour
 	resp.Schema = resourceClusterSchema()
 }
 
+// IdentitySchema returns the identity schema for the Cluster resource.
+func (*Cluster) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = utils.IDIdentitySchema("ID of the cluster.")
+}
+
 func resourceClusterSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "Unique name of the cluster.",
+				Required:            true,
+				MarkdownDescription: "Unique name of the cluster.",
 			},
 			"cluster_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "Cluster type. Type is immutable and can only be set on cluster creation.",
-				Validators:    validators.ClusterTypes(),
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Cluster type. Type is immutable and can only be set on cluster creation.",
+				Validators:          validators.ClusterTypes(),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"connection_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "Cluster connection type. Private clusters are not exposed to the internet. For BYOC clusters, Private is best-practice.",
-				Validators:    validators.ConnectionTypes(),
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Cluster connection type. Private clusters are not exposed to the internet. For BYOC clusters, Private is best-practice.",
+				Validators:          validators.ConnectionTypes(),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"cloud_provider": schema.StringAttribute{
-				Optional:      true,
-				Description:   "Cloud provider where resources are created.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Validators:    validators.CloudProviders(),
+				Optional:            true,
+				MarkdownDescription: "Cloud provider where resources are created.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          validators.CloudProviders(),
 			},
 			"redpanda_version": schema.StringAttribute{
-				Optional:      true,
-				Description:   "Current Redpanda version of the cluster.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Optional:            true,
+				MarkdownDescription: "Current Redpanda version of the cluster.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"throughput_tier": schema.StringAttribute{
-				Required:      true,
-				Description:   "Throughput tier of the cluster.",
+				Required:            true,
+				MarkdownDescription: "Throughput tier of the cluster. Changing the throughput tier replaces the cluster, as the Redpanda Cloud API does not resize clusters in place. The plan warns with the change of the tier limits.",
+				// ClusterUpdate has no throughput tier, the cluster must be recreated
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"region": schema.StringAttribute{
-				Optional:      true,
-				Description:   "Cloud provider region. Region represents the name of the region where the cluster will be provisioned.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Optional:            true,
+				MarkdownDescription: "Cloud provider region. Region represents the name of the region where the cluster will be provisioned.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"zones": schema.ListAttribute{
-				Optional:      true,
-				Description:   "Zones of the cluster. Must be valid zones within the selected region. If multiple zones are used, the cluster is a multi-AZ cluster.",
-				ElementType:   types.StringType,
-				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+				Optional:            true,
+				MarkdownDescription: "Zones of the cluster. Must be valid zones within the selected region. If multiple zones are used, the cluster is a multi-AZ cluster. AWS zones are zone IDs such as use1-az1, not zone names such as us-east-1a. The Redpanda Cloud API cannot add zones to or remove zones from an existing cluster, so changing the zones replaces the cluster.",
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
 			},
 			"allow_deletion": schema.BoolAttribute{
-				Optional:    true,
-				Description: "Allows deletion of the cluster. Defaults to true. Should probably be set to false for production use.",
+				Optional:            true,
+				MarkdownDescription: "Allows deletion of the cluster. Defaults to true. Should probably be set to false for production use.",
 			},
 			"tags": schema.MapAttribute{
-				Optional:      true,
-				Description:   "Tags placed on cloud resources. If the cloud provider is GCP and the name of a tag has the prefix \"gcp.network-tag.\", the tag is a network tag that will be added to the Redpanda cluster GKE nodes. Otherwise, the tag is a normal tag. For example, if the name of a tag is \"gcp.network-tag.network-tag-foo\", the network tag named \"network-tag-foo\" will be added to the Redpanda cluster GKE nodes. Note: The value of a network tag will be ignored. See the details on network tags at https://cloud.google.com/vpc/docs/add-remove-network-tags.",
-				ElementType:   types.StringType,
-				PlanModifiers: []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+				Optional:            true,
+				MarkdownDescription: "Tags placed on cloud resources. If the cloud provider is GCP and the name of a tag has the prefix \"gcp.network-tag.\", the tag is a network tag that will be added to the Redpanda cluster GKE nodes. Otherwise, the tag is a normal tag. For example, if the name of a tag is \"gcp.network-tag.network-tag-foo\", the network tag named \"network-tag-foo\" will be added to the Redpanda cluster GKE nodes. Note: The value of a network tag will be ignored. See the details on network tags at https://cloud.google.com/vpc/docs/add-remove-network-tags. Tags that break the naming rules of the cloud provider, e.g. GCP tags that are not lowercase or Azure tags that only differ by case, are reported as warnings during the plan. Changing tags updates the cluster in place.",
+				ElementType:         types.StringType,
+				Validators:          []validator.Map{validators.CloudTagsValidator{}},
+			},
+			"tags_all": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: "Tags placed on cloud resources: the tags of the cluster merged with the default_tags of the provider. Changes of the default_tags are applied without replacing the cluster.",
+				ElementType:         types.StringType,
 			},
 			"resource_group_id": schema.StringAttribute{
-				Required:      true,
-				Description:   "Resource group ID of the cluster.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Resource group ID or name of the cluster. A name is resolved to the ID of the resource group with that name. The attribute keeps the configured form, use `resolved_resource_group_id` for the ID.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"network_id": schema.StringAttribute{
-				Required:      true,
-				Description:   "Network ID where cluster is placed.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Network ID or name where cluster is placed. A name is resolved to the ID of the network with that name. The attribute keeps the configured form, use `resolved_network_id` for the ID.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"resolved_resource_group_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the resource group of the cluster, whether `resource_group_id` is set to its ID or its name.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"resolved_network_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the network of the cluster, whether `network_id` is set to its ID or its name.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				Description:   "ID of the cluster. ID is an output from the Create Cluster endpoint and cannot be set by the caller.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "ID of the cluster. ID is an output from the Create Cluster endpoint and cannot be set by the caller.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"cluster_api_url": schema.StringAttribute{
-				Computed:      true,
-				Description:   "The URL of the cluster API.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster API.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"kafka_bootstrap_servers": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The Kafka API seed brokers, to use as the bootstrap servers of Kafka clients. Empty until the cluster is ready.",
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"schema_registry_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the Schema Registry, null until the cluster is ready.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"http_proxy_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the HTTP Proxy, null until the cluster is ready.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"endpoints": schema.SingleNestedAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Connection information of the cluster, grouped so that it can be referenced or encoded to JSON as a single object. Endpoints that are not yet available are null.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"bootstrap_servers": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Kafka API bootstrap servers.",
+					},
+					"schema_registry_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Schema Registry URL.",
+					},
+					"http_proxy_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "HTTP Proxy URL.",
+					},
+					"console_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Redpanda Console URL.",
+					},
+					"cluster_api_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Cluster API URL.",
+					},
+					"prometheus_url": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Prometheus metrics endpoint URL.",
+					},
+					"kafka_api_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the Kafka API must authenticate with mTLS.",
+					},
+					"http_proxy_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the HTTP Proxy must authenticate with mTLS.",
+					},
+					"schema_registry_mtls_required": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Whether clients of the Schema Registry must authenticate with mTLS.",
+					},
+				},
+			},
+			"maintenance_window_config": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Window in which Redpanda Cloud upgrades the cluster. Exactly one of `day_hour`, `anytime` or `unspecified` is set. Changing the window updates the cluster in place, and removing it leaves the window of the cluster unchanged.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"day_hour": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Weekly window starting at an hour of a day of the week.",
+						Validators: []validator.Object{
+							objectvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("anytime"),
+								path.MatchRelative().AtParent().AtName("unspecified"),
+							),
+						},
+						Attributes: map[string]schema.Attribute{
+							"hour_of_day": schema.Int64Attribute{
+								Required:            true,
+								MarkdownDescription: "Hour of the day the window starts at, in UTC, from 0 to 23.",
+								Validators:          []validator.Int64{int64validator.Between(0, 23)},
+							},
+							"day_of_week": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Day of the week of the window, e.g. MONDAY.",
+								Validators:          []validator.String{stringvalidator.OneOf(daysOfWeek...)},
+							},
+						},
+					},
+					"anytime": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Set to true to allow upgrades at any time.",
+					},
+					"unspecified": schema.BoolAttribute{
+						Optional:            true,
+						MarkdownDescription: "Set to true to let Redpanda Cloud pick the window.",
+					},
+				},
+			},
+			"customer_managed_resources": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Cloud resources created and managed by you for a BYOC cluster deployed into your own VPC " +
+					"(BYOVPC), used together with the `customer_managed_resources` of the network. Only the block of the " +
+					"cluster's cloud provider can be set. Changing them replaces the cluster.",
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Customer-managed resources on AWS.",
+						Attributes: map[string]schema.Attribute{
+							"agent_instance_profile_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the instance profile of the Redpanda Cloud agent.",
+							},
+							"connectors_node_group_instance_profile_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the instance profile of the connectors node group.",
+							},
+							"utility_node_group_instance_profile_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the instance profile of the utility node group.",
+							},
+							"redpanda_node_group_instance_profile_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the instance profile of the Redpanda node group.",
+							},
+							"k8s_cluster_role_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the IAM role of the EKS cluster.",
+							},
+							"console_secrets_manager_role_arn": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "ARN of the IAM role Redpanda Console uses to access AWS Secrets Manager.",
+							},
+							"connectors_secrets_manager_role_arn": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "ARN of the IAM role managed connectors use to access AWS Secrets Manager.",
+							},
+							"redpanda_cloud_storage_manager_role_arn": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "ARN of the IAM role Redpanda uses to manage the cloud storage bucket.",
+							},
+							"redpanda_agent_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group of the Redpanda Cloud agent.",
+							},
+							"connectors_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group of the connectors node group.",
+							},
+							"redpanda_node_group_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group of the Redpanda node group.",
+							},
+							"utility_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group of the utility node group.",
+							},
+							"cluster_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group of the EKS cluster.",
+							},
+							"node_security_group_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the security group shared by the EKS nodes.",
+							},
+							"cloud_storage_bucket_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the S3 bucket used for Tiered Storage.",
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Customer-managed resources on GCP.",
+						Attributes: map[string]schema.Attribute{
+							"subnet": schema.SingleNestedAttribute{
+								Required:            true,
+								MarkdownDescription: "Subnet the Redpanda cluster is deployed into.",
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the subnet.",
+									},
+									"secondary_ipv4_range_pods_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the secondary IPv4 range of the subnet for pods.",
+									},
+									"secondary_ipv4_range_services_name": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Name of the secondary IPv4 range of the subnet for services.",
+									},
+									"k8s_master_ipv4_range": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "IPv4 range of the Kubernetes control plane, e.g. 10.0.0.0/24.",
+									},
+								},
+							},
+							"agent_service_account_email": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Email of the service account of the Redpanda Cloud agent.",
+							},
+							"console_service_account_email": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Email of the service account of Redpanda Console.",
+							},
+							"connector_service_account_email": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Email of the service account of managed connectors.",
+							},
+							"redpanda_cluster_service_account_email": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Email of the service account of the Redpanda cluster.",
+							},
+							"gke_service_account_email": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Email of the service account of the GKE cluster.",
+							},
+							"tiered_storage_bucket_name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Name of the Cloud Storage bucket used for Tiered Storage.",
+							},
+							"psc_nat_subnet_name": schema.StringAttribute{
+								Optional:            true,
+								MarkdownDescription: "Name of the NAT subnet of Private Service Connect, required when `gcp_private_service_connect` is enabled.",
+							},
+						},
+					},
+				},
+			},
+			"listeners": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: listenersDescription,
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+				Attributes: map[string]schema.Attribute{
+					"seed_broker_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of seed brokers of the Kafka API.",
+					},
+					"kafka_api_hosts": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "DNS names of the seed brokers of the Kafka API.",
+					},
+					"kafka_api_ports": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.Int64Type,
+						MarkdownDescription: "Ports of the seed brokers of the Kafka API, in ascending order.",
+					},
+					"http_proxy_port": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Port of the HTTP Proxy.",
+					},
+					"schema_registry_port": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Port of the Schema Registry.",
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: statusDescription,
+			},
+			"status_reasons": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: statusReasonsDescription,
+			},
+			"byoc_agent_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: byocAgentStatusDescription,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"aws_private_link": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "The AWS Private Link configuration.",
+				Optional:            true,
+				MarkdownDescription: "The AWS Private Link configuration.",
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether Redpanda AWS Private Link Endpoint Service is enabled.",
+						Required:            true,
+						MarkdownDescription: "Whether Redpanda AWS Private Link Endpoint Service is enabled.",
 					},
 					"connect_console": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether Console is connected in Redpanda AWS Private Link Service.",
+						Required:            true,
+						MarkdownDescription: "Whether Console is connected in Redpanda AWS Private Link Service.",
 					},
 					"allowed_principals": schema.ListAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Description: "The ARN of the principals that can access the Redpanda AWS PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+						ElementType:         types.StringType,
+						Required:            true,
+						MarkdownDescription: "The ARN of the principals that can access the Redpanda AWS PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+					},
+					"listener_ports": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Ports assigned to each listener of the Redpanda AWS Private Link Endpoint Service.",
+						PlanModifiers:       []planmodifier.Object{objectplanmodifier.UseStateForUnknown()},
+						Attributes: map[string]schema.Attribute{
+							"kafka_api_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Kafka API seed port.",
+							},
+							"kafka_api_node_base_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Kafka API base port for the brokers. Each broker is reachable on the base port plus its node index.",
+							},
+							"redpanda_proxy_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "HTTP Proxy seed port.",
+							},
+							"redpanda_proxy_node_base_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "HTTP Proxy base port for the brokers. Each broker is reachable on the base port plus its node index.",
+							},
+							"schema_registry_seed_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Schema Registry seed port.",
+							},
+							"console_port": schema.Int64Attribute{
+								Computed:            true,
+								MarkdownDescription: "Console port.",
+							},
+						},
 					},
 				},
 				Validators: []validator.Object{
@@ -183,21 +525,21 @@ func resourceClusterSchema() schema.Schema {
 				},
 			},
 			"azure_private_link": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "The Azure Private Link configuration.",
+				Optional:            true,
+				MarkdownDescription: "The Azure Private Link configuration.",
 				Attributes: map[string]schema.Attribute{
 					"allowed_subscriptions": schema.ListAttribute{
-						ElementType: types.StringType,
-						Required:    true,
-						Description: "The subscriptions that can access the Redpanda Azure PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
+						ElementType:         types.StringType,
+						Required:            true,
+						MarkdownDescription: "The subscriptions that can access the Redpanda Azure PrivateLink Endpoint Service. To grant permissions to all principals, use an asterisk (*).",
 					},
 					"connect_console": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether Console is connected in Redpanda Azure Private Link Service.",
+						Required:            true,
+						MarkdownDescription: "Whether Console is connected in Redpanda Azure Private Link Service.",
 					},
 					"enabled": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether Redpanda Azure Private Link Endpoint Service is enabled.",
+						Required:            true,
+						MarkdownDescription: "Whether Redpanda Azure Private Link Endpoint Service is enabled.",
 					},
 				},
 				Validators: []validator.Object{
@@ -208,25 +550,53 @@ func resourceClusterSchema() schema.Schema {
 				},
 			},
 			"gcp_private_service_connect": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "The GCP Private Service Connect configuration.",
+				Optional:            true,
+				MarkdownDescription: "The GCP Private Service Connect configuration.",
 				Attributes: map[string]schema.Attribute{
 					"enabled": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether Redpanda GCP Private Service Connect is enabled.",
+						Required:            true,
+						MarkdownDescription: "Whether Redpanda GCP Private Service Connect is enabled.",
 					},
 					"global_access_enabled": schema.BoolAttribute{
-						Required:    true,
-						Description: "Whether global access is enabled.",
+						Required:            true,
+						MarkdownDescription: "Whether global access is enabled.",
 					},
 					"consumer_accept_list": schema.ListNestedAttribute{
-						Required:    true,
-						Description: "List of consumers that are allowed to connect to Redpanda GCP PSC (Private Service Connect) service attachment.",
+						Required:            true,
+						MarkdownDescription: "List of consumers that are allowed to connect to Redpanda GCP PSC (Private Service Connect) service attachment.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"source": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "Either the GCP project number or its alphanumeric ID.",
+								},
+							},
+						},
+					},
+					"consumer_status": schema.ListNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Status of the connections of the consumers to the Redpanda GCP PSC service attachment. There is one element per connected endpoint, and one element without connection for each consumer of the accept list that has none.",
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
 								"source": schema.StringAttribute{
-									Required:    true,
-									Description: "Either the GCP project number or its alphanumeric ID.",
+									Computed:            true,
+									MarkdownDescription: "Entry of the consumer accept list matching the project of the endpoint.",
+								},
+								"connection_id": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "ID of the connection.",
+								},
+								"consumer_network": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Network of the consumer endpoint.",
+								},
+								"endpoint": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "IP address of the consumer endpoint.",
+								},
+								"status": schema.StringAttribute{
+									Computed:            true,
+									MarkdownDescription: "Status of the connection, e.g. PENDING, ACCEPTED or REJECTED.",
 								},
 							},
 						},
@@ -240,103 +610,322 @@ func resourceClusterSchema() schema.Schema {
 				},
 			},
 			"kafka_api": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "Cluster's Kafka API properties.",
+				Optional:            true,
+				MarkdownDescription: "Cluster's Kafka API properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Required:    true,
-						Description: "mTLS configuration.",
+						Required:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Required:    true,
-								Description: "Whether mTLS is enabled.",
+								Required:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"http_proxy": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "HTTP Proxy properties.",
+				Optional:            true,
+				MarkdownDescription: "HTTP Proxy properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Required:    true,
-						Description: "mTLS configuration.",
+						Required:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Required:    true,
-								Description: "Whether mTLS is enabled.",
+								Required:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"schema_registry": schema.SingleNestedAttribute{
-				Optional:    true,
-				Description: "Cluster's Schema Registry properties.",
+				Optional:            true,
+				MarkdownDescription: "Cluster's Schema Registry properties.",
 				Attributes: map[string]schema.Attribute{
 					"mtls": schema.SingleNestedAttribute{
-						Required:    true,
-						Description: "mTLS configuration.",
+						Required:            true,
+						MarkdownDescription: "mTLS configuration.",
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
-								Required:    true,
-								Description: "Whether mTLS is enabled.",
+								Required:            true,
+								MarkdownDescription: "Whether mTLS is enabled.",
 							},
 							"ca_certificates_pem": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "CA certificate in PEM format.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "CA certificate in PEM format.",
 							},
 							"principal_mapping_rules": schema.ListAttribute{
-								ElementType: types.StringType,
-								Required:    true,
-								Description: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
+								ElementType:         types.StringType,
+								Required:            true,
+								MarkdownDescription: "Principal mapping rules for mTLS authentication. See the Redpanda documentation on configuring authentication.",
 							},
 						},
 					},
 				},
 			},
 			"read_replica_cluster_ids": schema.ListAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-				Description: "IDs of clusters which may create read-only topics from this cluster.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs of clusters which may create read-only topics from this cluster. They must be in the cloud provider and region of this cluster.",
+			},
+			"is_read_replica_source": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: isReadReplicaSourceDescription,
+			},
+			"wait_for_pending_deletion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If the cluster is found in a deleting state when it is read, wait for the deletion to finish before removing it from state. Defaults to false, in which case the cluster is removed from state immediately and recreated on the next apply.",
+			},
+			"clone_from_cluster_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of an existing cluster to copy topics, topic configurations and ACLs from once the new cluster is ready. Only used on creation. User credentials cannot be read back from the source cluster, so any users found there are reported in a warning and must be recreated. Changing it replaces the cluster.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Delete all the topics, users and ACLs of the cluster through the cluster API before destroying it, including the ones not managed by Terraform. Defaults to false. Must be applied before a destroy to take effect. Deleting only the objects managed by Terraform is not supported, as Terraform already destroys the topic, user and ACL resources that reference the cluster before the cluster itself.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
+	}
+}
+
+// ValidateConfig checks the customer-managed resources of the cluster against
+// its cloud provider and cluster type.
+func (*Cluster) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// only the checked attributes are read, the others may be unknown
+	var cmr types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("customer_managed_resources"), &cmr)...)
+	if resp.Diagnostics.HasError() || cmr.IsNull() || cmr.IsUnknown() {
+		return
+	}
+	var model models.Cluster
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("customer_managed_resources"), &model.CustomerManagedResources)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cluster_type"), &model.ClusterType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cloud_provider"), &model.CloudProvider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateCustomerManagedResources(model)...)
+}
+
+// ModifyPlan validates the zones of new clusters and the added read replica
+// clusters, checks the names of new clusters when prevent_duplicate_names is
+// set, resolves new resource_group_id and network_id references, and drops
+// the replacement of a cluster when one of them changes between the ID and
+// the name of the same object.
+func (c *Cluster) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	c.planTagsAll(ctx, req, resp)
+	planIsReadReplicaSource(ctx, req, resp)
+	warnDeprecatedFields(ctx, req, resp)
+	planEndpoints(ctx, req, resp)
+	if c.CpCl == nil || resp.Diagnostics.HasError() {
+		return
+	}
+	c.validatePlanZones(ctx, req, resp)
+	c.validatePlanReadReplicas(ctx, req, resp)
+	if c.preventDuplicateNames {
+		utils.PlanUniqueName(ctx, req, resp, "redpanda_cluster", c.CpCl.ClusterIDsForName)
+	}
+	c.planReferences(ctx, req, resp)
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+	resolvers := c.referenceResolvers()
+	var requiresReplace path.Paths
+	for _, p := range resp.RequiresReplace {
+		if resolve, ok := resolvers[p.String()]; ok {
+			var plan, state types.String
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &plan)...)
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			same, err := sameReference(ctx, plan, state, resolve)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(p, fmt.Sprintf("unable to resolve %s", p), err.Error())
+				return
+			}
+			if same {
+				continue
+			}
+		}
+		requiresReplace = append(requiresReplace, p)
+	}
+	resp.RequiresReplace = requiresReplace
+
+	var planTier, stateTier types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("throughput_tier"), &planTier)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("throughput_tier"), &stateTier)...)
+	if resp.Diagnostics.HasError() || planTier.IsUnknown() || planTier.Equal(stateTier) {
+		return
+	}
+	change, err := throughputTierChange(ctx, c.CpCl.ThroughputTier, stateTier.ValueString(), planTier.ValueString())
+	if err != nil {
+		// the estimate is informative only, it must not block the plan
+		tflog.Warn(ctx, "unable to estimate the throughput tier change", map[string]any{"error": err.Error()})
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("throughput_tier"), "throughput tier change", change)
+}
+
+// warnDeprecatedFields warns about the attributes set to API fields that are
+// deprecated.
+func warnDeprecatedFields(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan models.ClusterResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clusterReq, err := generateClusterRequest(plan.Cluster)
+	if err != nil {
+		// the check is best effort, the request cannot be built from every
+		// plan, e.g. one with unknown values
+		return
+	}
+	utils.WarnDeprecatedFields(&resp.Diagnostics, clusterReq)
+}
+
+// planEndpoints leaves the endpoints of an existing cluster unknown when its
+// mTLS configuration changes, as they report which APIs require mTLS.
+// Otherwise they are kept from the state.
+func planEndpoints(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	for _, attr := range []string{"kafka_api", "http_proxy", "schema_registry"} {
+		var plan, state types.Object
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attr), &plan)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attr), &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Equal(state) {
+			continue
+		}
+		t, diags := req.Plan.Schema.TypeAtPath(ctx, path.Root("endpoints"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("endpoints"), types.ObjectUnknown(t.(types.ObjectType).AttrTypes))...)
+		return
+	}
+}
+
+// planTagsAll plans tags_all, the tags of the cluster merged with the
+// default tags of the provider.
+func (c *Cluster) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || tags.IsUnknown() {
+		return
+	}
+	var cloudProvider types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("cloud_provider"), &cloudProvider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	own := utils.TypeMapToStringMap(tags)
+	// the tags of the cluster are checked by their validator, the default
+	// tags they don't override are checked here
+	defaults := maps.Clone(c.defaultTags)
+	maps.DeleteFunc(defaults, func(k, _ string) bool { _, ok := own[k]; return ok })
+	for _, e := range validators.TagErrors(cloudProvider.ValueString(), defaults) {
+		resp.Diagnostics.AddAttributeError(path.Root("tags_all"), "Invalid Default Tag", e)
+	}
+	tagsAll := tagsAllValue(mergeTags(c.defaultTags, own))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// validatePlanZones checks the planned zones against the zones of the region
+// so that invalid zones fail the plan rather than the cluster creation.
+func (c *Cluster) validatePlanZones(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan models.ClusterResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Zones.IsNull() || plan.Zones.IsUnknown() || plan.Region.IsNull() || plan.Region.IsUnknown() ||
+		plan.CloudProvider.IsNull() || plan.CloudProvider.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateZones types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zones"), &stateZones)...)
+		if resp.Diagnostics.HasError() || plan.Zones.Equal(stateZones) {
+			return
+		}
+	}
+	zones := utils.TypeListToStringSlice(plan.Zones)
+	invalid, err := checkZones(ctx, c.CpCl.Region, plan.CloudProvider.ValueString(), plan.Region.ValueString(), zones)
+	if err != nil {
+		// the check is best effort, the API validates the zones again on creation
+		tflog.Warn(ctx, "unable to validate the cluster zones", map[string]any{"error": err.Error()})
+		return
+	}
+	if invalid != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("zones"), "invalid zones", invalid)
 	}
 }
 
 // Create creates a new Cluster resource. It updates the state if the resource
 // is successfully created.
 func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var model models.Cluster
+	ctx, notices := cloud.WithDeprecationNotices(ctx)
+	defer utils.WarnDeprecationNotices(&resp.Diagnostics, notices)
+	var model models.ClusterResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	createTimeout, diags := model.Timeouts.Create(ctx, c.timeouts.Scale(90*time.Minute))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	clusterReq, err := generateClusterRequest(model)
+	clusterReq, err := generateClusterRequest(model.Cluster)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse CreateCluster request", err.Error())
 		return
 	}
+	clusterReq.ResourceGroupId, err = c.resolveResourceGroupID(ctx, clusterReq.ResourceGroupId)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to resolve resource_group_id", err.Error())
+		return
+	}
+	clusterReq.NetworkId, err = c.resolveNetworkID(ctx, clusterReq.NetworkId)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to resolve network_id", err.Error())
+		return
+	}
 
 	clResp, err := c.CpCl.Cluster.CreateCluster(ctx, &controlplanev1beta2.CreateClusterRequest{Cluster: clusterReq})
 	if err != nil {
@@ -347,14 +936,17 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 	clusterID := op.GetResourceId()
 
 	// write initial state so that if cluster creation fails, we can still track and delete it
-	resp.Diagnostics.Append(resp.State.Set(ctx, generateMinimalModel(clusterID))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(generateMinimalModel(clusterID), clusterReq.GetResourceGroupId(), clusterReq.GetNetworkId(), model))...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, models.ResourceIdentity{ID: types.StringValue(clusterID)})...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// wait for creation to complete, running "byoc apply" if we see STATE_CREATING_AGENT
+	pollCtx, watchdog := c.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&resp.Diagnostics, watchdog)
 	ranByoc := false
-	cluster, err := utils.RetryGetCluster(ctx, 90*time.Minute, clusterID, c.CpCl, func(cluster *controlplanev1beta2.Cluster) *utils.RetryError {
+	cluster, err := utils.RetryGetCluster(pollCtx, createTimeout, clusterID, c.CpCl, func(cluster *controlplanev1beta2.Cluster) *utils.RetryError {
 		if cluster.GetState() == controlplanev1beta2.Cluster_STATE_CREATING {
 			return utils.RetryableError(fmt.Errorf("expected cluster to be ready but was in state %v", cluster.GetState()))
 		}
@@ -380,18 +972,39 @@ func (c *Cluster) Create(ctx context.Context, req resource.CreateRequest, resp *
 		resp.Diagnostics.AddError(fmt.Sprintf("failed to create cluster with ID %q", clusterID), err.Error())
 		return
 	}
-	persist, err := generateModel(model, cluster)
+	persist, err := generateModel(model.Cluster, cluster)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to generate model for state during cluster.Create", err.Error())
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(*persist, cluster.GetResourceGroupId(), cluster.GetNetworkId(), model))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// blue/green mode: copy topics and ACLs from the source cluster so that
+	// region or tier migrations can be done in a single apply
+	if sourceID := model.CloneFromClusterID.ValueString(); sourceID != "" {
+		missing, err := c.cloneCluster(ctx, sourceID, persist.ClusterAPIURL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to clone cluster %q into cluster %q", sourceID, clusterID), err.Error())
+			return
+		}
+		if len(missing) > 0 {
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("users of cluster %q were not cloned", sourceID),
+				fmt.Sprintf("User credentials cannot be read from the source cluster. The following users must be recreated: %s", strings.Join(missing, ", ")),
+			)
+		}
+	}
 }
 
 // Read reads Cluster resource's values and updates the state.
 func (c *Cluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var model models.Cluster
+	ctx, notices := cloud.WithDeprecationNotices(ctx)
+	defer utils.WarnDeprecationNotices(&resp.Diagnostics, notices)
+	var model models.ClusterResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 
 	cluster, err := c.CpCl.ClusterForID(ctx, model.ID.ValueString())
@@ -405,30 +1018,50 @@ func (c *Cluster) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	if cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING || cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING_AGENT {
-		// null out the state, force it to be destroyed and recreated
-		resp.Diagnostics.Append(resp.State.Set(ctx, generateMinimalModel(cluster.Id))...)
-		resp.Diagnostics.AddWarning(fmt.Sprintf("cluster %s is in state %s", model.ID.ValueString(), cluster.GetState()), "")
+	if isClusterDeleting(cluster) {
+		if model.WaitForPendingDeletion.ValueBool() {
+			if err := c.waitForPendingDeletion(ctx, cluster.Id); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("failed waiting for deletion of cluster %s", cluster.Id), err.Error())
+				return
+			}
+		}
+		// the cluster is going away, remove it from state so that it is recreated on the next apply
+		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("cluster %s is in state %s", cluster.Id, cluster.GetState()),
+			"The cluster has been removed from state and will be recreated on the next apply.",
+		)
 		return
 	}
 
-	persist, err := generateModel(model, cluster)
+	persist, err := generateModel(model.Cluster, cluster)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to generate model for state during cluster.Read", err.Error())
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
+	keepUnknownEnums(&resp.Diagnostics, cluster, model.Cluster, persist)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(*persist, cluster.GetResourceGroupId(), cluster.GetNetworkId(), model))...)
+	resp.Diagnostics.Append(utils.SetIdentity(ctx, resp.Identity, models.ResourceIdentity{ID: persist.ID})...)
 }
 
-// Update all cluster updates are currently delete and recreate.
+// Update sends an update request whose mask covers the fields that differ
+// between the plan and the state, waits for the resulting operation, then
+// re-reads the cluster to refresh the state.
 func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan models.Cluster
+	ctx, notices := cloud.WithDeprecationNotices(ctx)
+	defer utils.WarnDeprecationNotices(&resp.Diagnostics, notices)
+	var plan models.ClusterResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
-	var state models.Cluster
+	var state models.ClusterResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, c.timeouts.Scale(90*time.Minute))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	updateReq := generateUpdateRequest(plan, state)
+	updateReq := generateUpdateRequest(plan.Cluster, state.Cluster)
 	if len(updateReq.UpdateMask.Paths) != 0 {
 		op, err := c.CpCl.Cluster.UpdateCluster(ctx, updateReq)
 		if err != nil {
@@ -436,7 +1069,9 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 			return
 		}
 
-		if err := utils.AreWeDoneYet(ctx, op.GetOperation(), 90*time.Minute, c.CpCl.Operation); err != nil {
+		pollCtx, watchdog := c.polling.Context(ctx, plan.Name.ValueString())
+		defer utils.WarnStalledOperations(&resp.Diagnostics, watchdog)
+		if err := utils.AreWeDoneYet(pollCtx, op.GetOperation(), updateTimeout, c.CpCl.Operation); err != nil {
 			resp.Diagnostics.AddError("failed while waiting to update cluster", err.Error())
 			return
 		}
@@ -448,18 +1083,43 @@ func (c *Cluster) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
-	persist, err := generateModel(plan, cluster)
+	persist, err := generateModel(plan.Cluster, cluster)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to generate model for state during cluster.Update", err.Error())
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
+	keepUnknownEnums(&resp.Diagnostics, cluster, plan.Cluster, persist)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(*persist, cluster.GetResourceGroupId(), cluster.GetNetworkId(), plan))...)
+}
+
+// keepUnknownEnums keeps the prior cloud_provider, cluster_type and
+// connection_type of persist when the API returns values added after this
+// provider was built, which would otherwise replace the cluster.
+func keepUnknownEnums(diags *diag.Diagnostics, cluster *controlplanev1beta2.Cluster, prior models.Cluster, persist *models.Cluster) {
+	persist.CloudProvider = utils.KeepUnknownEnum(diags, "cloud_provider", cluster.GetCloudProvider(), prior.CloudProvider, persist.CloudProvider)
+	persist.ClusterType = utils.KeepUnknownEnum(diags, "cluster_type", cluster.GetType(), prior.ClusterType, persist.ClusterType)
+	persist.ConnectionType = utils.KeepUnknownEnum(diags, "connection_type", cluster.GetConnectionType(), prior.ConnectionType, persist.ConnectionType)
+}
+
+// resourceModel returns the state of the cluster resource, made of the
+// attributes read from the cluster, the IDs its references resolved to, and
+// the resource-only attributes and timeouts of the configuration.
+func resourceModel(cluster models.Cluster, resourceGroupID, networkID string, cfg models.ClusterResource) models.ClusterResource {
+	cfg.Cluster = cluster
+	cfg.ResolvedResourceGroupID = types.StringValue(resourceGroupID)
+	cfg.ResolvedNetworkID = types.StringValue(networkID)
+	return cfg
 }
 
 // Delete deletes the Cluster resource.
 func (c *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var model models.Cluster
+	var model models.ClusterResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	deleteTimeout, diags := model.Timeouts.Delete(ctx, c.timeouts.Scale(90*time.Minute))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	if !model.AllowDeletion.ValueBool() {
 		resp.Diagnostics.AddError("cluster deletion not allowed", "allow_deletion is set to false")
@@ -476,10 +1136,17 @@ func (c *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
+	if model.ForceDestroy.ValueBool() && cluster.GetState() == controlplanev1beta2.Cluster_STATE_READY && cluster.GetDataplaneApi().GetUrl() != "" {
+		if err := c.forceDestroy(ctx, cluster.GetDataplaneApi().GetUrl()); err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to delete the topics, users and ACLs of cluster %s", model.ID), err.Error())
+			return
+		}
+	}
+
 	// call Delete on the cluser, if it's not already in progress. calling Delete on a cluster in
 	// STATE_DELETING_AGENT seems to destroy it immediately and we don't want to do that if we haven't
 	// cleaned up yet
-	if !(cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING || cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING_AGENT) {
+	if !isClusterDeleting(cluster) {
 		_, err = c.CpCl.Cluster.DeleteCluster(ctx, &controlplanev1beta2.DeleteClusterRequest{
 			Id: clusterID,
 		})
@@ -491,7 +1158,9 @@ func (c *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 	// wait for creation to complete, running "byoc apply" if we see STATE_DELETING_AGENT
 	ranByoc := false
-	_, err = utils.RetryGetCluster(ctx, 90*time.Minute, clusterID, c.CpCl, func(cluster *controlplanev1beta2.Cluster) *utils.RetryError {
+	pollCtx, watchdog := c.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&resp.Diagnostics, watchdog)
+	_, err = utils.RetryGetCluster(pollCtx, deleteTimeout, clusterID, c.CpCl, func(cluster *controlplanev1beta2.Cluster) *utils.RetryError {
 		if cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING {
 			return utils.RetryableError(fmt.Errorf("expected cluster to be deleted but was in state %v", cluster.GetState()))
 		}
@@ -519,5 +1188,21 @@ func (c *Cluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 
 // ImportState imports and update the state of the cluster resource.
 func (*Cluster) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+// waitForPendingDeletion waits until a cluster that is already being deleted is
+// gone. BYOC clusters waiting on the agent to be torn down are not waited on,
+// since that requires running the byoc destroy step as part of Delete.
+func (c *Cluster) waitForPendingDeletion(ctx context.Context, clusterID string) error {
+	_, err := utils.RetryGetCluster(ctx, c.timeouts.Scale(90*time.Minute), clusterID, c.CpCl, func(cluster *controlplanev1beta2.Cluster) *utils.RetryError {
+		if cluster.GetState() == controlplanev1beta2.Cluster_STATE_DELETING_AGENT && cluster.Type == controlplanev1beta2.Cluster_TYPE_BYOC {
+			return nil
+		}
+		if isClusterDeleting(cluster) {
+			return utils.RetryableError(fmt.Errorf("expected cluster to be deleted but was in state %v", cluster.GetState()))
+		}
+		return utils.NonRetryableError(fmt.Errorf("unhandled state %v. please report this issue to the provider developers", cluster.GetState()))
+	})
+	return err
 }
@@ -0,0 +1,259 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/testutil"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// plannedCluster returns the plan of a minimal dedicated AWS cluster, with
+// the computed attributes unknown to the provider left null.
+func plannedCluster(mutate func(*models.Cluster)) models.Cluster {
+	m := models.Cluster{
+		Name:                  types.StringValue("orders"),
+		ConnectionType:        types.StringValue("public"),
+		CloudProvider:         types.StringValue("aws"),
+		ClusterType:           types.StringValue("dedicated"),
+		RedpandaVersion:       types.StringValue("v24.2.1"),
+		ThroughputTier:        types.StringValue("tier-1-aws-v2-arm"),
+		Region:                types.StringValue("us-east-2"),
+		Zones:                 utils.StringSliceToTypeList([]string{"use2-az1", "use2-az2", "use2-az3"}),
+		AllowDeletion:         types.BoolValue(true),
+		Tags:                  types.MapNull(types.StringType),
+		TagsAll:               types.MapNull(types.StringType),
+		ResourceGroupID:       types.StringValue("cqj0qkeeag2gl7rs2mfg"),
+		NetworkID:             types.StringValue("cqj0qm6eag2gl7rs2mg0"),
+		ClusterAPIURL:         types.StringNull(),
+		KafkaBootstrapServers: types.ListNull(types.StringType),
+		SchemaRegistryURL:     types.StringNull(),
+		HTTPProxyURL:          types.StringNull(),
+		Status:                types.StringNull(),
+		StatusReasons:         types.ListNull(types.StringType),
+		ReadReplicaClusterIDs: types.ListNull(types.StringType),
+		IsReadReplicaSource:   types.BoolValue(false),
+		ID:                    types.StringNull(),
+	}
+	if mutate != nil {
+		mutate(&m)
+	}
+	return m
+}
+
+// fakeCreateCluster returns the cluster the API creates for the request.
+func fakeCreateCluster(id string, req *controlplanev1beta2.ClusterCreate) *controlplanev1beta2.Cluster {
+	c := &controlplanev1beta2.Cluster{
+		Id:                       id,
+		State:                    controlplanev1beta2.Cluster_STATE_READY,
+		Name:                     req.GetName(),
+		ConnectionType:           req.GetConnectionType(),
+		CloudProvider:            req.GetCloudProvider(),
+		Type:                     req.GetType(),
+		RedpandaVersion:          req.GetRedpandaVersion(),
+		ThroughputTier:           req.GetThroughputTier(),
+		Region:                   req.GetRegion(),
+		Zones:                    req.GetZones(),
+		ResourceGroupId:          req.GetResourceGroupId(),
+		NetworkId:                req.GetNetworkId(),
+		CloudProviderTags:        req.GetCloudProviderTags(),
+		ReadReplicaClusterIds:    req.GetReadReplicaClusterIds(),
+		DataplaneApi:             &controlplanev1beta2.Cluster_DataplaneAPI{Url: "https://api-" + id + ".cluster.redpanda.com:443"},
+		KafkaApi:                 &controlplanev1beta2.Cluster_KafkaAPI{Mtls: req.GetKafkaApi().GetMtls()},
+		HttpProxy:                &controlplanev1beta2.Cluster_HTTPProxyStatus{Mtls: req.GetHttpProxy().GetMtls()},
+		SchemaRegistry:           &controlplanev1beta2.Cluster_SchemaRegistryStatus{Mtls: req.GetSchemaRegistry().GetMtls()},
+		MaintenanceWindowConfig:  req.GetMaintenanceWindowConfig(),
+		CustomerManagedResources: req.GetCustomerManagedResources(),
+	}
+	if pl := req.GetAwsPrivateLink(); pl != nil {
+		c.AwsPrivateLink = &controlplanev1beta2.AWSPrivateLinkStatus{
+			Enabled:           pl.GetEnabled(),
+			AllowedPrincipals: pl.GetAllowedPrincipals(),
+			ConnectConsole:    pl.GetConnectConsole(),
+		}
+	}
+	if psc := req.GetGcpPrivateServiceConnect(); psc != nil {
+		c.GcpPrivateServiceConnect = &controlplanev1beta2.GCPPrivateServiceConnectStatus{
+			Enabled:             psc.GetEnabled(),
+			GlobalAccessEnabled: psc.GetGlobalAccessEnabled(),
+			ConsumerAcceptList:  psc.GetConsumerAcceptList(),
+		}
+	}
+	if pl := req.GetAzurePrivateLink(); pl != nil {
+		c.AzurePrivateLink = &controlplanev1beta2.AzurePrivateLinkStatus{
+			Enabled:              pl.GetEnabled(),
+			AllowedSubscriptions: pl.GetAllowedSubscriptions(),
+			ConnectConsole:       pl.GetConnectConsole(),
+		}
+	}
+	return c
+}
+
+// TestClusterRoundTrip checks that every attribute of a planned cluster
+// survives the creation request and reads back without drift. The generated
+// requests are compared with the golden files in testdata.
+func TestClusterRoundTrip(t *testing.T) {
+	mtls := func() *models.Mtls {
+		return &models.Mtls{
+			Enabled:               types.BoolValue(true),
+			CaCertificatesPem:     utils.StringSliceToTypeList([]string{"-----BEGIN CERTIFICATE-----"}),
+			PrincipalMappingRules: utils.StringSliceToTypeList([]string{"RULE:.*CN=([^,]+).*/$1/", "DEFAULT"}),
+		}
+	}
+	tests := []struct {
+		name    string
+		planned models.Cluster
+	}{
+		{
+			name:    "aws_public",
+			planned: plannedCluster(nil),
+		},
+		{
+			name: "aws_private_link_mtls",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.ConnectionType = types.StringValue("private")
+				m.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")})
+				m.TagsAll = m.Tags
+				m.AwsPrivateLink = &models.AwsPrivateLink{
+					Enabled:           types.BoolValue(true),
+					ConnectConsole:    types.BoolValue(true),
+					AllowedPrincipals: utils.StringSliceToTypeList([]string{"arn:aws:iam::123456789012:root"}),
+					ListenerPorts:     types.ObjectNull(awsPrivateLinkListenerPortsType),
+				}
+				m.KafkaAPI = &models.KafkaAPI{Mtls: mtls()}
+				m.HTTPProxy = &models.HTTPProxy{Mtls: mtls()}
+				m.SchemaRegistry = &models.SchemaRegistry{Mtls: mtls()}
+			}),
+		},
+		{
+			name: "gcp_private_service_connect",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.CloudProvider = types.StringValue("gcp")
+				m.Region = types.StringValue("us-central1")
+				m.Zones = utils.StringSliceToTypeList([]string{"us-central1-a"})
+				m.GcpPrivateServiceConnect = &models.GcpPrivateServiceConnect{
+					Enabled:             types.BoolValue(true),
+					GlobalAccessEnabled: types.BoolValue(true),
+					ConsumerAcceptList:  []*models.GcpPrivateServiceConnectConsumer{{Source: "my-project"}},
+					ConsumerStatus:      types.ListNull(gcpConsumerStatusType),
+				}
+			}),
+		},
+		{
+			name: "azure_private_link",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.CloudProvider = types.StringValue("azure")
+				m.Region = types.StringValue("eastus")
+				m.Zones = utils.StringSliceToTypeList([]string{"eastus-az1"})
+				m.AzurePrivateLink = &models.AzurePrivateLink{
+					Enabled:              types.BoolValue(true),
+					ConnectConsole:       types.BoolValue(false),
+					AllowedSubscriptions: utils.StringSliceToTypeList([]string{"12345678-1234-1234-1234-123456789012"}),
+				}
+			}),
+		},
+		{
+			name: "default_tags",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.Tags = types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")})
+				m.TagsAll = tagsAllValue(mergeTags(map[string]string{"team": "platform", "cost-center": "1234"}, map[string]string{"team": "payments"}))
+			}),
+		},
+		{
+			name: "maintenance_window",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.MaintenanceWindowConfig = &models.MaintenanceWindowConfig{
+					DayHour: &models.MaintenanceWindowDayHour{
+						HourOfDay: types.Int64Value(3),
+						DayOfWeek: types.StringValue("SUNDAY"),
+					},
+					Anytime:     types.BoolNull(),
+					Unspecified: types.BoolNull(),
+				}
+			}),
+		},
+		{
+			name: "aws_byovpc",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.ClusterType = types.StringValue("byoc")
+				m.ConnectionType = types.StringValue("private")
+				m.CustomerManagedResources = &models.ClusterCustomerManagedResources{AWS: &models.ClusterCustomerManagedAWS{
+					AgentInstanceProfileArn:               types.StringValue("arn:aws:iam::123456789012:instance-profile/redpanda-agent"),
+					ConnectorsNodeGroupInstanceProfileArn: types.StringValue("arn:aws:iam::123456789012:instance-profile/redpanda-connectors"),
+					UtilityNodeGroupInstanceProfileArn:    types.StringValue("arn:aws:iam::123456789012:instance-profile/redpanda-utility"),
+					RedpandaNodeGroupInstanceProfileArn:   types.StringValue("arn:aws:iam::123456789012:instance-profile/redpanda-rp"),
+					K8sClusterRoleArn:                     types.StringValue("arn:aws:iam::123456789012:role/redpanda-cluster"),
+					ConsoleSecretsManagerRoleArn:          types.StringNull(),
+					ConnectorsSecretsManagerRoleArn:       types.StringNull(),
+					RedpandaCloudStorageManagerRoleArn:    types.StringValue("arn:aws:iam::123456789012:role/redpanda-cloud-storage-manager"),
+					RedpandaAgentSecurityGroupArn:         types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-01"),
+					ConnectorsSecurityGroupArn:            types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-02"),
+					RedpandaNodeGroupSecurityGroupArn:     types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-03"),
+					UtilitySecurityGroupArn:               types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-04"),
+					ClusterSecurityGroupArn:               types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-05"),
+					NodeSecurityGroupArn:                  types.StringValue("arn:aws:ec2:us-east-2:123456789012:security-group/sg-06"),
+					CloudStorageBucketArn:                 types.StringValue("arn:aws:s3:::redpanda-cloud-storage"),
+				}}
+			}),
+		},
+		{
+			name: "gcp_byovpc",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.ClusterType = types.StringValue("byoc")
+				m.CloudProvider = types.StringValue("gcp")
+				m.Region = types.StringValue("us-central1")
+				m.Zones = utils.StringSliceToTypeList([]string{"us-central1-a"})
+				m.CustomerManagedResources = &models.ClusterCustomerManagedResources{GCP: &models.ClusterCustomerManagedGCP{
+					Subnet: &models.ClusterCustomerManagedGCPSubnet{
+						Name:                           types.StringValue("redpanda-subnet"),
+						SecondaryIPv4RangePodsName:     types.StringValue("redpanda-pods"),
+						SecondaryIPv4RangeServicesName: types.StringValue("redpanda-services"),
+						K8sMasterIPv4Range:             types.StringValue("10.0.7.240/28"),
+					},
+					AgentServiceAccountEmail:           types.StringValue("redpanda-agent@acme.iam.gserviceaccount.com"),
+					ConsoleServiceAccountEmail:         types.StringValue("redpanda-console@acme.iam.gserviceaccount.com"),
+					ConnectorServiceAccountEmail:       types.StringValue("redpanda-connectors@acme.iam.gserviceaccount.com"),
+					RedpandaClusterServiceAccountEmail: types.StringValue("redpanda-cluster@acme.iam.gserviceaccount.com"),
+					GkeServiceAccountEmail:             types.StringValue("redpanda-gke@acme.iam.gserviceaccount.com"),
+					TieredStorageBucketName:            types.StringValue("redpanda-tiered-storage"),
+					PscNatSubnetName:                   types.StringNull(),
+				}}
+			}),
+		},
+		{
+			name: "read_replicas",
+			planned: plannedCluster(func(m *models.Cluster) {
+				m.ReadReplicaClusterIDs = utils.StringSliceToTypeList([]string{"cqj0r0meag2gl7rs2mh0"})
+				m.IsReadReplicaSource = types.BoolValue(true)
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			req, err := generateClusterRequest(tt.planned)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.Golden(t, tt.name+".golden.json", req)
+
+			read, err := generateModel(tt.planned, fakeCreateCluster("cqj0r0meag2gl7rs2mg0", req))
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.RoundTrip(ctx, t, resourceClusterSchema(),
+				models.ClusterResource{Cluster: tt.planned, Timeouts: testutil.NullTimeouts("create", "update", "delete")},
+				models.ClusterResource{Cluster: *read, Timeouts: testutil.NullTimeouts("create", "update", "delete")})
+
+			// the next plan after the apply must not update the cluster
+			tt.planned.ID = read.ID
+			if mask := generateUpdateRequest(tt.planned, *read).GetUpdateMask().GetPaths(); len(mask) > 0 {
+				t.Errorf("unexpected update after creation: %v", mask)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// mergeTags returns the default tags of the provider overridden by the tags
+// of the cluster.
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	merged := maps.Clone(defaults)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// tagsAllValue returns the value of tags_all, null when there is no tag so
+// that clusters without tags don't show a change.
+func tagsAllValue(tags map[string]string) types.Map {
+	if len(tags) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	values := make(map[string]attr.Value, len(tags))
+	for k, v := range tags {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// clusterTags returns the tags placed on the cloud resources of the cluster:
+// the planned tags_all, or the tags of the cluster if tags_all isn't known.
+func clusterTags(model models.Cluster) map[string]string {
+	if model.TagsAll.IsNull() || model.TagsAll.IsUnknown() {
+		return utils.TypeMapToStringMap(model.Tags)
+	}
+	return utils.TypeMapToStringMap(model.TagsAll)
+}
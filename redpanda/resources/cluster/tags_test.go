@@ -0,0 +1,31 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags(t *testing.T) {
+	assert.Equal(t, map[string]string{}, mergeTags(nil, nil))
+	assert.Equal(t, map[string]string{"owner": "data", "team": "payments"}, mergeTags(
+		map[string]string{"owner": "data", "team": "platform"},
+		map[string]string{"team": "payments"},
+	))
+	assert.Equal(t, types.MapNull(types.StringType), tagsAllValue(mergeTags(nil, nil)))
+}
+
+func TestDefaultTagsUpdate(t *testing.T) {
+	state := plannedCluster(func(m *models.Cluster) {
+		m.ID = types.StringValue("cl-1")
+		m.TagsAll = tagsAllValue(map[string]string{"owner": "data"})
+	})
+	plan := state
+	plan.TagsAll = tagsAllValue(map[string]string{"owner": "data", "cost-center": "1234"})
+
+	req := generateUpdateRequest(plan, state)
+	assert.Equal(t, []string{"cloud_provider_tags"}, req.GetUpdateMask().GetPaths())
+	assert.Equal(t, map[string]string{"owner": "data", "cost-center": "1234"}, req.GetCluster().GetCloudProviderTags())
+}
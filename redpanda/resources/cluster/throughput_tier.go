@@ -0,0 +1,55 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+)
+
+// throughputTierChange describes, for the plan output, how the limits of the
+// cluster change when moving from one throughput tier to another.
+func throughputTierChange(ctx context.Context, client controlplanev1beta2grpc.ThroughputTierServiceClient, from, to string) (string, error) {
+	oldTier, err := client.GetThroughputTier(ctx, &controlplanev1beta2.GetThroughputTierRequest{Name: from})
+	if err != nil {
+		return "", fmt.Errorf("unable to get throughput tier %q: %v", from, err)
+	}
+	newTier, err := client.GetThroughputTier(ctx, &controlplanev1beta2.GetThroughputTierRequest{Name: to})
+	if err != nil {
+		return "", fmt.Errorf("unable to get throughput tier %q: %v", to, err)
+	}
+	o, n := oldTier.GetThroughputTier(), newTier.GetThroughputTier()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Changing throughput_tier from %q to %q replaces the cluster. The tier limits change as follows:\n", from, to)
+	fmt.Fprintf(&b, "  max ingress: %s -> %s\n", formatBytesPerSecond(o.GetMaxIngressBytesPerSecond()), formatBytesPerSecond(n.GetMaxIngressBytesPerSecond()))
+	fmt.Fprintf(&b, "  max egress: %s -> %s\n", formatBytesPerSecond(o.GetMaxEgressBytesPerSecond()), formatBytesPerSecond(n.GetMaxEgressBytesPerSecond()))
+	fmt.Fprintf(&b, "  max partitions: %d -> %d\n", o.GetMaxPartitionCount(), n.GetMaxPartitionCount())
+	fmt.Fprintf(&b, "  max connections: %d -> %d", o.GetMaxConnectionsCount(), n.GetMaxConnectionsCount())
+	return b.String(), nil
+}
+
+func formatBytesPerSecond(v int64) string {
+	const mib = 1 << 20
+	if v%mib == 0 {
+		return fmt.Sprintf("%d MiB/s", v/mib)
+	}
+	return fmt.Sprintf("%d B/s", v)
+}
@@ -0,0 +1,55 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThroughputTierChange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockThroughputTierServiceClient(ctrl)
+	client.EXPECT().GetThroughputTier(gomock.Any(), &controlplanev1beta2.GetThroughputTierRequest{Name: "tier-1-aws"}).Return(&controlplanev1beta2.GetThroughputTierResponse{
+		ThroughputTier: &controlplanev1beta2.ThroughputTier{
+			Name:                     "tier-1-aws",
+			MaxIngressBytesPerSecond: 20 << 20,
+			MaxEgressBytesPerSecond:  60 << 20,
+			MaxPartitionCount:        1000,
+			MaxConnectionsCount:      1500,
+		},
+	}, nil)
+	client.EXPECT().GetThroughputTier(gomock.Any(), &controlplanev1beta2.GetThroughputTierRequest{Name: "tier-2-aws"}).Return(&controlplanev1beta2.GetThroughputTierResponse{
+		ThroughputTier: &controlplanev1beta2.ThroughputTier{
+			Name:                     "tier-2-aws",
+			MaxIngressBytesPerSecond: 50 << 20,
+			MaxEgressBytesPerSecond:  150 << 20,
+			MaxPartitionCount:        2000,
+			MaxConnectionsCount:      3750,
+		},
+	}, nil)
+
+	got, err := throughputTierChange(context.Background(), client, "tier-1-aws", "tier-2-aws")
+	require.NoError(t, err)
+	assert.Equal(t, `Changing throughput_tier from "tier-1-aws" to "tier-2-aws" replaces the cluster. The tier limits change as follows:
+  max ingress: 20 MiB/s -> 50 MiB/s
+  max egress: 60 MiB/s -> 150 MiB/s
+  max partitions: 1000 -> 2000
+  max connections: 1500 -> 3750`, got)
+}
+
+func TestThroughputTierChangeUnknownTier(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockThroughputTierServiceClient(ctrl)
+	client.EXPECT().GetThroughputTier(gomock.Any(), gomock.Any()).Return(nil, errors.New("not found"))
+
+	_, err := throughputTierChange(context.Background(), client, "tier-1-aws", "tier-9-aws")
+	assert.ErrorContains(t, err, "tier-1-aws")
+}
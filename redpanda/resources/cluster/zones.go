@@ -0,0 +1,87 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// awsZoneNameRegex matches AWS availability zone names such as us-east-1a, as
+// opposed to the zone IDs such as use1-az1 expected by the API.
+var awsZoneNameRegex = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-\d+[a-z]$`)
+
+// checkZones verifies, before the cluster is created, that zones are valid
+// zones of the region, and returns a description of the invalid ones. AWS zone
+// names are reported with a dedicated message since AWS maps them to a
+// different zone ID in every account, so they cannot be translated.
+func checkZones(ctx context.Context, client controlplanev1beta2grpc.RegionServiceClient, cloudProvider, region string, zones []string) (string, error) {
+	provider, err := utils.StringToCloudProvider(cloudProvider)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.GetRegion(ctx, &controlplanev1beta2.GetRegionRequest{
+		Name:          region,
+		CloudProvider: provider,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to get region %q: %v", region, err)
+	}
+	valid := resp.GetRegion().GetZones()
+	if len(valid) == 0 {
+		// nothing to compare against
+		return "", nil
+	}
+
+	var names, unknown []string
+	for _, z := range zones {
+		switch {
+		case slices.Contains(valid, z):
+		case provider == controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS && awsZoneNameRegex.MatchString(z):
+			names = append(names, z)
+		default:
+			unknown = append(unknown, z)
+		}
+	}
+	var msgs []string
+	if len(names) > 0 {
+		msgs = append(msgs, fmt.Sprintf("%s: AWS zones must be given as zone IDs rather than zone names. "+
+			"The same zone name maps to different zone IDs in each AWS account; for BYOC clusters run "+
+			"`aws ec2 describe-availability-zones --region %s` to find the ID of each zone", quoteAll(names), region))
+	}
+	if len(unknown) > 0 {
+		msgs = append(msgs, fmt.Sprintf("%s: not a zone of region %q", quoteAll(unknown), region))
+	}
+	if len(msgs) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%s. Zones of region %q are: %s", strings.Join(msgs, ". "), region, strings.Join(valid, ", ")), nil
+}
+
+func quoteAll(s []string) string {
+	q := make([]string, len(s))
+	for i, v := range s {
+		q[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(q, ", ")
+}
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckZones(t *testing.T) {
+	awsRegion := &controlplanev1beta2.Region{Name: "us-east-1", Zones: []string{"use1-az1", "use1-az2", "use1-az4"}}
+	gcpRegion := &controlplanev1beta2.Region{Name: "us-central1", Zones: []string{"us-central1-a", "us-central1-b", "us-central1-c"}}
+	tests := []struct {
+		name     string
+		provider string
+		region   *controlplanev1beta2.Region
+		zones    []string
+		want     []string
+	}{
+		{
+			name:     "valid aws zone ids",
+			provider: "aws",
+			region:   awsRegion,
+			zones:    []string{"use1-az1", "use1-az2"},
+		},
+		{
+			name:     "aws zone names",
+			provider: "aws",
+			region:   awsRegion,
+			zones:    []string{"use1-az1", "us-east-1a", "us-east-1b"},
+			want:     []string{`"us-east-1a", "us-east-1b": AWS zones must be given as zone IDs`, `Zones of region "us-east-1" are: use1-az1, use1-az2, use1-az4`},
+		},
+		{
+			name:     "unknown aws zone id",
+			provider: "aws",
+			region:   awsRegion,
+			zones:    []string{"use1-az3"},
+			want:     []string{`"use1-az3": not a zone of region "us-east-1"`},
+		},
+		{
+			name:     "valid gcp zones",
+			provider: "gcp",
+			region:   gcpRegion,
+			zones:    []string{"us-central1-a"},
+		},
+		{
+			name:     "gcp zone of another region",
+			provider: "gcp",
+			region:   gcpRegion,
+			zones:    []string{"us-east1-b"},
+			want:     []string{`"us-east1-b": not a zone of region "us-central1"`},
+		},
+		{
+			name:     "region without zones",
+			provider: "aws",
+			region:   &controlplanev1beta2.Region{Name: "us-east-1"},
+			zones:    []string{"us-east-1a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockRegionServiceClient(ctrl)
+			client.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.GetRegionResponse{Region: tt.region}, nil)
+
+			got, err := checkZones(context.Background(), client, tt.provider, tt.region.Name, tt.zones)
+			require.NoError(t, err)
+			if len(tt.want) == 0 {
+				assert.Empty(t, got)
+			}
+			for _, w := range tt.want {
+				assert.Contains(t, got, w)
+			}
+		})
+	}
+}
+
+func TestCheckZonesRegionError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRegionServiceClient(ctrl)
+	client.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	_, err := checkZones(context.Background(), client, "aws", "us-east-1", []string{"use1-az1"})
+	assert.ErrorContains(t, err, "us-east-1")
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package clusterconfig contains the implementation of the ClusterConfiguration
+// and PartitionRebalance resources, managed through the Admin API of a
+// cluster, following the Terraform framework interfaces.
+package clusterconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// Client is a minimal client of the cluster configuration and partition
+// balancer endpoints of the Redpanda Admin API.
+type Client struct {
+	url      string
+	http     *http.Client
+	username string
+	password string
+	token    string
+}
+
+// NewClient returns a Client for the Admin API at rawURL. Requests use basic
+// authentication when username is set, and the token otherwise.
+func NewClient(rawURL string, httpClient *http.Client, username, password, token string) *Client {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	return &Client{
+		url:      strings.TrimSuffix(rawURL, "/"),
+		http:     httpClient,
+		username: username,
+		password: password,
+		token:    token,
+	}
+}
+
+// Error is an error returned by the Admin API.
+type Error struct {
+	StatusCode int    `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("admin API returned HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("admin API returned HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+// Properties returns the current value of every cluster property, including
+// the ones left to their default.
+func (c *Client) Properties(ctx context.Context) (map[string]json.RawMessage, error) {
+	var out map[string]json.RawMessage
+	if err := c.do(ctx, http.MethodGet, "/v1/cluster_config?include_defaults=true", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type patchRequest struct {
+	Upsert map[string]json.RawMessage `json:"upsert"`
+	Remove []string                   `json:"remove"`
+}
+
+// Patch sets the upserted properties and resets the removed ones to their
+// default, in a single new version of the cluster configuration.
+func (c *Client) Patch(ctx context.Context, upsert map[string]json.RawMessage, remove []string) error {
+	if upsert == nil {
+		upsert = map[string]json.RawMessage{}
+	}
+	if remove == nil {
+		remove = []string{}
+	}
+	return c.do(ctx, http.MethodPut, "/v1/cluster_config", patchRequest{Upsert: upsert, Remove: remove}, nil)
+}
+
+// BalancerStatus is the status of the partition balancer of a cluster.
+type BalancerStatus struct {
+	// Status is one of off, starting, ready, in_progress or stalled.
+	Status               string  `json:"status"`
+	SecondsSinceLastTick float64 `json:"seconds_since_last_tick"`
+	// CurrentReassignments is the number of partitions being moved.
+	CurrentReassignments int `json:"current_reassignments_count"`
+}
+
+// Rebalance triggers an on-demand run of the partition balancer, which moves
+// partitions to even out their distribution across the brokers.
+func (c *Client) Rebalance(ctx context.Context) error {
+	return c.do(ctx, http.MethodPost, "/v1/partitions/rebalance", nil, nil)
+}
+
+// PartitionBalancerStatus returns the status of the partition balancer.
+func (c *Client) PartitionBalancerStatus(ctx context.Context) (*BalancerStatus, error) {
+	var out BalancerStatus
+	if err := c.do(ctx, http.MethodGet, "/v1/cluster/partition_balancer/status", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	switch {
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach the admin API: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read the admin API response: %v", err)
+	}
+	if resp.StatusCode >= 300 {
+		e := &Error{}
+		_ = json.Unmarshal(b, e)
+		e.StatusCode = resp.StatusCode
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("unable to decode the admin API response: %v", err)
+	}
+	return nil
+}
+
+// encodeValue returns the JSON value sent for a property configured as s.
+// Values that are valid JSON, such as true, 3600 or ["a"], are sent as is,
+// and any other value is sent as a JSON string.
+func encodeValue(s string) json.RawMessage {
+	if json.Valid([]byte(s)) {
+		return json.RawMessage(s)
+	}
+	b, _ := json.Marshal(s)
+	return b
+}
+
+// decodeValue returns the value of a property as configured: strings
+// unquoted and other values as compact JSON.
+func decodeValue(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, v); err != nil {
+		return string(v)
+	}
+	return buf.String()
+}
+
+// sameValue reports whether the configured value s encodes to the JSON value
+// v, e.g. 1.0 and 1.
+func sameValue(s string, v json.RawMessage) bool {
+	var a, b any
+	if json.Unmarshal(encodeValue(s), &a) != nil || json.Unmarshal(v, &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
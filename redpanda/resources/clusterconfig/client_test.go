@@ -0,0 +1,93 @@
+package clusterconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientPatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/v1/cluster_config", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"upsert": map[string]any{"auto_create_topics_enabled": true, "log_retention_ms": float64(3600000)},
+			"remove": []any{"log_segment_size"},
+		}, body)
+		_, _ = w.Write([]byte(`{"config_version": 4}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "", "", "token")
+	err := c.Patch(context.Background(), map[string]json.RawMessage{
+		"auto_create_topics_enabled": encodeValue("true"),
+		"log_retention_ms":           encodeValue("3600000"),
+	}, []string{"log_segment_size"})
+	require.NoError(t, err)
+}
+
+func TestClientErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", user)
+		assert.Equal(t, "secret", pass)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message": "Invalid property value", "code": 400}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "admin", "secret", "token")
+	err := c.Patch(context.Background(), map[string]json.RawMessage{"log_retention_ms": encodeValue("forever")}, nil)
+	assert.EqualError(t, err, "admin API returned HTTP 400: Invalid property value")
+}
+
+func TestValues(t *testing.T) {
+	tests := []struct {
+		value   string
+		encoded string
+	}{
+		{value: "true", encoded: `true`},
+		{value: "3600000", encoded: `3600000`},
+		{value: `["a","b"]`, encoded: `["a","b"]`},
+		{value: "lz4", encoded: `"lz4"`},
+		{value: "", encoded: `""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.encoded, string(encodeValue(tt.value)))
+			assert.Equal(t, tt.value, decodeValue(json.RawMessage(tt.encoded)))
+		})
+	}
+	assert.True(t, sameValue("1.0", json.RawMessage(`1`)))
+	assert.False(t, sameValue("2", json.RawMessage(`1`)))
+}
+
+func TestClientRebalance(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/partitions/rebalance":
+			assert.Equal(t, http.MethodPost, r.Method)
+		case "/v1/cluster/partition_balancer/status":
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = w.Write([]byte(`{"status": "in_progress", "seconds_since_last_tick": 2, "current_reassignments_count": 12}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "", "", "token")
+	require.NoError(t, c.Rebalance(context.Background()))
+	status, err := c.PartitionBalancerStatus(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &BalancerStatus{Status: "in_progress", SecondsSinceLastTick: 2, CurrentReassignments: 12}, status)
+}
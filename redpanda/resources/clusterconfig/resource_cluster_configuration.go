@@ -0,0 +1,238 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clusterconfig
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ClusterConfiguration{}
+	_ resource.ResourceWithConfigure   = &ClusterConfiguration{}
+	_ resource.ResourceWithImportState = &ClusterConfiguration{}
+)
+
+// ClusterConfiguration represents the cluster configuration Terraform
+// resource, a set of cluster-wide properties of a cluster.
+type ClusterConfiguration struct {
+	// Client is used instead of the Admin API of admin_api_url when set.
+	Client *Client
+
+	resData config.Resource
+}
+
+// Metadata returns the metadata for the ClusterConfiguration resource.
+func (*ClusterConfiguration) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_cluster_configuration"
+}
+
+// Configure configures the ClusterConfiguration resource.
+func (c *ClusterConfiguration) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	c.resData = p
+}
+
+// Schema returns the schema for the ClusterConfiguration resource.
+func (*ClusterConfiguration) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceClusterConfigurationSchema()
+}
+
+func resourceClusterConfigurationSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages cluster-wide properties of a cluster, such as auto_create_topics_enabled, through its Admin API. Only the declared properties are tracked: properties changed outside of Terraform are reported as drift only if they are declared.",
+		Attributes: map[string]schema.Attribute{
+			"admin_api_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL of the Admin API of the cluster.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"properties": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Cluster properties to set. Values that are valid JSON, such as true, 3600 or [\"a\"], are sent as is, any other value is sent as a string. Removing a property resets it to its default.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the Admin API. When unset, the provider credentials are used.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the Admin API.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the cluster configuration, equal to the Admin API URL",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create sets the declared properties.
+func (c *ClusterConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.ClusterConfiguration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c.apply(ctx, model, nil, &resp.State, &resp.Diagnostics)
+}
+
+// Read reads the declared properties. Properties the cluster no longer
+// reports are removed from state.
+func (c *ClusterConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.ClusterConfiguration
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	declared := map[string]string{}
+	resp.Diagnostics.Append(model.Properties.ElementsAs(ctx, &declared, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	current, err := c.client(model).Properties(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read the cluster configuration", err.Error())
+		return
+	}
+	props, diags := types.MapValueFrom(ctx, types.StringType, readProperties(declared, current))
+	resp.Diagnostics.Append(diags...)
+	model.Properties = props
+	model.ID = model.AdminAPIURL
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// readProperties returns the current value of the declared properties. The
+// declared value is kept when it encodes to the current value, so that 1.0
+// doesn't drift from 1.
+func readProperties(declared map[string]string, current map[string]json.RawMessage) map[string]string {
+	props := map[string]string{}
+	for k, v := range declared {
+		cur, ok := current[k]
+		if !ok {
+			continue
+		}
+		if sameValue(v, cur) {
+			props[k] = v
+		} else {
+			props[k] = decodeValue(cur)
+		}
+	}
+	return props
+}
+
+// Update sets the declared properties and resets the ones that are no
+// longer declared.
+func (c *ClusterConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.ClusterConfiguration
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	previous := map[string]string{}
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &previous, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c.apply(ctx, plan, previous, &resp.State, &resp.Diagnostics)
+}
+
+// Delete resets the declared properties to their default.
+func (c *ClusterConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model models.ClusterConfiguration
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	declared := map[string]string{}
+	resp.Diagnostics.Append(model.Properties.ElementsAs(ctx, &declared, false)...)
+	if resp.Diagnostics.HasError() || len(declared) == 0 {
+		return
+	}
+	var remove []string
+	for k := range declared {
+		remove = append(remove, k)
+	}
+	slices.Sort(remove)
+	if err := c.client(model).Patch(ctx, nil, remove); err != nil {
+		resp.Diagnostics.AddError("failed to reset the cluster configuration", err.Error())
+	}
+}
+
+// ImportState imports the state of the ClusterConfiguration resource. The ID
+// is the URL of the Admin API. No property is declared after the import: the
+// next plan sets the properties of the configuration.
+func (*ClusterConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("admin_api_url"), types.StringValue(req.ID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("properties"), types.MapValueMust(types.StringType, nil))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+}
+
+// apply sets the properties of model and resets the previous properties that
+// are no longer declared.
+func (c *ClusterConfiguration) apply(ctx context.Context, model models.ClusterConfiguration, previous map[string]string, state *tfsdk.State, diags *diag.Diagnostics) {
+	declared := map[string]string{}
+	diags.Append(model.Properties.ElementsAs(ctx, &declared, false)...)
+	if diags.HasError() {
+		return
+	}
+	upsert := map[string]json.RawMessage{}
+	for k, v := range declared {
+		upsert[k] = encodeValue(v)
+	}
+	var remove []string
+	for k := range previous {
+		if _, ok := declared[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	slices.Sort(remove)
+	if err := c.client(model).Patch(ctx, upsert, remove); err != nil {
+		diags.AddError("failed to update the cluster configuration", err.Error())
+		return
+	}
+	model.ID = model.AdminAPIURL
+	diags.Append(state.Set(ctx, model)...)
+}
+
+// client returns a client of the Admin API of the cluster, authenticated
+// with the given credentials or with the provider token.
+func (c *ClusterConfiguration) client(model models.ClusterConfiguration) *Client {
+	if c.Client != nil {
+		return c.Client
+	}
+	return NewClient(model.AdminAPIURL.ValueString(), c.resData.Proxy.HTTPClient(), model.Username.ValueString(), model.Password.ValueString(), c.resData.AuthToken)
+}
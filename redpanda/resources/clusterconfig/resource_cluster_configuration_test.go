@@ -0,0 +1,28 @@
+package clusterconfig
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadProperties(t *testing.T) {
+	declared := map[string]string{
+		"auto_create_topics_enabled": "false",
+		"log_retention_ms":           "604800000.0",
+		"log_compression_type":       "lz4",
+		"removed_property":           "1",
+	}
+	current := map[string]json.RawMessage{
+		"auto_create_topics_enabled": json.RawMessage(`true`),
+		"log_retention_ms":           json.RawMessage(`604800000`),
+		"log_compression_type":       json.RawMessage(`"lz4"`),
+		"log_segment_size":           json.RawMessage(`134217728`),
+	}
+	assert.Equal(t, map[string]string{
+		"auto_create_topics_enabled": "true",
+		"log_retention_ms":           "604800000.0",
+		"log_compression_type":       "lz4",
+	}, readProperties(declared, current))
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clusterconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &PartitionRebalance{}
+	_ resource.ResourceWithConfigure = &PartitionRebalance{}
+)
+
+// PartitionRebalance represents the partition rebalance Terraform resource,
+// which runs the partition balancer of a cluster when it is created, e.g.
+// after brokers were added.
+type PartitionRebalance struct {
+	// Client is used instead of the Admin API of admin_api_url when set.
+	Client *Client
+
+	resData config.Resource
+}
+
+// Metadata returns the metadata for the PartitionRebalance resource.
+func (*PartitionRebalance) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_partition_rebalance"
+}
+
+// Configure configures the PartitionRebalance resource.
+func (p *PartitionRebalance) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	p.resData = data
+}
+
+// Schema returns the schema for the PartitionRebalance resource.
+func (*PartitionRebalance) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourcePartitionRebalanceSchema()
+}
+
+func resourcePartitionRebalanceSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Runs the partition balancer of a cluster through its Admin API, so that partitions are spread over brokers added by a scale-up. The balancer runs when the resource is created, and again whenever `triggers` change. Destroying the resource does not move any partition.",
+		Attributes: map[string]schema.Attribute{
+			"admin_api_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL of the Admin API of the cluster.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the Admin API. When unset, the provider credentials are used.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the Admin API.",
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Arbitrary values that run the balancer again when they change, e.g. the throughput tier of the cluster.",
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Wait until the balancer has no partition left to move. Defaults to true.",
+				Default:             &utils.DefaultBoolValue{Value: true, Desc: "Defaults to true"},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Status of the partition balancer once the rebalance was started, or completed when waiting for it: off, starting, ready, in_progress or stalled.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the partition rebalance, equal to the Admin API URL",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+// Create runs the partition balancer and waits for it to complete, unless
+// wait_for_completion is false.
+func (p *PartitionRebalance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.PartitionRebalance
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := model.Timeouts.Create(ctx, p.resData.Timeouts.Scale(time.Hour))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client := p.client(model)
+	triggered := time.Now()
+	if err := client.Rebalance(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to start the partition rebalance", err.Error())
+		return
+	}
+	var status *BalancerStatus
+	err := utils.Retry(ctx, createTimeout, func() *utils.RetryError {
+		var err error
+		if status, err = client.PartitionBalancerStatus(ctx); err != nil {
+			return utils.NonRetryableError(err)
+		}
+		if !model.WaitForCompletion.ValueBool() {
+			return nil
+		}
+		return rebalanceDone(status, time.Since(triggered))
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to wait for the partition rebalance", err.Error())
+		return
+	}
+	model.Status = types.StringValue(status.Status)
+	model.ID = model.AdminAPIURL
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// rebalanceDone returns nil once the balancer has run since it was triggered,
+// elapsed ago, and has no partition left to move.
+func rebalanceDone(status *BalancerStatus, elapsed time.Duration) *utils.RetryError {
+	switch {
+	case status.Status == "stalled":
+		return utils.NonRetryableError(fmt.Errorf("the partition balancer is stalled with %d partitions being moved; check the health of the brokers", status.CurrentReassignments))
+	case status.CurrentReassignments > 0:
+		return utils.RetryableError(fmt.Errorf("the partition balancer is moving %d partitions", status.CurrentReassignments))
+	case status.Status == "off":
+		// the balancer doesn't tick when autobalancing is off
+		return nil
+	case status.Status != "ready":
+		return utils.RetryableError(fmt.Errorf("the partition balancer is %s", status.Status))
+	case time.Duration(status.SecondsSinceLastTick*float64(time.Second)) >= elapsed:
+		return utils.RetryableError(fmt.Errorf("the partition balancer has not run since the rebalance was started"))
+	}
+	return nil
+}
+
+// Read leaves the state unchanged: a rebalance is a one-off run with nothing
+// to refresh.
+func (*PartitionRebalance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.PartitionRebalance
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update stores the new credentials and settings, as changing them does not
+// run the balancer again.
+func (*PartitionRebalance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.PartitionRebalance
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the PartitionRebalance resource from the state only.
+func (*PartitionRebalance) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// partitions are left where the balancer moved them
+}
+
+// client returns a client of the Admin API of the cluster, authenticated
+// with the given credentials or with the provider token.
+func (p *PartitionRebalance) client(model models.PartitionRebalance) *Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return NewClient(model.AdminAPIURL.ValueString(), p.resData.Proxy.HTTPClient(), model.Username.ValueString(), model.Password.ValueString(), p.resData.AuthToken)
+}
@@ -0,0 +1,37 @@
+package clusterconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRebalanceDone(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    BalancerStatus
+		elapsed   time.Duration
+		done      bool
+		retryable bool
+	}{
+		{name: "moving partitions", status: BalancerStatus{Status: "in_progress", CurrentReassignments: 3}, elapsed: time.Minute, retryable: true},
+		{name: "starting", status: BalancerStatus{Status: "starting"}, elapsed: time.Minute, retryable: true},
+		{name: "not ticked yet", status: BalancerStatus{Status: "ready", SecondsSinceLastTick: 30}, elapsed: 10 * time.Second, retryable: true},
+		{name: "ticked and idle", status: BalancerStatus{Status: "ready", SecondsSinceLastTick: 5}, elapsed: 10 * time.Second, done: true},
+		{name: "off", status: BalancerStatus{Status: "off", SecondsSinceLastTick: 30}, elapsed: time.Second, done: true},
+		{name: "stalled", status: BalancerStatus{Status: "stalled", CurrentReassignments: 1}, elapsed: time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rebalanceDone(&tt.status, tt.elapsed)
+			if tt.done {
+				assert.Nil(t, err)
+				return
+			}
+			if assert.NotNil(t, err) {
+				assert.Equal(t, tt.retryable, err.Retryable)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package clusterexport contains the implementation of the ClusterExport data
+// source following the Terraform framework interfaces.
+package clusterexport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceClusterExport{}
+	_ datasource.DataSourceWithConfigure = &DataSourceClusterExport{}
+)
+
+// DataSourceClusterExport represents a data source rendering the topics,
+// users and ACLs of a cluster as Terraform configuration.
+type DataSourceClusterExport struct {
+	CpCl        *cloud.ControlPlaneClientSet
+	TopicClient dataplanev1alpha2grpc.TopicServiceClient
+	UserClient  dataplanev1alpha2grpc.UserServiceClient
+	ACLClient   dataplanev1alpha2grpc.ACLServiceClient
+
+	dsData config.Datasource
+}
+
+// Metadata returns the metadata for the ClusterExport data source.
+func (*DataSourceClusterExport) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_cluster_export"
+}
+
+// Configure uses provider level data to configure DataSourceClusterExport.
+func (d *DataSourceClusterExport) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	d.dsData = p
+}
+
+// Schema returns the schema for the ClusterExport data source.
+func (*DataSourceClusterExport) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = datasourceClusterExportSchema()
+}
+
+func datasourceClusterExportSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster to export",
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster API of the cluster",
+			},
+			"hcl": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Terraform configuration managing the topics, users and ACLs of the cluster, with import blocks " +
+					"adopting them. Internal topics are left out, and the password of each user is read from a variable as the " +
+					"API doesn't return it",
+			},
+			"topic_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of topics in the configuration",
+			},
+			"user_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of users in the configuration",
+			},
+			"acl_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of ACLs in the configuration",
+			},
+		},
+		MarkdownDescription: "Data source rendering the topics, users and ACLs of an existing cluster as Terraform configuration, to bring them under Terraform management in bulk",
+	}
+}
+
+// Read lists the dataplane objects of the cluster and renders them as HCL.
+func (d *DataSourceClusterExport) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var model models.ClusterExport
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, err := d.CpCl.ClusterForID(ctx, model.ID.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", model.ID), err.Error())
+		return
+	}
+	clusterURL := cluster.GetDataplaneApi().GetUrl()
+	if clusterURL == "" {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to export cluster %s", model.ID), "the cluster has no cluster API URL yet, wait until it is ready")
+		return
+	}
+
+	topicClient, userClient, aclClient := d.TopicClient, d.UserClient, d.ACLClient
+	if topicClient == nil || userClient == nil || aclClient == nil {
+		conn, err := cloud.SpawnConn(clusterURL, d.dsData.AuthToken, d.dsData.Proxy)
+		if err != nil {
+			response.Diagnostics.AddError("failed to create dataplane clients", fmt.Sprintf("unable to open a connection with the cluster API: %v", err))
+			return
+		}
+		defer conn.Close()
+		topicClient = dataplanev1alpha2grpc.NewTopicServiceClient(conn)
+		userClient = dataplanev1alpha2grpc.NewUserServiceClient(conn)
+		aclClient = dataplanev1alpha2grpc.NewACLServiceClient(conn)
+	}
+
+	e := export{clusterID: cluster.GetId(), clusterURL: clusterURL}
+	if e.topics, err = listTopics(ctx, topicClient); err != nil {
+		response.Diagnostics.AddError("failed to list topics", err.Error())
+		return
+	}
+	if e.users, err = listUsers(ctx, userClient); err != nil {
+		response.Diagnostics.AddError("failed to list users", err.Error())
+		return
+	}
+	if e.acls, err = listACLs(ctx, aclClient); err != nil {
+		response.Diagnostics.AddError("failed to list ACLs", err.Error())
+		return
+	}
+
+	model.ClusterAPIURL = types.StringValue(clusterURL)
+	model.HCL = types.StringValue(renderHCL(e))
+	model.TopicCount = types.Int64Value(int64(len(e.topics)))
+	model.UserCount = types.Int64Value(int64(len(e.users)))
+	model.ACLCount = types.Int64Value(int64(len(e.acls)))
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+// listTopics returns the topics of the cluster that aren't internal, with
+// their dynamic configuration, ordered by name.
+func listTopics(ctx context.Context, client dataplanev1alpha2grpc.TopicServiceClient) ([]exportedTopic, error) {
+	var topics []exportedTopic
+	var pageToken string
+	for {
+		list, err := client.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range list.GetTopics() {
+			if t.GetInternal() {
+				continue
+			}
+			cfgs, err := client.GetTopicConfigurations(ctx, &dataplanev1alpha2.GetTopicConfigurationsRequest{TopicName: t.GetName()})
+			if err != nil {
+				return nil, fmt.Errorf("unable to read the configuration of topic %q: %v", t.GetName(), err)
+			}
+			topic := exportedTopic{
+				name:              t.GetName(),
+				partitionCount:    t.GetPartitionCount(),
+				replicationFactor: t.GetReplicationFactor(),
+				configuration:     map[string]string{},
+			}
+			// only the configuration set on the topic itself is managed by
+			// redpanda_topic, the rest is inherited from the cluster
+			for _, c := range cfgs.GetConfigurations() {
+				if c.GetSource() == dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG && c.Value != nil {
+					topic.configuration[c.GetName()] = c.GetValue()
+				}
+			}
+			topics = append(topics, topic)
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" || len(list.GetTopics()) == 0 {
+			break
+		}
+	}
+	sort.Slice(topics, func(i, j int) bool { return topics[i].name < topics[j].name })
+	return topics, nil
+}
+
+// listUsers returns the users of the cluster, ordered by name.
+func listUsers(ctx context.Context, client dataplanev1alpha2grpc.UserServiceClient) ([]*dataplanev1alpha2.ListUsersResponse_User, error) {
+	var users []*dataplanev1alpha2.ListUsersResponse_User
+	var pageToken string
+	for {
+		list, err := client.ListUsers(ctx, &dataplanev1alpha2.ListUsersRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, list.GetUsers()...)
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" || len(list.GetUsers()) == 0 {
+			break
+		}
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].GetName() < users[j].GetName() })
+	return users, nil
+}
+
+// listACLs returns the ACLs of the cluster, ordered by key.
+func listACLs(ctx context.Context, client dataplanev1alpha2grpc.ACLServiceClient) ([]exportedACL, error) {
+	list, err := client.ListACLs(ctx, &dataplanev1alpha2.ListACLsRequest{Filter: &dataplanev1alpha2.ListACLsRequest_Filter{
+		ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_ANY,
+		ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_ANY,
+		Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+		PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ANY,
+	}})
+	if err != nil {
+		return nil, err
+	}
+	var acls []exportedACL
+	for _, res := range list.GetResources() {
+		for _, a := range res.GetAcls() {
+			acls = append(acls, exportedACL{
+				resourceType:        strings.TrimPrefix(res.GetResourceType().String(), "RESOURCE_TYPE_"),
+				resourceName:        res.GetResourceName(),
+				resourcePatternType: strings.TrimPrefix(res.GetResourcePatternType().String(), "RESOURCE_PATTERN_TYPE_"),
+				principal:           a.GetPrincipal(),
+				host:                a.GetHost(),
+				operation:           strings.TrimPrefix(a.GetOperation().String(), "OPERATION_"),
+				permissionType:      strings.TrimPrefix(a.GetPermissionType().String(), "PERMISSION_TYPE_"),
+			})
+		}
+	}
+	sort.Slice(acls, func(i, j int) bool { return acls[i].key() < acls[j].key() })
+	return acls, nil
+}
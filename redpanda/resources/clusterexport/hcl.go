@@ -0,0 +1,154 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package clusterexport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// export holds the dataplane objects of a cluster to render as HCL.
+type export struct {
+	clusterID  string
+	clusterURL string
+	topics     []exportedTopic
+	users      []*dataplanev1alpha2.ListUsersResponse_User
+	acls       []exportedACL
+}
+
+type exportedTopic struct {
+	name              string
+	partitionCount    int32
+	replicationFactor int32
+	configuration     map[string]string
+}
+
+// exportedACL holds the fields of a redpanda_acl, in the order of its key.
+type exportedACL struct {
+	resourceType        string
+	resourceName        string
+	resourcePatternType string
+	principal           string
+	host                string
+	operation           string
+	permissionType      string
+}
+
+// key returns the key of the ACL, as used in the import ID of redpanda_acl.
+func (a exportedACL) key() string {
+	return strings.Join([]string{a.resourceType, a.resourceName, a.resourcePatternType, a.principal, a.host, a.operation, a.permissionType}, "|")
+}
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// labels hands out unique resource labels derived from object names.
+type labels map[string]bool
+
+// label returns an unused resource label for name, in snake case.
+func (l labels) label(name string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "_" + label
+	}
+	unique := label
+	for i := 2; l[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	l[unique] = true
+	return unique
+}
+
+// renderHCL returns the configuration managing the objects of e, with import
+// blocks adopting them. The password of each user is read from a variable as
+// the API doesn't return it.
+func renderHCL(e export) string {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+
+	topicLabels := labels{}
+	for _, t := range e.topics {
+		label := topicLabels.label(t.name)
+		appendImport(body, "redpanda_topic", label, e.clusterID+"/"+t.name)
+		r := appendResource(body, "redpanda_topic", label)
+		r.SetAttributeValue("name", cty.StringVal(t.name))
+		r.SetAttributeValue("partition_count", cty.NumberIntVal(int64(t.partitionCount)))
+		r.SetAttributeValue("replication_factor", cty.NumberIntVal(int64(t.replicationFactor)))
+		if len(t.configuration) > 0 {
+			cfg := make(map[string]cty.Value, len(t.configuration))
+			for k, v := range t.configuration {
+				cfg[k] = cty.StringVal(v)
+			}
+			r.SetAttributeValue("configuration", cty.MapVal(cfg))
+		}
+		r.SetAttributeValue("cluster_api_url", cty.StringVal(e.clusterURL))
+	}
+
+	userLabels := labels{}
+	for _, u := range e.users {
+		label := userLabels.label(u.GetName())
+		variable := label + "_password"
+		v := body.AppendNewBlock("variable", []string{variable}).Body()
+		v.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+		v.SetAttributeValue("sensitive", cty.True)
+		body.AppendNewline()
+
+		appendImport(body, "redpanda_user", label, e.clusterID+"/"+u.GetName())
+		r := appendResource(body, "redpanda_user", label)
+		r.SetAttributeValue("name", cty.StringVal(u.GetName()))
+		r.SetAttributeTraversal("password", hcl.Traversal{hcl.TraverseRoot{Name: "var"}, hcl.TraverseAttr{Name: variable}})
+		// mechanisms unknown to the provider would fail validation
+		if m := utils.UserMechanismToString(u.Mechanism); m != "unspecified" && !utils.UnknownEnum(u.Mechanism) {
+			r.SetAttributeValue("mechanism", cty.StringVal(m))
+		}
+		r.SetAttributeValue("cluster_api_url", cty.StringVal(e.clusterURL))
+	}
+
+	aclLabels := labels{}
+	for _, a := range e.acls {
+		label := aclLabels.label(strings.Join([]string{a.resourceType, a.resourceName, a.principal, a.operation, a.permissionType}, "_"))
+		appendImport(body, "redpanda_acl", label, e.clusterID+"/"+a.key())
+		r := appendResource(body, "redpanda_acl", label)
+		r.SetAttributeValue("resource_type", cty.StringVal(a.resourceType))
+		r.SetAttributeValue("resource_name", cty.StringVal(a.resourceName))
+		r.SetAttributeValue("resource_pattern_type", cty.StringVal(a.resourcePatternType))
+		r.SetAttributeValue("principal", cty.StringVal(a.principal))
+		r.SetAttributeValue("host", cty.StringVal(a.host))
+		r.SetAttributeValue("operation", cty.StringVal(a.operation))
+		r.SetAttributeValue("permission_type", cty.StringVal(a.permissionType))
+		r.SetAttributeValue("cluster_api_url", cty.StringVal(e.clusterURL))
+	}
+	return string(hclwrite.Format(f.Bytes()))
+}
+
+func appendImport(body *hclwrite.Body, typeName, label, id string) {
+	b := body.AppendNewBlock("import", nil).Body()
+	b.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: typeName}, hcl.TraverseAttr{Name: label}})
+	b.SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+}
+
+func appendResource(body *hclwrite.Body, typeName, label string) *hclwrite.Body {
+	r := body.AppendNewBlock("resource", []string{typeName, label}).Body()
+	body.AppendNewline()
+	return r
+}
@@ -0,0 +1,83 @@
+package clusterexport
+
+import (
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabel(t *testing.T) {
+	l := labels{}
+	assert.Equal(t, "orders", l.label("orders"))
+	assert.Equal(t, "orders_2", l.label("orders"))
+	assert.Equal(t, "orders_v1", l.label("Orders.v1"))
+	assert.Equal(t, "_1_events", l.label("1-events"))
+	assert.Equal(t, "_", l.label("..."))
+}
+
+func TestRenderHCL(t *testing.T) {
+	mechanism := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_256
+	got := renderHCL(export{
+		clusterID:  "cl-1",
+		clusterURL: "https://api-1234.cluster.redpanda.com",
+		topics: []exportedTopic{
+			{name: "orders", partitionCount: 3, replicationFactor: 3, configuration: map[string]string{"cleanup.policy": "compact"}},
+		},
+		users: []*dataplanev1alpha2.ListUsersResponse_User{
+			{Name: "alice", Mechanism: &mechanism},
+		},
+		acls: []exportedACL{
+			{resourceType: "TOPIC", resourceName: "orders", resourcePatternType: "LITERAL", principal: "User:alice", host: "*", operation: "READ", permissionType: "ALLOW"},
+		},
+	})
+	assert.Equal(t, `import {
+  to = redpanda_topic.orders
+  id = "cl-1/orders"
+}
+
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 3
+  replication_factor = 3
+  configuration = {
+    "cleanup.policy" = "compact"
+  }
+  cluster_api_url = "https://api-1234.cluster.redpanda.com"
+}
+
+variable "alice_password" {
+  type      = string
+  sensitive = true
+}
+
+import {
+  to = redpanda_user.alice
+  id = "cl-1/alice"
+}
+
+resource "redpanda_user" "alice" {
+  name            = "alice"
+  password        = var.alice_password
+  mechanism       = "scram-sha-256"
+  cluster_api_url = "https://api-1234.cluster.redpanda.com"
+}
+
+import {
+  to = redpanda_acl.topic_orders_user_alice_read_allow
+  id = "cl-1/TOPIC|orders|LITERAL|User:alice|*|READ|ALLOW"
+}
+
+resource "redpanda_acl" "topic_orders_user_alice_read_allow" {
+  resource_type         = "TOPIC"
+  resource_name         = "orders"
+  resource_pattern_type = "LITERAL"
+  principal             = "User:alice"
+  host                  = "*"
+  operation             = "READ"
+  permission_type       = "ALLOW"
+  cluster_api_url       = "https://api-1234.cluster.redpanda.com"
+}
+
+`, got)
+}
@@ -0,0 +1,104 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// overlappingNetworks returns the networks of the resource group, other than
+// the network of ID skipID, whose CIDR block overlaps cidr.
+func overlappingNetworks(ctx context.Context, client controlplanev1beta2grpc.NetworkServiceClient, resourceGroupID, cidr, skipID string) ([]*controlplanev1beta2.Network, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR block %q: %v", cidr, err)
+	}
+	var overlapping []*controlplanev1beta2.Network
+	pageToken := ""
+	for {
+		resp, err := client.ListNetworks(ctx, &controlplanev1beta2.ListNetworksRequest{
+			Filter:    &controlplanev1beta2.ListNetworksRequest_Filter{ResourceGroupId: resourceGroupID},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list the networks of resource group %q: %v", resourceGroupID, err)
+		}
+		for _, nw := range resp.GetNetworks() {
+			if nw.GetId() == skipID || nw.GetResourceGroupId() != resourceGroupID {
+				continue
+			}
+			other, err := netip.ParsePrefix(nw.GetCidrBlock())
+			if err != nil {
+				// networks with customer-managed resources have no CIDR block
+				continue
+			}
+			if prefix.Overlaps(other) {
+				overlapping = append(overlapping, nw)
+			}
+		}
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" || len(resp.GetNetworks()) == 0 {
+			return overlapping, nil
+		}
+	}
+}
+
+// warnOverlappingCIDR warns when the CIDR block of a new network overlaps the
+// CIDR block of another network of its resource group, since the networks
+// cannot then be peered with the same VPC.
+func (n *Network) warnOverlappingCIDR(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	var cidr, resourceGroupID types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("cidr_block"), &cidr)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("resource_group_id"), &resourceGroupID)...)
+	if response.Diagnostics.HasError() || cidr.IsNull() || cidr.IsUnknown() || resourceGroupID.IsUnknown() {
+		return
+	}
+	var skipID string
+	if !request.State.Raw.IsNull() {
+		var stateCIDR, stateID types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("cidr_block"), &stateCIDR)...)
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("id"), &stateID)...)
+		if response.Diagnostics.HasError() || stateCIDR.Equal(cidr) {
+			return
+		}
+		// the network is replaced, so it doesn't overlap itself
+		skipID = stateID.ValueString()
+	}
+	overlapping, err := overlappingNetworks(ctx, n.CpCl.Network, resourceGroupID.ValueString(), cidr.ValueString(), skipID)
+	if err != nil {
+		// the check is best effort, the network can still be created
+		tflog.Warn(ctx, "unable to check the CIDR block against the other networks", map[string]any{"error": err.Error()})
+		return
+	}
+	for _, nw := range overlapping {
+		response.Diagnostics.AddAttributeWarning(
+			path.Root("cidr_block"),
+			"overlapping CIDR block",
+			fmt.Sprintf("The CIDR block %s overlaps the CIDR block %s of network %q (%s) in the same resource group. "+
+				"Networks with overlapping CIDR blocks cannot be peered with the same VPC.",
+				cidr.ValueString(), nw.GetCidrBlock(), nw.GetName(), nw.GetId()),
+		)
+	}
+}
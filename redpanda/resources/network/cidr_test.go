@@ -0,0 +1,45 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlappingNetworks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockNetworkServiceClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListNetworksResponse{
+			Networks: []*controlplanev1beta2.Network{
+				{Id: "net-1", Name: "contains", ResourceGroupId: "rg-1", CidrBlock: "10.0.0.0/16"},
+				{Id: "net-2", Name: "disjoint", ResourceGroupId: "rg-1", CidrBlock: "10.1.0.0/20"},
+				{Id: "net-3", Name: "byovpc", ResourceGroupId: "rg-1"},
+			},
+			NextPageToken: "page-2",
+		}, nil),
+		client.EXPECT().ListNetworks(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListNetworksRequest, _ ...any) (*controlplanev1beta2.ListNetworksResponse, error) {
+			assert.Equal(t, "page-2", req.GetPageToken())
+			assert.Equal(t, "rg-1", req.GetFilter().GetResourceGroupId())
+			return &controlplanev1beta2.ListNetworksResponse{
+				Networks: []*controlplanev1beta2.Network{
+					{Id: "net-4", Name: "contained", ResourceGroupId: "rg-1", CidrBlock: "10.0.4.0/24"},
+					{Id: "net-5", Name: "replaced", ResourceGroupId: "rg-1", CidrBlock: "10.0.0.0/20"},
+				},
+			}, nil
+		}),
+	)
+
+	overlapping, err := overlappingNetworks(context.Background(), client, "rg-1", "10.0.0.0/20", "net-5")
+	require.NoError(t, err)
+	var names []string
+	for _, nw := range overlapping {
+		names = append(names, nw.GetName())
+	}
+	assert.Equal(t, []string{"contains", "contained"}, names)
+}
@@ -24,9 +24,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -54,16 +57,16 @@ func datasourceNetworkSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "UUID of the network",
+				Required:            true,
+				MarkdownDescription: "UUID of the network",
 			},
 			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Name of the network",
+				Computed:            true,
+				MarkdownDescription: "Name of the network",
 			},
 			"cidr_block": schema.StringAttribute{
-				Computed:    true,
-				Description: "The cidr_block to create the network in",
+				Computed:            true,
+				MarkdownDescription: "The cidr_block to create the network in",
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^(\d{1,3}\.){3}\d{1,3}/(\d{1,2})$`),
@@ -72,29 +75,79 @@ func datasourceNetworkSchema() schema.Schema {
 				},
 			},
 			"region": schema.StringAttribute{
-				Computed:    true,
-				Description: "The region to create the network in. Can also be set at the provider level",
+				Computed:            true,
+				MarkdownDescription: "The region to create the network in. Can also be set at the provider level",
 			},
 			"cloud_provider": schema.StringAttribute{
-				Computed:    true,
-				Description: "The cloud provider to create the network in. Can also be set at the provider level",
-				Validators: []validator.String{
-					stringvalidator.OneOf("gcp", "aws"),
-				},
+				Computed:            true,
+				MarkdownDescription: "The cloud provider to create the network in. Can also be set at the provider level",
+				Validators:          validators.CloudProviders(),
 			},
 			"resource_group_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The ID of the resource group in which to create the network",
+				Computed:            true,
+				MarkdownDescription: "The ID of the resource group in which to create the network",
 			},
 			"cluster_type": schema.StringAttribute{
-				Computed:    true,
-				Description: "The type of cluster this network is associated with, can be one of dedicated or cloud",
+				Computed:            true,
+				MarkdownDescription: "The type of cluster this network is associated with, can be one of dedicated or cloud",
 				Validators: []validator.String{
 					stringvalidator.OneOf("dedicated", "cloud"),
 				},
 			},
+			"customer_managed_resources": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cloud resources created and managed by the customer for a BYOVPC network, if any.",
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Customer-managed resources on AWS.",
+						Attributes: map[string]schema.Attribute{
+							"management_bucket_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the S3 bucket storing the state of the Redpanda cluster deployment.",
+							},
+							"dynamodb_table_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the DynamoDB table storing the locks of the Redpanda cluster deployment.",
+							},
+							"vpc_arn": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ARN of the VPC the Redpanda cluster is deployed into.",
+							},
+							"private_subnet_arns": schema.ListAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								MarkdownDescription: "ARNs of the private subnets of the VPC the Redpanda cluster is deployed into.",
+							},
+							"public_subnet_arns": schema.ListAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								MarkdownDescription: "ARNs of the public subnets of the VPC, if any.",
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						Computed:            true,
+						MarkdownDescription: "Customer-managed resources on GCP.",
+						Attributes: map[string]schema.Attribute{
+							"network_name": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Name of the VPC network the Redpanda cluster is deployed into.",
+							},
+							"network_project_id": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "ID of the GCP project of the VPC network.",
+							},
+							"management_bucket_name": schema.StringAttribute{
+								Computed:            true,
+								MarkdownDescription: "Name of the Cloud Storage bucket storing the state of the Redpanda cluster deployment.",
+							},
+						},
+					},
+				},
+			},
 		},
-		Description: "Data source for a Redpanda Cloud network",
+		MarkdownDescription: "Data source for a Redpanda Cloud network",
 	}
 }
 
@@ -107,21 +160,15 @@ func (n *DataSourceNetwork) Read(ctx context.Context, req datasource.ReadRequest
 		resp.Diagnostics.AddError(fmt.Sprintf("failed to read network %s", model.ID.ValueString()), err.Error())
 		return
 	}
+	utils.WarnUnknownEnum(&resp.Diagnostics, "cloud_provider", nw.GetCloudProvider())
+	utils.WarnUnknownEnum(&resp.Diagnostics, "cluster_type", nw.GetClusterType())
 	resp.Diagnostics.Append(resp.State.Set(ctx, generateModel(nw))...)
 }
 
 // Configure uses provider level data to configure DataSourceNetwork's client.
 func (n *DataSourceNetwork) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	n.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
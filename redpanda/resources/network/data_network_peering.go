@@ -0,0 +1,144 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	uiv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/ui/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DataSourceNetworkPeering{}
+)
+
+// DataSourceNetworkPeering represents a data source for the details needed to
+// peer a VPC with a Redpanda Cloud network. It reads them from the Cloud UI
+// API, which is not part of the Public API, so the data source is
+// experimental.
+type DataSourceNetworkPeering struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the metadata for the NetworkPeering data source.
+func (*DataSourceNetworkPeering) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_network_peering"
+}
+
+// Schema returns the schema for the NetworkPeering data source.
+func (*DataSourceNetworkPeering) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceNetworkPeeringSchema()
+}
+
+func datasourceNetworkPeeringSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"network_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "UUID of the network",
+			},
+			"aws": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "VPC of the network, for networks on AWS",
+				Attributes: map[string]schema.Attribute{
+					"owner_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the AWS account owning the VPC",
+					},
+					"vpc_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the VPC",
+					},
+					"cidr_block": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "CIDR block of the VPC",
+					},
+				},
+			},
+			"gcp": schema.SingleNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "VPC network of the network, for networks on GCP",
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the GCP project holding the VPC network",
+					},
+					"network_name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the VPC network",
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Data source for the details needed to peer a VPC with a Redpanda Cloud network. " +
+			"**Experimental:** the details are read from the Redpanda Cloud UI API, which has no stability guarantee, " +
+			"so this data source may change or be removed in a minor release.",
+	}
+}
+
+// Read reads the NetworkPeering data source's values and updates the state.
+func (n *DataSourceNetworkPeering) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.NetworkPeering
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := n.CpCl.NetworkPeering.GetNetworkPeeringInstructions(ctx, &uiv1alpha1.GetNetworkPeeringInstructionsRequest{NetworkId: model.NetworkID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the peering details of network %s", model.NetworkID.ValueString()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, generatePeeringModel(model.NetworkID.ValueString(), res.GetInstructions()))...)
+}
+
+// generatePeeringModel populates a NetworkPeering model from the peering
+// instructions of the network.
+func generatePeeringModel(networkID string, instructions *uiv1alpha1.NetworkPeeringInstructions) models.NetworkPeering {
+	model := models.NetworkPeering{NetworkID: types.StringValue(networkID)}
+	details := instructions.GetVpcPeeringDetails()
+	if aws := details.GetAws(); aws != nil {
+		model.Aws = &models.NetworkPeeringAws{
+			OwnerID:   types.StringValue(aws.GetOwnerId()),
+			VpcID:     types.StringValue(aws.GetVpcId()),
+			CidrBlock: types.StringValue(aws.GetCidrBlock()),
+		}
+	}
+	if gcp := details.GetGcp(); gcp != nil {
+		model.Gcp = &models.NetworkPeeringGcp{
+			ProjectID:   types.StringValue(gcp.GetProjectId()),
+			NetworkName: types.StringValue(gcp.GetNetworkName()),
+		}
+	}
+	return model
+}
+
+// Configure uses provider level data to configure DataSourceNetworkPeering's
+// client.
+func (n *DataSourceNetworkPeering) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	n.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
@@ -0,0 +1,37 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	uiv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/ui/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePeeringSchema(t *testing.T) {
+	if d := datasourceNetworkPeeringSchema().ValidateImplementation(context.Background()); d.HasError() {
+		t.Errorf("Unexpected error in schema: %s", d)
+	}
+}
+
+func TestGeneratePeeringModel(t *testing.T) {
+	got := generatePeeringModel("net-1", &uiv1alpha1.NetworkPeeringInstructions{
+		NetworkId: "net-1",
+		VpcPeeringDetails: &uiv1alpha1.NetworkPeeringInstructions_VpcPeeringDetails{
+			CloudProvider: &uiv1alpha1.NetworkPeeringInstructions_VpcPeeringDetails_Aws{
+				Aws: &uiv1alpha1.NetworkPeeringInstructions_AWSNetworkDetails{OwnerId: "123456789012", VpcId: "vpc-1", CidrBlock: "10.0.0.0/20"},
+			},
+		},
+	})
+	assert.Equal(t, models.NetworkPeering{
+		NetworkID: types.StringValue("net-1"),
+		Aws: &models.NetworkPeeringAws{
+			OwnerID:   types.StringValue("123456789012"),
+			VpcID:     types.StringValue("vpc-1"),
+			CidrBlock: types.StringValue("10.0.0.0/20"),
+		},
+	}, got)
+	assert.Equal(t, models.NetworkPeering{NetworkID: types.StringValue("net-2")}, generatePeeringModel("net-2", nil))
+}
@@ -16,12 +16,40 @@
 package network
 
 import (
+	"fmt"
+
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 )
 
+// generateNetworkRequest generates the creation request of the network
+// planned in model.
+func generateNetworkRequest(model models.Network) (*controlplanev1beta2.NetworkCreate, error) {
+	cloudProvider, err := utils.StringToCloudProvider(model.CloudProvider.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unsupported cloud provider: %v", err)
+	}
+	clusterType, err := utils.StringToClusterType(model.ClusterType.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("unsupported cluster type: %v", err)
+	}
+	return &controlplanev1beta2.NetworkCreate{
+		Name:            model.Name.ValueString(),
+		CidrBlock:       model.CidrBlock.ValueString(),
+		Region:          model.Region.ValueString(),
+		CloudProvider:   cloudProvider,
+		ResourceGroupId: model.ResourceGroupID.ValueString(),
+		ClusterType:     clusterType,
+
+		CustomerManagedResources: toCustomerManagedResources(model.CustomerManagedResources),
+	}, nil
+}
+
+// generateModel populates the Network model to be persisted to state.
 func generateModel(nw *controlplanev1beta2.Network) *models.Network {
 	return &models.Network{
 		CidrBlock:       types.StringValue(nw.CidrBlock),
@@ -31,5 +59,107 @@ func generateModel(nw *controlplanev1beta2.Network) *models.Network {
 		Name:            types.StringValue(nw.Name),
 		Region:          types.StringValue(nw.Region),
 		ResourceGroupID: types.StringValue(nw.ResourceGroupId),
+
+		CustomerManagedResources: toCustomerManagedResourcesModel(nw.GetCustomerManagedResources()),
+	}
+}
+
+// toCustomerManagedResources converts the customer-managed resources of the
+// model to their API representation. Returns nil if none are set.
+func toCustomerManagedResources(cmr *models.NetworkCustomerManagedResources) *controlplanev1beta2.Network_CustomerManagedResources {
+	switch {
+	case cmr == nil:
+		return nil
+	case cmr.AWS != nil:
+		aws := &controlplanev1beta2.Network_CustomerManagedResources_AWS{
+			ManagementBucket: &controlplanev1beta2.CustomerManagedAWSCloudStorageBucket{Arn: cmr.AWS.ManagementBucketArn.ValueString()},
+			DynamodbTable:    &controlplanev1beta2.CustomerManagedDynamoDBTable{Arn: cmr.AWS.DynamoDBTableArn.ValueString()},
+			Vpc:              &controlplanev1beta2.CustomerManagedAWSVPC{Arn: cmr.AWS.VpcArn.ValueString()},
+			PrivateSubnets:   &controlplanev1beta2.CustomerManagedAWSSubnets{Arns: utils.TypeListToStringSlice(cmr.AWS.PrivateSubnetArns)},
+		}
+		if arns := utils.TypeListToStringSlice(cmr.AWS.PublicSubnetArns); len(arns) > 0 {
+			aws.PublicSubnets = &controlplanev1beta2.CustomerManagedAWSSubnets{Arns: arns}
+		}
+		return &controlplanev1beta2.Network_CustomerManagedResources{
+			CloudProvider: &controlplanev1beta2.Network_CustomerManagedResources_Aws{Aws: aws},
+		}
+	case cmr.GCP != nil:
+		return &controlplanev1beta2.Network_CustomerManagedResources{
+			CloudProvider: &controlplanev1beta2.Network_CustomerManagedResources_Gcp{Gcp: &controlplanev1beta2.Network_CustomerManagedResources_GCP{
+				NetworkName:      cmr.GCP.NetworkName.ValueString(),
+				NetworkProjectId: cmr.GCP.NetworkProjectID.ValueString(),
+				ManagementBucket: &controlplanev1beta2.CustomerManagedGoogleCloudStorageBucket{Name: cmr.GCP.ManagementBucketName.ValueString()},
+			}},
+		}
+	}
+	return nil
+}
+
+// toCustomerManagedResourcesModel converts the customer-managed resources
+// reported by the API to the model. Returns nil if the network has none.
+func toCustomerManagedResourcesModel(cmr *controlplanev1beta2.Network_CustomerManagedResources) *models.NetworkCustomerManagedResources {
+	if aws := cmr.GetAws(); aws != nil {
+		public := types.ListNull(types.StringType)
+		if arns := aws.GetPublicSubnets().GetArns(); len(arns) > 0 {
+			public = utils.StringSliceToTypeList(arns)
+		}
+		return &models.NetworkCustomerManagedResources{AWS: &models.NetworkCustomerManagedAWS{
+			ManagementBucketArn: types.StringValue(aws.GetManagementBucket().GetArn()),
+			DynamoDBTableArn:    types.StringValue(aws.GetDynamodbTable().GetArn()),
+			VpcArn:              types.StringValue(aws.GetVpc().GetArn()),
+			PrivateSubnetArns:   utils.StringSliceToTypeList(aws.GetPrivateSubnets().GetArns()),
+			PublicSubnetArns:    public,
+		}}
+	}
+	if gcp := cmr.GetGcp(); gcp != nil {
+		return &models.NetworkCustomerManagedResources{GCP: &models.NetworkCustomerManagedGCP{
+			NetworkName:          types.StringValue(gcp.GetNetworkName()),
+			NetworkProjectID:     types.StringValue(gcp.GetNetworkProjectId()),
+			ManagementBucketName: types.StringValue(gcp.GetManagementBucket().GetName()),
+		}}
+	}
+	return nil
+}
+
+// validateCustomerManagedResources checks that a network either has a CIDR
+// block or brings its own VPC, and that customer-managed resources are only
+// given for BYOC networks, in the block of the network's cloud provider.
+// Unknown values are not checked.
+func validateCustomerManagedResources(model models.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cmrPath := path.Root("customer_managed_resources")
+	cmr := model.CustomerManagedResources
+	if cmr == nil {
+		if model.CidrBlock.IsNull() {
+			diags.AddAttributeError(path.Root("cidr_block"), "Missing CIDR block",
+				"cidr_block is required unless the network uses customer_managed_resources")
+		}
+		return diags
+	}
+	if !model.ClusterType.IsUnknown() && model.ClusterType.ValueString() != utils.ClusterTypeToString(controlplanev1beta2.Cluster_TYPE_BYOC) {
+		diags.AddAttributeError(cmrPath, "Invalid Configuration",
+			fmt.Sprintf("customer_managed_resources can only be set when cluster_type is byoc, but it is set to %s", model.ClusterType.ValueString()))
+	}
+	if cmr.AWS != nil && cmr.GCP != nil {
+		diags.AddAttributeError(cmrPath, "Invalid Configuration", "only one of aws or gcp can be set in customer_managed_resources")
+		return diags
+	}
+	if model.CloudProvider.IsUnknown() {
+		return diags
+	}
+	provider := model.CloudProvider.ValueString()
+	switch {
+	case provider == utils.CloudProviderStringAws && cmr.AWS == nil,
+		provider == utils.CloudProviderStringGcp && cmr.GCP == nil:
+		diags.AddAttributeError(cmrPath.AtName(provider), "Missing Configuration",
+			fmt.Sprintf("customer_managed_resources.%s is required when cloud_provider is %s", provider, provider))
+	case provider != utils.CloudProviderStringAws && provider != utils.CloudProviderStringGcp:
+		diags.AddAttributeError(cmrPath, "Invalid Configuration",
+			fmt.Sprintf("customer_managed_resources are only supported on aws and gcp, but cloud_provider is set to %s", provider))
+	}
+	if cmr.AWS != nil && !cmr.AWS.PrivateSubnetArns.IsUnknown() && len(cmr.AWS.PrivateSubnetArns.Elements()) == 0 {
+		diags.AddAttributeError(cmrPath.AtName("aws").AtName("private_subnet_arns"), "Missing Configuration",
+			"at least one private subnet is required")
 	}
+	return diags
 }
@@ -0,0 +1,69 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCustomerManagedResources(t *testing.T) {
+	aws := &models.NetworkCustomerManagedResources{AWS: &models.NetworkCustomerManagedAWS{
+		ManagementBucketArn: types.StringValue("arn:aws:s3:::redpanda-management"),
+		DynamoDBTableArn:    types.StringValue("arn:aws:dynamodb:us-east-2:123456789012:table/redpanda-locks"),
+		VpcArn:              types.StringValue("arn:aws:ec2:us-east-2:123456789012:vpc/vpc-0a1b2c3d"),
+		PrivateSubnetArns:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("arn:aws:ec2:us-east-2:123456789012:subnet/subnet-01")}),
+		PublicSubnetArns:    types.ListNull(types.StringType),
+	}}
+	gcp := &models.NetworkCustomerManagedResources{GCP: &models.NetworkCustomerManagedGCP{
+		NetworkName:          types.StringValue("redpanda-vpc"),
+		NetworkProjectID:     types.StringValue("acme-network"),
+		ManagementBucketName: types.StringValue("redpanda-management"),
+	}}
+	network := func(provider, clusterType string, cidr types.String, cmr *models.NetworkCustomerManagedResources) models.Network {
+		return models.Network{
+			CloudProvider:            types.StringValue(provider),
+			ClusterType:              types.StringValue(clusterType),
+			CidrBlock:                cidr,
+			CustomerManagedResources: cmr,
+		}
+	}
+	cidr := types.StringValue("10.0.0.0/20")
+
+	tests := []struct {
+		name    string
+		model   models.Network
+		wantErr string
+	}{
+		{name: "cidr block", model: network("aws", "dedicated", cidr, nil)},
+		{name: "aws byovpc", model: network("aws", "byoc", types.StringNull(), aws)},
+		{name: "gcp byovpc", model: network("gcp", "byoc", cidr, gcp)},
+		{name: "unknown provider", model: models.Network{CloudProvider: types.StringUnknown(), ClusterType: types.StringValue("byoc"), CustomerManagedResources: gcp}},
+		{name: "missing cidr block", model: network("aws", "dedicated", types.StringNull(), nil), wantErr: "cidr_block is required"},
+		{name: "dedicated", model: network("aws", "dedicated", cidr, aws), wantErr: "only be set when cluster_type is byoc"},
+		{name: "wrong provider block", model: network("aws", "byoc", cidr, gcp), wantErr: "customer_managed_resources.aws is required"},
+		{name: "azure", model: network("azure", "byoc", cidr, aws), wantErr: "only supported on aws and gcp"},
+		{name: "both blocks", model: network("aws", "byoc", cidr, &models.NetworkCustomerManagedResources{AWS: aws.AWS, GCP: gcp.GCP}), wantErr: "only one of aws or gcp"},
+		{
+			name: "no private subnets",
+			model: network("aws", "byoc", cidr, &models.NetworkCustomerManagedResources{AWS: &models.NetworkCustomerManagedAWS{
+				PrivateSubnetArns: types.ListValueMust(types.StringType, []attr.Value{}),
+			}}),
+			wantErr: "at least one private subnet",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateCustomerManagedResources(tt.model)
+			if tt.wantErr == "" {
+				assert.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			if assert.True(t, diags.HasError()) {
+				assert.Contains(t, diags.Errors()[0].Detail(), tt.wantErr)
+			}
+		})
+	}
+}
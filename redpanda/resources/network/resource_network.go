@@ -24,13 +24,16 @@ import (
 	"time"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
@@ -40,14 +43,22 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &Network{}
-	_ resource.ResourceWithConfigure   = &Network{}
-	_ resource.ResourceWithImportState = &Network{}
+	_ resource.Resource                   = &Network{}
+	_ resource.ResourceWithConfigure      = &Network{}
+	_ resource.ResourceWithImportState    = &Network{}
+	_ resource.ResourceWithIdentity       = &Network{}
+	_ resource.ResourceWithValidateConfig = &Network{}
+	_ resource.ResourceWithModifyPlan     = &Network{}
 )
 
 // Network represents a network managed resource.
 type Network struct {
 	CpCl *cloud.ControlPlaneClientSet
+
+	timeouts config.Timeouts
+	polling  config.Polling
+
+	preventDuplicateNames bool
 }
 
 // Metadata returns the full name of the Network resource.
@@ -57,23 +68,19 @@ func (*Network) Metadata(_ context.Context, _ resource.MetadataRequest, response
 
 // Configure uses provider level data to configure Network's clients.
 func (n *Network) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		// we can't add a diagnostic for an unset ProviderData here because
-		// during the early part of the terraform lifecycle, the provider data
-		// is not set and this is valid, but we also can't do anything until it
-		// is set
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Resource)
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	n.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	n.timeouts = p.Timeouts
+	n.polling = p.Polling
+	n.preventDuplicateNames = p.PreventDuplicateNames
+}
+
+// IdentitySchema returns the identity schema for the Network resource.
+func (*Network) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = utils.IDIdentitySchema("ID of the network.")
 }
 
 // Schema returns the schema for the Network resource.
@@ -85,14 +92,15 @@ func resourceNetworkSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required:      true,
-				Description:   "Name of the network",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Name of the network",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"cidr_block": schema.StringAttribute{
-				Required:      true,
-				Description:   "The cidr_block to create the network in",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The cidr_block to create the network in. Required unless `customer_managed_resources` is set. The plan warns when it overlaps the CIDR block of another network of the resource group.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^(\d{1,3}\.){3}\d{1,3}/(\d{1,2})$`),
@@ -101,63 +109,145 @@ func resourceNetworkSchema() schema.Schema {
 				},
 			},
 			"region": schema.StringAttribute{
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Description:   "The region to create the network in.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "The region to create the network in.",
 			},
 			"cloud_provider": schema.StringAttribute{
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
-				Description:   "The cloud provider to create the network in.",
-				Validators:    validators.CloudProviders(),
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "The cloud provider to create the network in.",
+				Validators:          validators.CloudProviders(),
 			},
 			"resource_group_id": schema.StringAttribute{
-				Required:      true,
-				Description:   "The ID of the resource group in which to create the network",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The ID of the resource group in which to create the network",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				Description:   "The ID of the network",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Computed:            true,
+				MarkdownDescription: "The ID of the network",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"cluster_type": schema.StringAttribute{
-				Required:      true,
-				Description:   "The type of cluster this network is associated with, can be one of dedicated or cloud",
-				Validators:    validators.ClusterTypes(),
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The type of cluster this network is associated with, can be one of dedicated or cloud",
+				Validators:          validators.ClusterTypes(),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"customer_managed_resources": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Cloud resources created and managed by you for a BYOC cluster deployed into your own VPC " +
+					"(BYOVPC). Only the block of the network's cloud provider can be set.",
+				PlanModifiers: []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"aws": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Customer-managed resources on AWS.",
+						Attributes: map[string]schema.Attribute{
+							"management_bucket_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the S3 bucket storing the state of the Redpanda cluster deployment.",
+							},
+							"dynamodb_table_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the DynamoDB table storing the locks of the Redpanda cluster deployment.",
+							},
+							"vpc_arn": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ARN of the VPC the Redpanda cluster is deployed into.",
+							},
+							"private_subnet_arns": schema.ListAttribute{
+								Required:            true,
+								ElementType:         types.StringType,
+								MarkdownDescription: "ARNs of the private subnets of the VPC the Redpanda cluster is deployed into.",
+							},
+							"public_subnet_arns": schema.ListAttribute{
+								Optional:            true,
+								ElementType:         types.StringType,
+								MarkdownDescription: "ARNs of the public subnets of the VPC, if any.",
+							},
+						},
+					},
+					"gcp": schema.SingleNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Customer-managed resources on GCP.",
+						Attributes: map[string]schema.Attribute{
+							"network_name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Name of the VPC network the Redpanda cluster is deployed into.",
+							},
+							"network_project_id": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "ID of the GCP project of the VPC network.",
+							},
+							"management_bucket_name": schema.StringAttribute{
+								Required:            true,
+								MarkdownDescription: "Name of the Cloud Storage bucket storing the state of the Redpanda cluster deployment.",
+							},
+						},
+					},
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{Create: true, Delete: true}),
+		},
+	}
+}
+
+// ValidateConfig checks the customer-managed resources of the network
+// against its cloud provider and cluster type.
+func (*Network) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var model models.NetworkResource
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(validateCustomerManagedResources(model.Network)...)
+}
+
+// ModifyPlan checks the CIDR block of new networks against the other networks
+// of their resource group, and their name when prevent_duplicate_names is set.
+// It warns about the attributes set to deprecated API fields.
+func (n *Network) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.NetworkResource
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if nwReq, err := generateNetworkRequest(plan.Network); err == nil {
+		utils.WarnDeprecatedFields(&response.Diagnostics, nwReq)
+	}
+	if n.CpCl == nil || response.Diagnostics.HasError() {
+		return
+	}
+	n.warnOverlappingCIDR(ctx, request, response)
+	if n.preventDuplicateNames {
+		utils.PlanUniqueName(ctx, request, response, "redpanda_network", n.CpCl.NetworkIDsForName)
 	}
 }
 
 // Create creates a new Network resource. It updates the state if the resource
 // is successfully created.
 func (n *Network) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
-	var model models.Network
+	ctx, notices := cloud.WithDeprecationNotices(ctx)
+	defer utils.WarnDeprecationNotices(&response.Diagnostics, notices)
+	var model models.NetworkResource
 	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
-
-	cloudProvider, err := utils.StringToCloudProvider(model.CloudProvider.ValueString())
-	if err != nil {
-		response.Diagnostics.AddError("unsupported cloud provider", err.Error())
+	createTimeout, diags := model.Timeouts.Create(ctx, n.timeouts.Scale(15*time.Minute))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
-	clusterType, err := utils.StringToClusterType(model.ClusterType.ValueString())
+
+	nwReq, err := generateNetworkRequest(model.Network)
 	if err != nil {
-		response.Diagnostics.AddError("unsupported cluster type", err.Error())
+		response.Diagnostics.AddError("unable to parse CreateNetwork request", err.Error())
 		return
 	}
 
-	netResp, err := n.CpCl.Network.CreateNetwork(ctx, &controlplanev1beta2.CreateNetworkRequest{
-		Network: &controlplanev1beta2.NetworkCreate{
-			Name:            model.Name.ValueString(),
-			CidrBlock:       model.CidrBlock.ValueString(),
-			Region:          model.Region.ValueString(),
-			CloudProvider:   cloudProvider,
-			ResourceGroupId: model.ResourceGroupID.ValueString(),
-			ClusterType:     clusterType,
-		},
-	})
+	netResp, err := n.CpCl.Network.CreateNetwork(ctx, &controlplanev1beta2.CreateNetworkRequest{Network: nwReq})
 	if err != nil {
 		response.Diagnostics.AddError("failed to create network", err.Error())
 		return
@@ -165,8 +255,11 @@ func (n *Network) Create(ctx context.Context, request resource.CreateRequest, re
 	op := netResp.Operation
 	// write initial state so that if network creation fails, we can still track and delete it
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), utils.TrimmedStringValue(op.GetResourceId()))...)
+	response.Diagnostics.Append(utils.SetIdentity(ctx, response.Identity, models.ResourceIdentity{ID: utils.TrimmedStringValue(op.GetResourceId())})...)
 
-	if err := utils.AreWeDoneYet(ctx, op, 15*time.Minute, n.CpCl.Operation); err != nil {
+	pollCtx, watchdog := n.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&response.Diagnostics, watchdog)
+	if err := utils.AreWeDoneYet(pollCtx, op, createTimeout, n.CpCl.Operation); err != nil {
 		response.Diagnostics.AddError("failed waiting for network creation", err.Error())
 		return
 	}
@@ -176,12 +269,14 @@ func (n *Network) Create(ctx context.Context, request resource.CreateRequest, re
 		response.Diagnostics.AddError(fmt.Sprintf("failed to read network %s", op.GetResourceId()), err.Error())
 		return
 	}
-	response.Diagnostics.Append(response.State.Set(ctx, generateModel(nw))...)
+	response.Diagnostics.Append(response.State.Set(ctx, models.NetworkResource{Network: *generateModel(nw), Timeouts: model.Timeouts})...)
 }
 
 // Read reads Network resource's values and updates the state.
 func (n *Network) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
-	var model models.Network
+	ctx, notices := cloud.WithDeprecationNotices(ctx)
+	defer utils.WarnDeprecationNotices(&response.Diagnostics, notices)
+	var model models.NetworkResource
 	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
 	nw, err := n.CpCl.NetworkForID(ctx, model.ID.ValueString())
 	if err != nil {
@@ -199,18 +294,36 @@ func (n *Network) Read(ctx context.Context, request resource.ReadRequest, respon
 		response.Diagnostics.AddWarning(fmt.Sprintf("network %s is in state %s", nw.Id, nw.GetState()), "")
 		return
 	}
-	response.Diagnostics.Append(response.State.Set(ctx, generateModel(nw))...)
+	persist := generateModel(nw)
+	// values added to the API after this provider was built would
+	// otherwise replace the network
+	persist.CloudProvider = utils.KeepUnknownEnum(&response.Diagnostics, "cloud_provider", nw.GetCloudProvider(), model.CloudProvider, persist.CloudProvider)
+	persist.ClusterType = utils.KeepUnknownEnum(&response.Diagnostics, "cluster_type", nw.GetClusterType(), model.ClusterType, persist.ClusterType)
+	response.Diagnostics.Append(response.State.Set(ctx, models.NetworkResource{Network: *persist, Timeouts: model.Timeouts})...)
+	response.Diagnostics.Append(utils.SetIdentity(ctx, response.Identity, models.ResourceIdentity{ID: utils.TrimmedStringValue(nw.GetId())})...)
 }
 
 // Update is not supported for network. As a result all configurable schema
-// elements have been marked as RequiresReplace.
-func (*Network) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+// elements have been marked as RequiresReplace, and only the timeouts are
+// updated in place.
+func (*Network) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan models.NetworkResource
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
 }
 
 // Delete deletes the Network resource.
 func (n *Network) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
-	var model models.Network
+	var model models.NetworkResource
 	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	deleteTimeout, diags := model.Timeouts.Delete(ctx, n.timeouts.Scale(15*time.Minute))
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	netResp, err := n.CpCl.Network.DeleteNetwork(ctx, &controlplanev1beta2.DeleteNetworkRequest{
 		Id: model.ID.ValueString(),
 	})
@@ -218,7 +331,9 @@ func (n *Network) Delete(ctx context.Context, request resource.DeleteRequest, re
 		response.Diagnostics.AddError("failed to delete network", err.Error())
 		return
 	}
-	if err := utils.AreWeDoneYet(ctx, netResp.Operation, 15*time.Minute, n.CpCl.Operation); err != nil {
+	pollCtx, watchdog := n.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&response.Diagnostics, watchdog)
+	if err := utils.AreWeDoneYet(pollCtx, netResp.Operation, deleteTimeout, n.CpCl.Operation); err != nil {
 		response.Diagnostics.AddError("failed waiting for network deletion", err.Error())
 	}
 }
@@ -228,5 +343,5 @@ func (n *Network) Delete(ctx context.Context, request resource.DeleteRequest, re
 // https://developer.hashicorp.com/terraform/plugin/framework/resources/import
 // for more details.
 func (*Network) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
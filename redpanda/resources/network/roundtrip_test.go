@@ -0,0 +1,115 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/testutil"
+)
+
+// fakeCreateNetwork returns the network the API creates for the request.
+func fakeCreateNetwork(id string, req *controlplanev1beta2.NetworkCreate) *controlplanev1beta2.Network {
+	return &controlplanev1beta2.Network{
+		Id:              id,
+		Name:            req.GetName(),
+		ResourceGroupId: req.GetResourceGroupId(),
+		CloudProvider:   req.GetCloudProvider(),
+		Region:          req.GetRegion(),
+		CidrBlock:       req.GetCidrBlock(),
+		ClusterType:     req.GetClusterType(),
+		State:           controlplanev1beta2.Network_STATE_READY,
+
+		CustomerManagedResources: req.GetCustomerManagedResources(),
+	}
+}
+
+// TestNetworkRoundTrip checks that every attribute of a planned network
+// survives the creation request. The generated requests are compared with the
+// golden files in testdata.
+func TestNetworkRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		planned models.Network
+	}{
+		{
+			name: "aws_dedicated",
+			planned: models.Network{
+				Name:            types.StringValue("orders"),
+				ResourceGroupID: types.StringValue("cqj0qkeeag2gl7rs2mfg"),
+				CloudProvider:   types.StringValue("aws"),
+				Region:          types.StringValue("us-east-2"),
+				CidrBlock:       types.StringValue("10.0.0.0/20"),
+				ClusterType:     types.StringValue("dedicated"),
+				ID:              types.StringNull(),
+			},
+		},
+		{
+			name: "gcp_byoc",
+			planned: models.Network{
+				Name:            types.StringValue("orders"),
+				ResourceGroupID: types.StringValue("cqj0qkeeag2gl7rs2mfg"),
+				CloudProvider:   types.StringValue("gcp"),
+				Region:          types.StringValue("us-central1"),
+				CidrBlock:       types.StringValue("10.1.0.0/20"),
+				ClusterType:     types.StringValue("byoc"),
+				ID:              types.StringNull(),
+			},
+		},
+		{
+			name: "aws_byovpc",
+			planned: models.Network{
+				Name:            types.StringValue("orders"),
+				ResourceGroupID: types.StringValue("cqj0qkeeag2gl7rs2mfg"),
+				CloudProvider:   types.StringValue("aws"),
+				Region:          types.StringValue("us-east-2"),
+				CidrBlock:       types.StringValue("10.2.0.0/20"),
+				ClusterType:     types.StringValue("byoc"),
+				ID:              types.StringNull(),
+				CustomerManagedResources: &models.NetworkCustomerManagedResources{AWS: &models.NetworkCustomerManagedAWS{
+					ManagementBucketArn: types.StringValue("arn:aws:s3:::redpanda-management"),
+					DynamoDBTableArn:    types.StringValue("arn:aws:dynamodb:us-east-2:123456789012:table/redpanda-locks"),
+					VpcArn:              types.StringValue("arn:aws:ec2:us-east-2:123456789012:vpc/vpc-0a1b2c3d"),
+					PrivateSubnetArns: types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("arn:aws:ec2:us-east-2:123456789012:subnet/subnet-01"),
+						types.StringValue("arn:aws:ec2:us-east-2:123456789012:subnet/subnet-02"),
+					}),
+					PublicSubnetArns: types.ListNull(types.StringType),
+				}},
+			},
+		},
+		{
+			name: "gcp_byovpc",
+			planned: models.Network{
+				Name:            types.StringValue("orders"),
+				ResourceGroupID: types.StringValue("cqj0qkeeag2gl7rs2mfg"),
+				CloudProvider:   types.StringValue("gcp"),
+				Region:          types.StringValue("us-central1"),
+				CidrBlock:       types.StringValue("10.3.0.0/20"),
+				ClusterType:     types.StringValue("byoc"),
+				ID:              types.StringNull(),
+				CustomerManagedResources: &models.NetworkCustomerManagedResources{GCP: &models.NetworkCustomerManagedGCP{
+					NetworkName:          types.StringValue("redpanda-vpc"),
+					NetworkProjectID:     types.StringValue("acme-network"),
+					ManagementBucketName: types.StringValue("redpanda-management"),
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := generateNetworkRequest(tt.planned)
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.Golden(t, tt.name+".golden.json", req)
+			read := generateModel(fakeCreateNetwork("cqj0qm6eag2gl7rs2mg0", req))
+			testutil.RoundTrip(context.Background(), t, resourceNetworkSchema(),
+				models.NetworkResource{Network: tt.planned, Timeouts: testutil.NullTimeouts("create", "delete")},
+				models.NetworkResource{Network: *read, Timeouts: testutil.NullTimeouts("create", "delete")})
+		})
+	}
+}
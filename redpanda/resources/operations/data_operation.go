@@ -0,0 +1,165 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package operations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DataSourceOperation{}
+)
+
+// DataSourceOperation represents a data source for a single operation of
+// Redpanda Cloud, looked up by ID.
+type DataSourceOperation struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// DataSourceOperationSchema defines the schema for an Operation data source.
+func DataSourceOperationSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the operation",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Type of the operation, e.g. create_cluster",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "State of the operation: in_progress, completed or failed",
+			},
+			"resource_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the resource the operation applies to",
+			},
+			"started_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time the operation started at, in RFC 3339 format",
+			},
+			"finished_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time the operation finished at, in RFC 3339 format, null while it is in progress",
+			},
+			"error_code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Code of the error of a failed operation, e.g. ResourceExhausted",
+			},
+			"error_message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Message of the error of a failed operation",
+			},
+			"metadata": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metadata of the operation in JSON, which depends on its type",
+			},
+		},
+		MarkdownDescription: "Data source for an operation of Redpanda Cloud, e.g. to find out why the creation of a cluster failed from the operation ID in the error.",
+	}
+}
+
+// Metadata returns the metadata for the Operation data source.
+func (*DataSourceOperation) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_operation"
+}
+
+// Schema returns the schema for the Operation data source.
+func (*DataSourceOperation) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceOperationSchema()
+}
+
+// Read reads the Operation data source's values and updates the state.
+func (r *DataSourceOperation) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.Operation
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := r.CpCl.Operation.GetOperation(ctx, &controlplanev1beta2.GetOperationRequest{Id: model.ID.ValueString()})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("unable to find operation %s", model.ID), err.Error())
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read operation %s", model.ID), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, operationModel(op.GetOperation()))...)
+}
+
+// operationModel returns the state of the Operation data source for op.
+func operationModel(op *controlplanev1beta2.Operation) models.Operation {
+	m := models.Operation{
+		ID:           types.StringValue(op.GetId()),
+		Type:         types.StringValue(operationTypeToString(op.GetType())),
+		State:        types.StringValue(strings.ToLower(strings.TrimPrefix(op.GetState().String(), "STATE_"))),
+		ResourceID:   types.StringPointerValue(op.ResourceId),
+		StartedAt:    timestampValue(op.GetStartedAt()),
+		FinishedAt:   timestampValue(op.GetFinishedAt()),
+		ErrorCode:    types.StringNull(),
+		ErrorMessage: types.StringNull(),
+		Metadata:     types.StringNull(),
+	}
+	if e := op.GetError(); e != nil {
+		m.ErrorCode = types.StringValue(status.FromProto(e).Code().String())
+		m.ErrorMessage = types.StringValue(e.GetMessage())
+	}
+	if md := op.GetMetadata(); md != nil {
+		b, err := protojson.Marshal(md)
+		if err != nil {
+			// metadata of a type unknown to the provider
+			b = []byte(fmt.Sprintf("{%q:%q}", "@type", md.GetTypeUrl()))
+		}
+		m.Metadata = types.StringValue(string(b))
+	}
+	return m
+}
+
+func timestampValue(ts *timestamppb.Timestamp) types.String {
+	if ts == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(ts.AsTime().Format(time.RFC3339))
+}
+
+// Configure uses provider level data to configure DataSourceOperation client.
+func (r *DataSourceOperation) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
@@ -0,0 +1,64 @@
+package operations
+
+import (
+	"testing"
+	"time"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestOperationModel(t *testing.T) {
+	started := time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC)
+	clusterID := "cr3g5ri2k5ag6jlu5mb0"
+	metadata, err := anypb.New(&controlplanev1beta2.CreateClusterMetadata{ClusterId: clusterID})
+	require.NoError(t, err)
+
+	t.Run("failed", func(t *testing.T) {
+		got := operationModel(&controlplanev1beta2.Operation{
+			Id:         "op-1",
+			Type:       controlplanev1beta2.Operation_TYPE_CREATE_CLUSTER,
+			State:      controlplanev1beta2.Operation_STATE_FAILED,
+			ResourceId: &clusterID,
+			StartedAt:  timestamppb.New(started),
+			FinishedAt: timestamppb.New(started.Add(20 * time.Minute)),
+			Metadata:   metadata,
+			Result: &controlplanev1beta2.Operation_Error{
+				Error: &rpcstatus.Status{Code: int32(grpccodes.ResourceExhausted), Message: "quota exceeded"},
+			},
+		})
+		assert.Equal(t, types.StringValue("create_cluster"), got.Type)
+		assert.Equal(t, types.StringValue("failed"), got.State)
+		assert.Equal(t, types.StringValue("2024-09-01T10:20:00Z"), got.FinishedAt)
+		assert.Equal(t, types.StringValue("ResourceExhausted"), got.ErrorCode)
+		assert.Equal(t, types.StringValue("quota exceeded"), got.ErrorMessage)
+		assert.Contains(t, got.Metadata.ValueString(), clusterID)
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		got := operationModel(&controlplanev1beta2.Operation{
+			Id:        "op-2",
+			Type:      controlplanev1beta2.Operation_TYPE_UPDATE_CLUSTER,
+			State:     controlplanev1beta2.Operation_STATE_IN_PROGRESS,
+			StartedAt: timestamppb.New(started),
+		})
+		assert.Equal(t, models.Operation{
+			ID:           types.StringValue("op-2"),
+			Type:         types.StringValue("update_cluster"),
+			State:        types.StringValue("in_progress"),
+			ResourceID:   types.StringNull(),
+			StartedAt:    types.StringValue("2024-09-01T10:00:00Z"),
+			FinishedAt:   types.StringNull(),
+			ErrorCode:    types.StringNull(),
+			ErrorMessage: types.StringNull(),
+			Metadata:     types.StringNull(),
+		}, got)
+	})
+}
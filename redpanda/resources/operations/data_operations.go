@@ -0,0 +1,181 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package operations contains the implementation of the Operations data
+// source following the Terraform framework interfaces.
+package operations
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DataSourceOperations{}
+)
+
+// DataSourceOperations represents a data source for the operations in
+// progress in Redpanda Cloud.
+type DataSourceOperations struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// DataSourceOperationsSchema defines the schema for an Operations data source.
+func DataSourceOperationsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the operations on the resource with this ID, e.g. a cluster or a network ID",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return the operations of this type, one of " + strings.Join(operationTypes(), ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(operationTypes()...),
+				},
+			},
+			"operations": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the operation",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the operation",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "ID of the resource the operation applies to",
+						},
+						"started_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time the operation started at, in RFC 3339 format",
+						},
+					},
+				},
+				MarkdownDescription: "Operations in progress",
+			},
+		},
+		MarkdownDescription: "Data source for the operations in progress in Redpanda Cloud, such as cluster creations started from the console. Can be used to wait for these operations before making conflicting changes.",
+	}
+}
+
+// Metadata returns the metadata for the Operations data source.
+func (*DataSourceOperations) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_operations"
+}
+
+// Schema returns the schema for the Operations data source.
+func (*DataSourceOperations) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceOperationsSchema()
+}
+
+// Read reads the Operations data source's values and updates the state.
+func (r *DataSourceOperations) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.Operations
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ops, err := inProgressOperations(ctx, r.CpCl.Operation, model.Type.ValueString(), model.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list operations", err.Error())
+		return
+	}
+	model.Operations = ops
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// inProgressOperations lists the operations in progress, optionally filtered
+// by type and by resource ID.
+func inProgressOperations(ctx context.Context, client controlplanev1beta2grpc.OperationServiceClient, opType, resourceID string) ([]models.OperationsItem, error) {
+	filter := &controlplanev1beta2.ListOperationsRequest_Filter{State: controlplanev1beta2.Operation_STATE_IN_PROGRESS}
+	if opType != "" {
+		filter.TypeIn = []controlplanev1beta2.Operation_Type{stringToOperationType(opType)}
+	}
+	ops := []models.OperationsItem{}
+	var pageToken string
+	for {
+		list, err := client.ListOperations(ctx, &controlplanev1beta2.ListOperationsRequest{Filter: filter, PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range list.GetOperations() {
+			if resourceID != "" && op.GetResourceId() != resourceID {
+				continue
+			}
+			item := models.OperationsItem{
+				ID:         op.GetId(),
+				Type:       operationTypeToString(op.GetType()),
+				ResourceID: op.GetResourceId(),
+			}
+			if op.GetStartedAt() != nil {
+				item.StartedAt = op.GetStartedAt().AsTime().Format(time.RFC3339)
+			}
+			ops = append(ops, item)
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" || len(list.GetOperations()) == 0 {
+			return ops, nil
+		}
+	}
+}
+
+// operationTypes returns the names of the operation types, e.g. create_cluster.
+func operationTypes() []string {
+	var names []string
+	for v := range controlplanev1beta2.Operation_Type_name {
+		if t := controlplanev1beta2.Operation_Type(v); t != controlplanev1beta2.Operation_TYPE_UNSPECIFIED {
+			names = append(names, operationTypeToString(t))
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+func operationTypeToString(t controlplanev1beta2.Operation_Type) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "TYPE_"))
+}
+
+func stringToOperationType(s string) controlplanev1beta2.Operation_Type {
+	return controlplanev1beta2.Operation_Type(controlplanev1beta2.Operation_Type_value["TYPE_"+strings.ToUpper(s)])
+}
+
+// Configure uses provider level data to configure DataSourceOperations client.
+func (r *DataSourceOperations) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
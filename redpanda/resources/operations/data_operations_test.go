@@ -0,0 +1,82 @@
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestInProgressOperations(t *testing.T) {
+	started := time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC)
+	clusterID, networkID := "cr3g5ri2k5ag6jlu5mb0", "cr3g5cq2k5ag6jlu5ma0"
+	page1 := &controlplanev1beta2.ListOperationsResponse{
+		Operations: []*controlplanev1beta2.Operation{
+			{Id: "op-1", Type: controlplanev1beta2.Operation_TYPE_UPDATE_CLUSTER, ResourceId: &clusterID, StartedAt: timestamppb.New(started)},
+			{Id: "op-2", Type: controlplanev1beta2.Operation_TYPE_CREATE_NETWORK, ResourceId: &networkID},
+		},
+		NextPageToken: "next",
+	}
+	page2 := &controlplanev1beta2.ListOperationsResponse{
+		Operations: []*controlplanev1beta2.Operation{
+			{Id: "op-3", Type: controlplanev1beta2.Operation_TYPE_DELETE_CLUSTER, ResourceId: &clusterID},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		opType     string
+		resourceID string
+		wantTypes  []controlplanev1beta2.Operation_Type
+		want       []string
+	}{
+		{name: "all", want: []string{"op-1", "op-2", "op-3"}},
+		{name: "by resource", resourceID: clusterID, want: []string{"op-1", "op-3"}},
+		{name: "by type", opType: "update_cluster", wantTypes: []controlplanev1beta2.Operation_Type{controlplanev1beta2.Operation_TYPE_UPDATE_CLUSTER}, want: []string{"op-1", "op-2", "op-3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockOperationServiceClient(ctrl)
+			gomock.InOrder(
+				client.EXPECT().ListOperations(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListOperationsRequest, _ ...any) (*controlplanev1beta2.ListOperationsResponse, error) {
+					assert.Equal(t, controlplanev1beta2.Operation_STATE_IN_PROGRESS, req.Filter.State)
+					assert.Equal(t, tt.wantTypes, req.Filter.TypeIn)
+					assert.Empty(t, req.PageToken)
+					return page1, nil
+				}),
+				client.EXPECT().ListOperations(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListOperationsRequest, _ ...any) (*controlplanev1beta2.ListOperationsResponse, error) {
+					assert.Equal(t, "next", req.PageToken)
+					return page2, nil
+				}),
+			)
+
+			got, err := inProgressOperations(context.Background(), client, tt.opType, tt.resourceID)
+			require.NoError(t, err)
+			var ids []string
+			for _, op := range got {
+				ids = append(ids, op.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+			if tt.resourceID == "" {
+				assert.Equal(t, models.OperationsItem{ID: "op-1", Type: "update_cluster", ResourceID: clusterID, StartedAt: "2024-09-01T10:00:00Z"}, got[0])
+			}
+		})
+	}
+}
+
+func TestOperationTypes(t *testing.T) {
+	assert.Contains(t, operationTypes(), "create_cluster")
+	assert.NotContains(t, operationTypes(), "unspecified")
+	for _, name := range operationTypes() {
+		assert.Equal(t, name, operationTypeToString(stringToOperationType(name)))
+	}
+}
@@ -47,21 +47,21 @@ func DataSourceRegionSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"cloud_provider": schema.StringAttribute{
-				Required:    true,
-				Description: "Cloud provider where the region exists",
-				Validators:  validators.CloudProviders(),
+				Required:            true,
+				MarkdownDescription: "Cloud provider where the region exists",
+				Validators:          validators.CloudProviders(),
 			},
 			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "Name of the region",
+				Required:            true,
+				MarkdownDescription: "Name of the region",
 			},
 			"zones": schema.ListAttribute{
-				ElementType: types.StringType,
-				Computed:    true,
-				Description: "Zones available in the region",
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Zones available in the region",
 			},
 		},
-		Description: "Data source for a Redpanda Cloud region",
+		MarkdownDescription: "Data source for a Redpanda Cloud region",
 	}
 }
 
@@ -111,16 +111,8 @@ func (r *DataSourceRegion) Read(ctx context.Context, req datasource.ReadRequest,
 
 // Configure uses provider level data to configure DataSourceRegion client.
 func (r *DataSourceRegion) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
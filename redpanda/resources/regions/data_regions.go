@@ -19,7 +19,6 @@ package regions
 
 import (
 	"context"
-	"fmt"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -49,29 +48,29 @@ func DataSourceRegionsSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"cloud_provider": schema.StringAttribute{
-				Required:    true,
-				Description: "Cloud provider where the regions exist",
-				Validators:  validators.CloudProviders(),
+				Required:            true,
+				MarkdownDescription: "Cloud provider where the regions exist",
+				Validators:          validators.CloudProviders(),
 			},
 			"regions": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Name of the region",
+							Computed:            true,
+							MarkdownDescription: "Name of the region",
 						},
 						"zones": schema.ListAttribute{
-							ElementType: types.StringType,
-							Computed:    true,
-							Description: "Zones available in the region",
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "Zones available in the region",
 						},
 					},
 				},
-				Description: "Regions available for the cloud provider",
+				MarkdownDescription: "Regions available for the cloud provider",
 			},
 		},
-		Description: "Data source for a list of Redpanda Cloud regions",
+		MarkdownDescription: "Data source for a list of Redpanda Cloud regions",
 	}
 }
 
@@ -124,16 +123,8 @@ func (r *DataSourceRegions) Read(ctx context.Context, req datasource.ReadRequest
 
 // Configure uses provider level data to configure DataSourceRegions client.
 func (r *DataSourceRegions) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -17,7 +17,6 @@ package resourcegroup
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -54,17 +53,17 @@ func datasourceResourceGroupSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Computed:    true,
-				Optional:    true,
-				Description: "UUID of the resource group",
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "UUID of the resource group. Set either `id` or `name` to look up a resource group, or neither when there is only one.",
 			},
 			"name": schema.StringAttribute{
-				Computed:    true,
-				Optional:    true,
-				Description: "Name of the resource group",
+				Computed:            true,
+				Optional:            true,
+				MarkdownDescription: "Name of the resource group. Use it to look up the ID of a resource group instead of hardcoding it in clusters and networks.",
 			},
 		},
-		Description: "Data source for a Redpanda Cloud resource group",
+		MarkdownDescription: "Data source for a Redpanda Cloud resource group",
 	}
 }
 
@@ -72,6 +71,9 @@ func datasourceResourceGroupSchema() schema.Schema {
 func (n *DataSourceResourceGroup) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var model models.ResourceGroup
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	rg, err := n.CpCl.ResourceGroupForIDOrName(ctx, model.ID.ValueString(), model.Name.ValueString())
 	if err != nil {
@@ -86,16 +88,8 @@ func (n *DataSourceResourceGroup) Read(ctx context.Context, req datasource.ReadR
 
 // Configure uses provider level data to configure DataSourceResourceGroup client.
 func (n *DataSourceResourceGroup) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	n.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -0,0 +1,76 @@
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceResourceGroupReadByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		groups     []*controlplanev1beta2.ResourceGroup
+		wantID     string
+		wantErrMsg string
+	}{
+		{
+			name: "unique name",
+			groups: []*controlplanev1beta2.ResourceGroup{
+				{Id: "rg-1", Name: "prod"},
+				{Id: "rg-2", Name: "prod-eu"},
+			},
+			wantID: "rg-1",
+		},
+		{
+			name:       "unknown name",
+			groups:     []*controlplanev1beta2.ResourceGroup{},
+			wantErrMsg: "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockResourceGroupServiceClient(ctrl)
+			client.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{ResourceGroups: tt.groups}, nil)
+			d := &DataSourceResourceGroup{CpCl: &cloud.ControlPlaneClientSet{ResourceGroup: client}}
+
+			s := datasourceResourceGroupSchema()
+			cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, nil),
+				"name": tftypes.NewValue(tftypes.String, "prod"),
+			})}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+			d.Read(ctx, datasource.ReadRequest{Config: cfg}, resp)
+			if tt.wantErrMsg != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErrMsg)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var model models.ResourceGroup
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+			assert.Equal(t, models.ResourceGroup{Name: types.StringValue("prod"), ID: types.StringValue(tt.wantID)}, model)
+		})
+	}
+}
+
+func TestDataSourceNamespaceSchema(t *testing.T) {
+	resp := &datasource.SchemaResponse{}
+	(&DataSourceNamespace{}).Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	require.False(t, resp.Schema.ValidateImplementation(context.Background()).HasError())
+	assert.NotEmpty(t, resp.Schema.DeprecationMessage)
+	assert.Equal(t, datasourceResourceGroupSchema().Type(), resp.Schema.Type())
+}
@@ -0,0 +1,99 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package resourcegroup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// namespaceTypeName is the former name of the resource group resource, from
+// before the API renamed namespaces to resource groups.
+const namespaceTypeName = "redpanda_namespace"
+
+// Namespace is the deprecated redpanda_namespace resource. It manages a
+// resource group exactly like ResourceGroup, so that existing configurations
+// keep working until they are moved to redpanda_resource_group.
+type Namespace struct {
+	ResourceGroup
+}
+
+// Metadata returns the full name of the Namespace resource.
+func (*Namespace) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = namespaceTypeName
+}
+
+// Schema returns the schema for the Namespace resource.
+func (*Namespace) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = namespaceSchema()
+}
+
+func namespaceSchema() schema.Schema {
+	s := resourceGroupSchema()
+	s.MarkdownDescription = "A Redpanda Cloud namespace, now called a resource group"
+	s.DeprecationMessage = "redpanda_namespace is deprecated, use redpanda_resource_group instead. Existing namespaces " +
+		"can be moved without being replaced with a moved block from redpanda_namespace to redpanda_resource_group."
+	return s
+}
+
+// DataSourceNamespace is the deprecated redpanda_namespace data source. It
+// looks up a resource group exactly like DataSourceResourceGroup.
+type DataSourceNamespace struct {
+	DataSourceResourceGroup
+}
+
+// Metadata returns the full name of the Namespace data source.
+func (*DataSourceNamespace) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = namespaceTypeName
+}
+
+// Schema returns the schema for the Namespace data source.
+func (*DataSourceNamespace) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	s := datasourceResourceGroupSchema()
+	s.MarkdownDescription = "Data source for a Redpanda Cloud namespace, now called a resource group"
+	s.DeprecationMessage = "The redpanda_namespace data source is deprecated, use redpanda_resource_group instead."
+	resp.Schema = s
+}
+
+// MoveState allows namespaces to be moved to resource groups with a moved
+// block, which requires Terraform 1.8 or later.
+func (*ResourceGroup) MoveState(_ context.Context) []resource.StateMover {
+	source := namespaceSchema()
+	return []resource.StateMover{
+		{
+			SourceSchema: &source,
+			StateMover:   moveNamespaceState,
+		},
+	}
+}
+
+func moveNamespaceState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != namespaceTypeName || !strings.HasSuffix(req.SourceProviderAddress, "redpanda-data/redpanda") {
+		// not a namespace, let other movers or the framework handle it
+		return
+	}
+	var model models.ResourceGroup
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, model)...)
+}
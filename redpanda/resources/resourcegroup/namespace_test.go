@@ -0,0 +1,67 @@
+package resourcegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resourceGroupState(ctx context.Context, t *testing.T, s schema.Schema) *tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, models.ResourceGroup{
+		Name: types.StringValue("default"),
+		ID:   types.StringValue("0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e"),
+	})
+	require.False(t, diags.HasError(), diags)
+	return &state
+}
+
+func TestNamespaceSchema(t *testing.T) {
+	s := namespaceSchema()
+	require.False(t, s.ValidateImplementation(context.Background()).HasError())
+	assert.NotEmpty(t, s.DeprecationMessage)
+	assert.Equal(t, resourceGroupSchema().Type(), s.Type())
+}
+
+func TestMoveNamespaceState(t *testing.T) {
+	tests := []struct {
+		name     string
+		typeName string
+		provider string
+		wantMove bool
+	}{
+		{name: "namespace", typeName: "redpanda_namespace", provider: "registry.terraform.io/redpanda-data/redpanda", wantMove: true},
+		{name: "other type", typeName: "redpanda_network", provider: "registry.terraform.io/redpanda-data/redpanda"},
+		{name: "other provider", typeName: "redpanda_namespace", provider: "registry.terraform.io/example/redpanda"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			target := resourceGroupSchema()
+			resp := &resource.MoveStateResponse{TargetState: tfsdk.State{Schema: target, Raw: tftypes.NewValue(target.Type().TerraformType(ctx), nil)}}
+			moveNamespaceState(ctx, resource.MoveStateRequest{
+				SourceTypeName:        tt.typeName,
+				SourceProviderAddress: tt.provider,
+				SourceState:           resourceGroupState(ctx, t, namespaceSchema()),
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if !tt.wantMove {
+				assert.True(t, resp.TargetState.Raw.IsNull())
+				return
+			}
+			var got models.ResourceGroup
+			require.False(t, resp.TargetState.Get(ctx, &got).HasError())
+			assert.Equal(t, "default", got.Name.ValueString())
+			assert.Equal(t, "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e", got.ID.ValueString())
+		})
+	}
+}
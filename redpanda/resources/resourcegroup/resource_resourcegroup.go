@@ -20,6 +20,7 @@ package resourcegroup
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -39,11 +40,16 @@ var (
 	_ resource.Resource                = &ResourceGroup{}
 	_ resource.ResourceWithConfigure   = &ResourceGroup{}
 	_ resource.ResourceWithImportState = &ResourceGroup{}
+	_ resource.ResourceWithMoveState   = &ResourceGroup{}
+	_ resource.ResourceWithModifyPlan  = &ResourceGroup{}
+	_ resource.ResourceWithImportState = &Namespace{}
 )
 
 // ResourceGroup represents a cluster managed resource.
 type ResourceGroup struct {
 	CpCl *cloud.ControlPlaneClientSet
+
+	preventDuplicateNames bool
 }
 
 // Metadata returns the full name of the ResourceGroup resource.
@@ -53,23 +59,12 @@ func (*ResourceGroup) Metadata(_ context.Context, _ resource.MetadataRequest, re
 
 // Configure uses provider level data to configure ResourceGroup client.
 func (n *ResourceGroup) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		// We can't add a diagnostic for an unset ProviderData here because
-		// during the early part of the terraform lifecycle, the provider data
-		// is not set and this is valid, but we also can't do anything until it
-		// is set.
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Resource)
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	n.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	n.preventDuplicateNames = p.PreventDuplicateNames
 }
 
 // Schema returns the schema for the ResourceGroup resource.
@@ -82,21 +77,29 @@ func resourceGroupSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required:      true,
-				Description:   "Name of the resource group. Changing the name of a resource group will result in a new resource group being created and the old one being destroyed",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Name of the resource group. Changing the name of a resource group will result in a new resource group being created and the old one being destroyed",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				Description:   "UUID of the resource group",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Computed:            true,
+				MarkdownDescription: "UUID of the resource group",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
-		Description: "A Redpanda Cloud resource group",
-		Version:     1,
+		MarkdownDescription: "A Redpanda Cloud resource group",
 	}
 }
 
+// ModifyPlan checks the names of new resource groups when
+// prevent_duplicate_names is set.
+func (n *ResourceGroup) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !n.preventDuplicateNames || n.CpCl == nil {
+		return
+	}
+	utils.PlanUniqueName(ctx, req, resp, "redpanda_resource_group", n.CpCl.ResourceGroupIDsForName)
+}
+
 // Create creates a new ResourceGroup resource. It updates the state if the
 // resource is successfully created.
 func (n *ResourceGroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -176,7 +179,18 @@ func (n *ResourceGroup) Delete(ctx context.Context, req resource.DeleteRequest,
 // ImportState refreshes the state with the correct ID for the ResourceGroup,
 // allowing TF to use Read to get the correct ResourceGroup name into state see
 // https://developer.hashicorp.com/terraform/plugin/framework/resources/import
-// for more details.
-func (*ResourceGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// for more details. The resource group can also be imported by name with a
+// name=<name> import ID.
+func (n *ResourceGroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, byName := strings.CutPrefix(req.ID, "name=")
+	if !byName {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+	rg, err := n.CpCl.ResourceGroupForName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to import resource group %q", name), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rg.GetId())...)
 }
@@ -4,7 +4,17 @@ import (
 	"context"
 	"testing"
 
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestResourceGroupSchema(t *testing.T) {
@@ -23,3 +33,71 @@ func TestValidateSchema(t *testing.T) {
 		t.Errorf("Unexpected error in schema: %s", d)
 	}
 }
+
+func TestImportStateByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		groups     []*controlplanev1beta2.ResourceGroup
+		wantID     string
+		wantErrMsg string
+	}{
+		{
+			name:   "import by id",
+			id:     "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e",
+			wantID: "0b7d5d5a-3f7c-4a6e-9a43-7c0d4f6f6b1e",
+		},
+		{
+			name: "import by name",
+			id:   "name=prod",
+			groups: []*controlplanev1beta2.ResourceGroup{
+				{Id: "rg-1", Name: "prod"},
+				{Id: "rg-2", Name: "prod-eu"},
+			},
+			wantID: "rg-1",
+		},
+		{
+			name: "ambiguous name",
+			id:   "name=prod",
+			groups: []*controlplanev1beta2.ResourceGroup{
+				{Id: "rg-1", Name: "prod"},
+				{Id: "rg-2", Name: "prod"},
+			},
+			wantErrMsg: "rg-1, rg-2",
+		},
+		{
+			name:       "unknown name",
+			id:         "name=prod",
+			groups:     []*controlplanev1beta2.ResourceGroup{},
+			wantErrMsg: "not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			client := mocks.NewMockResourceGroupServiceClient(ctrl)
+			if tt.groups != nil {
+				client.EXPECT().ListResourceGroups(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListResourceGroupsResponse{ResourceGroups: tt.groups}, nil)
+			}
+			rg := &ResourceGroup{CpCl: &cloud.ControlPlaneClientSet{ResourceGroup: client}}
+
+			s := resourceGroupSchema()
+			resp := &resource.ImportStateResponse{State: tfsdk.State{
+				Schema: s,
+				Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+			}}
+			rg.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+			if tt.wantErrMsg != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantErrMsg)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			assert.Equal(t, tt.wantID, id.ValueString())
+		})
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package role contains the implementation of the Role and RoleAssignment
+// resources following the Terraform framework interfaces.
+package role
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Role{}
+	_ resource.ResourceWithConfigure   = &Role{}
+	_ resource.ResourceWithImportState = &Role{}
+)
+
+// Role represents the Role Terraform resource, a role of a cluster that ACLs
+// and users can be bound to.
+type Role struct {
+	SecurityClient consolev1alpha1grpc.SecurityServiceClient
+
+	resData       config.Resource
+	dataplaneConn *grpc.ClientConn
+}
+
+// Metadata returns the metadata for the Role resource.
+func (*Role) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "redpanda_role"
+}
+
+// Configure configures the Role resource.
+func (r *Role) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	r.resData = p
+}
+
+// Schema returns the schema for the Role resource.
+func (*Role) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resourceRoleSchema()
+}
+
+func resourceRoleSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Role of a cluster. ACLs bound to the principal RedpandaRole:<name> apply to every user assigned to the role with redpanda_role_assignment.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the role.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"delete_acls": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set to true, destroying the role also deletes the ACLs bound to it.",
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
+					"change this value unless you are planning to do state imports",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the role, its name.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create creates the role.
+func (r *Role) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var model models.Role
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer r.closeConn()
+	_, err := r.SecurityClient.CreateRole(ctx, &consolev1alpha1.CreateRoleRequest{
+		Role: &consolev1alpha1.Role{Name: model.Name.ValueString()},
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to create role %q", model.Name.ValueString()), err.Error())
+		return
+	}
+	model.ID = model.Name
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Read checks that the role still exists.
+func (r *Role) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var model models.Role
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer r.closeConn()
+	_, err := r.SecurityClient.GetRole(ctx, &consolev1alpha1.GetRoleRequest{RoleName: model.Name.ValueString()})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("failed to read role %q", model.Name.ValueString()), err.Error())
+		return
+	}
+	model.ID = model.Name
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Update only updates delete_acls, which is not stored by the cluster.
+func (*Role) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan models.Role
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the role, and the ACLs bound to it when delete_acls is set.
+func (r *Role) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var model models.Role
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer r.closeConn()
+	_, err := r.SecurityClient.DeleteRole(ctx, &consolev1alpha1.DeleteRoleRequest{
+		RoleName:   model.Name.ValueString(),
+		DeleteAcls: model.DeleteACLs.ValueBool(),
+	})
+	if err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to delete role %q", model.Name.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports the state of the Role resource from an ID of the form
+// <cluster_id>/<role_name>.
+func (r *Role) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	clusterID, name, err := utils.ParseClusterImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", request.ID), "ADDR ID format is <cluster_id>/<role_name>")
+		return
+	}
+	clusterURL, err := clusterAPIURL(ctx, r.resData, clusterID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<role_name>", clusterID), err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), types.StringValue(name))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), types.StringValue(name))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(clusterURL))...)
+}
+
+func (r *Role) createSecurityClient(clusterURL string) error {
+	if r.SecurityClient != nil { // Client already started, no need to create another one.
+		return nil
+	}
+	conn, err := spawnConn(r.resData, r.dataplaneConn, clusterURL)
+	if err != nil {
+		return err
+	}
+	r.dataplaneConn = conn
+	r.SecurityClient = consolev1alpha1grpc.NewSecurityServiceClient(conn)
+	return nil
+}
+
+func (r *Role) closeConn() {
+	if r.dataplaneConn != nil {
+		r.dataplaneConn.Close()
+	}
+}
+
+// spawnConn returns conn, or a new connection to the cluster API if conn is
+// nil.
+func spawnConn(resData config.Resource, conn *grpc.ClientConn, clusterURL string) (*grpc.ClientConn, error) {
+	if conn != nil {
+		return conn, nil
+	}
+	conn, err := cloud.SpawnConn(clusterURL, resData.AuthToken, resData.Proxy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open a connection with the cluster API: %v", err)
+	}
+	return conn, nil
+}
+
+// clusterAPIURL returns the cluster API URL of the cluster.
+func clusterAPIURL(ctx context.Context, resData config.Resource, clusterID string) (string, error) {
+	cluster, err := cloud.NewControlPlaneClientSet(resData.ControlPlaneConnection).ClusterForID(ctx, clusterID)
+	if err != nil {
+		return "", err
+	}
+	return cluster.GetDataplaneApi().GetUrl(), nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Assignment{}
+	_ resource.ResourceWithConfigure   = &Assignment{}
+	_ resource.ResourceWithImportState = &Assignment{}
+)
+
+// Assignment represents the RoleAssignment Terraform resource, the membership
+// of a user in a role.
+type Assignment struct {
+	SecurityClient consolev1alpha1grpc.SecurityServiceClient
+
+	resData       config.Resource
+	dataplaneConn *grpc.ClientConn
+}
+
+// Metadata returns the metadata for the RoleAssignment resource.
+func (*Assignment) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "redpanda_role_assignment"
+}
+
+// Configure configures the RoleAssignment resource.
+func (a *Assignment) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	a.resData = p
+}
+
+// Schema returns the schema for the RoleAssignment resource.
+func (*Assignment) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resourceAssignmentSchema()
+}
+
+func resourceAssignmentSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Assigns a user of a cluster to a role, granting the user the ACLs bound to the role.",
+		Attributes: map[string]schema.Attribute{
+			"role_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the role.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"principal": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the SCRAM user assigned to the role, e.g. the name of a redpanda_user.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
+					"change this value unless you are planning to do state imports",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the assignment, of the form <role_name>,<principal>.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create assigns the principal to the role.
+func (a *Assignment) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var model models.RoleAssignment
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer a.closeConn()
+	_, err := a.SecurityClient.UpdateRoleMembership(ctx, &consolev1alpha1.UpdateRoleMembershipRequest{
+		RoleName: model.RoleName.ValueString(),
+		Add:      []*consolev1alpha1.RoleMembership{{Principal: model.Principal.ValueString()}},
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to assign %q to role %q", model.Principal.ValueString(), model.RoleName.ValueString()), err.Error())
+		return
+	}
+	model.ID = types.StringValue(assignmentID(model))
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Read checks that the principal is still a member of the role.
+func (a *Assignment) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var model models.RoleAssignment
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer a.closeConn()
+	role, err := a.SecurityClient.GetRole(ctx, &consolev1alpha1.GetRoleRequest{RoleName: model.RoleName.ValueString()})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("failed to read role %q", model.RoleName.ValueString()), err.Error())
+		return
+	}
+	if !isMember(role.GetMembers(), model.Principal.ValueString()) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+	model.ID = types.StringValue(assignmentID(model))
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Update is not supported: every attribute requires a replacement.
+func (*Assignment) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan models.RoleAssignment
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+// Delete removes the principal from the role.
+func (a *Assignment) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var model models.RoleAssignment
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := a.createSecurityClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create security client", err.Error())
+		return
+	}
+	defer a.closeConn()
+	_, err := a.SecurityClient.UpdateRoleMembership(ctx, &consolev1alpha1.UpdateRoleMembershipRequest{
+		RoleName: model.RoleName.ValueString(),
+		Remove:   []*consolev1alpha1.RoleMembership{{Principal: model.Principal.ValueString()}},
+	})
+	if err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to remove %q from role %q", model.Principal.ValueString(), model.RoleName.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports the state of the RoleAssignment resource from an ID of
+// the form <cluster_id>/<role_name>,<principal>.
+func (a *Assignment) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	clusterID, key, err := utils.ParseClusterImportID(request.ID)
+	roleName, principal, ok := strings.Cut(key, ",")
+	if err != nil || !ok || roleName == "" || principal == "" {
+		response.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", request.ID), "ADDR ID format is <cluster_id>/<role_name>,<principal>")
+		return
+	}
+	clusterURL, err := clusterAPIURL(ctx, a.resData, clusterID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<role_name>,<principal>", clusterID), err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("role_name"), types.StringValue(roleName))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("principal"), types.StringValue(principal))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), types.StringValue(roleName+","+principal))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(clusterURL))...)
+}
+
+func (a *Assignment) createSecurityClient(clusterURL string) error {
+	if a.SecurityClient != nil { // Client already started, no need to create another one.
+		return nil
+	}
+	conn, err := spawnConn(a.resData, a.dataplaneConn, clusterURL)
+	if err != nil {
+		return err
+	}
+	a.dataplaneConn = conn
+	a.SecurityClient = consolev1alpha1grpc.NewSecurityServiceClient(conn)
+	return nil
+}
+
+func (a *Assignment) closeConn() {
+	if a.dataplaneConn != nil {
+		a.dataplaneConn.Close()
+	}
+}
+
+// assignmentID returns the ID of the assignment.
+func assignmentID(model models.RoleAssignment) string {
+	return model.RoleName.ValueString() + "," + model.Principal.ValueString()
+}
+
+// isMember reports whether principal is one of the members of a role.
+func isMember(members []*consolev1alpha1.RoleMembership, principal string) bool {
+	for _, m := range members {
+		if m.GetPrincipal() == principal {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package role
+
+import (
+	"context"
+	"testing"
+
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateSchema(t *testing.T) {
+	ctx := context.Background()
+	if d := resourceRoleSchema().ValidateImplementation(ctx); d.HasError() {
+		t.Errorf("Unexpected error in role schema: %s", d)
+	}
+	if d := resourceAssignmentSchema().ValidateImplementation(ctx); d.HasError() {
+		t.Errorf("Unexpected error in role assignment schema: %s", d)
+	}
+}
+
+func TestRoleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockSecurityServiceClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().CreateRole(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *consolev1alpha1.CreateRoleRequest, _ ...any) (*consolev1alpha1.CreateRoleResponse, error) {
+			assert.Equal(t, "analysts", req.GetRole().GetName())
+			return &consolev1alpha1.CreateRoleResponse{Role: req.GetRole()}, nil
+		}),
+		client.EXPECT().GetRole(gomock.Any(), gomock.Any()).
+			Return(&consolev1alpha1.GetRoleResponse{Role: &consolev1alpha1.Role{Name: "analysts"}}, nil),
+		client.EXPECT().DeleteRole(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *consolev1alpha1.DeleteRoleRequest, _ ...any) (*consolev1alpha1.DeleteRoleResponse, error) {
+			assert.Equal(t, "analysts", req.GetRoleName())
+			assert.False(t, req.GetDeleteAcls())
+			return &consolev1alpha1.DeleteRoleResponse{}, nil
+		}),
+		client.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "role not found")),
+	)
+	r := &Role{SecurityClient: client}
+
+	s := resourceRoleSchema()
+	objType := s.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"name":            tftypes.NewValue(tftypes.String, "analysts"),
+		"delete_acls":     tftypes.NewValue(tftypes.Bool, nil),
+		"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		"id":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: raw}}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Raw.IsNull())
+
+	deleteResp := &resource.DeleteResponse{State: createResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+
+	// A role deleted outside of Terraform is removed from state.
+	readResp = &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
+
+func TestAssignmentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockSecurityServiceClient(ctrl)
+	role := func(members ...string) *consolev1alpha1.GetRoleResponse {
+		resp := &consolev1alpha1.GetRoleResponse{Role: &consolev1alpha1.Role{Name: "analysts"}}
+		for _, m := range members {
+			resp.Members = append(resp.Members, &consolev1alpha1.RoleMembership{Principal: m})
+		}
+		return resp
+	}
+	gomock.InOrder(
+		client.EXPECT().UpdateRoleMembership(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *consolev1alpha1.UpdateRoleMembershipRequest, _ ...any) (*consolev1alpha1.UpdateRoleMembershipResponse, error) {
+			assert.Equal(t, "analysts", req.GetRoleName())
+			assert.Equal(t, []string{"alice"}, principals(req.GetAdd()))
+			assert.Empty(t, req.GetRemove())
+			return &consolev1alpha1.UpdateRoleMembershipResponse{RoleName: req.GetRoleName()}, nil
+		}),
+		client.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(role("bob", "alice"), nil),
+		client.EXPECT().UpdateRoleMembership(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *consolev1alpha1.UpdateRoleMembershipRequest, _ ...any) (*consolev1alpha1.UpdateRoleMembershipResponse, error) {
+			assert.Empty(t, req.GetAdd())
+			assert.Equal(t, []string{"alice"}, principals(req.GetRemove()))
+			return &consolev1alpha1.UpdateRoleMembershipResponse{RoleName: req.GetRoleName()}, nil
+		}),
+		client.EXPECT().GetRole(gomock.Any(), gomock.Any()).Return(role("bob"), nil),
+	)
+	a := &Assignment{SecurityClient: client}
+
+	s := resourceAssignmentSchema()
+	objType := s.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"role_name":       tftypes.NewValue(tftypes.String, "analysts"),
+		"principal":       tftypes.NewValue(tftypes.String, "alice"),
+		"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		"id":              tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	a.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: raw}}, createResp)
+	require.False(t, createResp.Diagnostics.HasError(), createResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	a.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.False(t, readResp.State.Raw.IsNull())
+
+	deleteResp := &resource.DeleteResponse{State: createResp.State}
+	a.Delete(ctx, resource.DeleteRequest{State: createResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), deleteResp.Diagnostics)
+
+	// A user removed from the role outside of Terraform is removed from state.
+	readResp = &resource.ReadResponse{State: createResp.State}
+	a.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), readResp.Diagnostics)
+	assert.True(t, readResp.State.Raw.IsNull())
+}
+
+func principals(members []*consolev1alpha1.RoleMembership) []string {
+	var out []string
+	for _, m := range members {
+		out = append(out, m.GetPrincipal())
+	}
+	return out
+}
@@ -0,0 +1,264 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package schemaregistry contains the implementation of the resources managed
+// through the Schema Registry API of a cluster, following the Terraform
+// framework interfaces.
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const contentType = "application/vnd.schemaregistry.v1+json"
+
+// Client is a minimal client of the Schema Registry HTTP API.
+type Client struct {
+	url      string
+	http     *http.Client
+	username string
+	password string
+	token    string
+}
+
+// NewClient returns a Client for the Schema Registry at rawURL. Requests use
+// basic authentication when username is set, and the token otherwise.
+func NewClient(rawURL string, httpClient *http.Client, username, password, token string) *Client {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	return &Client{
+		url:      strings.TrimSuffix(rawURL, "/"),
+		http:     httpClient,
+		username: username,
+		password: password,
+		token:    token,
+	}
+}
+
+// Error is an error returned by the Schema Registry API.
+type Error struct {
+	StatusCode int    `json:"-"`
+	Code       int    `json:"error_code"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("schema registry returned HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("schema registry returned HTTP %d: %s (code %d)", e.StatusCode, e.Message, e.Code)
+}
+
+// IsNotFound reports whether err is a Schema Registry not found error.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// SubjectSchema is a version of the schema of a subject.
+type SubjectSchema struct {
+	Subject    string `json:"subject"`
+	ID         int64  `json:"id"`
+	Version    int64  `json:"version"`
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+type schemaRequest struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+// Register registers the schema under the subject and returns the registered
+// version. Registering a schema that already exists under the subject returns
+// the existing version.
+func (c *Client) Register(ctx context.Context, subject, schema, schemaType string) (*SubjectSchema, error) {
+	req := schemaRequest{Schema: schema, SchemaType: wireSchemaType(schemaType)}
+	if err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject)+"/versions", req, nil); err != nil {
+		return nil, err
+	}
+	var out SubjectSchema
+	if err := c.do(ctx, http.MethodPost, "/subjects/"+url.PathEscape(subject), req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Subjects returns the names of the subjects of the Schema Registry, in the
+// order returned by the Schema Registry.
+func (c *Client) Subjects(ctx context.Context) ([]string, error) {
+	var out []string
+	if err := c.do(ctx, http.MethodGet, "/subjects", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LatestVersion returns the latest version of the schema of the subject.
+func (c *Client) LatestVersion(ctx context.Context, subject string) (*SubjectSchema, error) {
+	var out SubjectSchema
+	if err := c.do(ctx, http.MethodGet, "/subjects/"+url.PathEscape(subject)+"/versions/latest", nil, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Versions returns the versions of the subject that are not soft deleted, in
+// ascending order.
+func (c *Client) Versions(ctx context.Context, subject string) ([]int64, error) {
+	var out []int64
+	if err := c.do(ctx, http.MethodGet, "/subjects/"+url.PathEscape(subject)+"/versions", nil, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteVersion soft deletes a version of the subject.
+func (c *Client) DeleteVersion(ctx context.Context, subject string, version int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/subjects/%s/versions/%d", url.PathEscape(subject), version), nil, nil)
+}
+
+// DeleteSubject deletes all the versions of the subject. The subject is soft
+// deleted first, as the Schema Registry requires before a permanent deletion.
+func (c *Client) DeleteSubject(ctx context.Context, subject string) error {
+	p := "/subjects/" + url.PathEscape(subject)
+	if err := c.do(ctx, http.MethodDelete, p, nil, nil); err != nil && !IsNotFound(err) {
+		return err
+	}
+	if err := c.do(ctx, http.MethodDelete, p+"?permanent=true", nil, nil); err != nil && !IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// configPath returns the path of the configuration of the subject, or of the
+// global configuration if subject is empty.
+func configPath(subject string) string {
+	if subject == "" {
+		return "/config"
+	}
+	return "/config/" + url.PathEscape(subject)
+}
+
+// Compatibility returns the compatibility level of the subject, or the global
+// compatibility level if subject is empty. The level of a subject without its
+// own level is reported as not found rather than falling back to the global
+// level.
+func (c *Client) Compatibility(ctx context.Context, subject string) (string, error) {
+	p := configPath(subject)
+	if subject != "" {
+		p += "?defaultToGlobal=false"
+	}
+	var out struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}
+	if err := c.do(ctx, http.MethodGet, p, nil, &out); err != nil {
+		return "", err
+	}
+	return out.CompatibilityLevel, nil
+}
+
+// SetCompatibility sets the compatibility level of the subject, or the global
+// compatibility level if subject is empty.
+func (c *Client) SetCompatibility(ctx context.Context, subject, level string) error {
+	req := struct {
+		Compatibility string `json:"compatibility"`
+	}{level}
+	return c.do(ctx, http.MethodPut, configPath(subject), req, nil)
+}
+
+// DeleteCompatibility removes the compatibility level of the subject, which
+// then falls back to the global compatibility level.
+func (c *Client) DeleteCompatibility(ctx context.Context, subject string) error {
+	err := c.do(ctx, http.MethodDelete, configPath(subject), nil, nil)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", contentType)
+	if in != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	switch {
+	case c.username != "":
+		req.SetBasicAuth(c.username, c.password)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to reach the schema registry: %v", err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read the schema registry response: %v", err)
+	}
+	if resp.StatusCode >= 300 {
+		e := &Error{StatusCode: resp.StatusCode}
+		_ = json.Unmarshal(b, e)
+		return e
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("unable to decode the schema registry response: %v", err)
+	}
+	return nil
+}
+
+// wireSchemaType returns the schemaType sent to the Schema Registry, which
+// omits it for Avro schemas.
+func wireSchemaType(t string) string {
+	if strings.EqualFold(t, schemaTypeAvro) {
+		return ""
+	}
+	return strings.ToUpper(t)
+}
+
+// stateSchemaType returns the schema type reported by the Schema Registry,
+// which omits it for Avro schemas.
+func stateSchemaType(t string) string {
+	if t == "" {
+		return schemaTypeAvro
+	}
+	return t
+}
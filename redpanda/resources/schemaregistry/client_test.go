@@ -0,0 +1,123 @@
+package schemaregistry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientRegister(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "app", user)
+		assert.Equal(t, "secret", pass)
+		var body schemaRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "PROTOBUF", body.SchemaType)
+		switch r.URL.Path {
+		case "/subjects/orders-value/versions":
+			_, _ = w.Write([]byte(`{"id": 12}`))
+		case "/subjects/orders-value":
+			_, _ = w.Write([]byte(`{"subject": "orders-value", "id": 12, "version": 3, "schema": "syntax = \"proto3\";", "schemaType": "PROTOBUF"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "app", "secret", "token")
+	got, err := c.Register(context.Background(), "orders-value", `syntax = "proto3";`, "protobuf")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), got.ID)
+	assert.Equal(t, int64(3), got.Version)
+	assert.Equal(t, []string{"POST /subjects/orders-value/versions", "POST /subjects/orders-value"}, requests)
+}
+
+func TestClientErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/subjects/missing/versions/latest":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": 40401, "message": "Subject 'missing' not found."}`))
+		case "/subjects/orders-value/versions":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error_code": 409, "message": "Schema being registered is incompatible with an earlier schema"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "", "", "token")
+	_, err := c.LatestVersion(context.Background(), "missing")
+	assert.True(t, IsNotFound(err))
+
+	_, err = c.Register(context.Background(), "orders-value", "{}", "AVRO")
+	assert.False(t, IsNotFound(err))
+	assert.ErrorContains(t, err, "incompatible with an earlier schema")
+}
+
+func TestClientDeleteSubject(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.URL.RawQuery == "" {
+			// already soft deleted
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[1, 2]`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "", "", "")
+	require.NoError(t, c.DeleteSubject(context.Background(), "orders-value"))
+	assert.Equal(t, []string{"DELETE /subjects/orders-value", "DELETE /subjects/orders-value?permanent=true"}, requests)
+}
+
+func TestClientCompatibility(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/config":
+			_, _ = w.Write([]byte(`{"compatibilityLevel": "BACKWARD"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/config/unset":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error_code": 40408, "message": "Subject 'unset' does not have subject-level compatibility configured"}`))
+		case r.Method == http.MethodPut:
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "FULL_TRANSITIVE", body["compatibility"])
+			_, _ = w.Write([]byte(`{"compatibility": "FULL_TRANSITIVE"}`))
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, srv.Client(), "", "", "")
+	ctx := context.Background()
+	level, err := c.Compatibility(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, "BACKWARD", level)
+
+	_, err = c.Compatibility(ctx, "unset")
+	assert.True(t, IsNotFound(err))
+
+	require.NoError(t, c.SetCompatibility(ctx, "orders-value", "FULL_TRANSITIVE"))
+	require.NoError(t, c.DeleteCompatibility(ctx, "orders-value"))
+	assert.Equal(t, []string{
+		"GET /config",
+		"GET /config/unset?defaultToGlobal=false",
+		"PUT /config/orders-value",
+		"DELETE /config/orders-value",
+	}, requests)
+}
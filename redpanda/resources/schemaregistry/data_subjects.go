@@ -0,0 +1,168 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceSubjects{}
+	_ datasource.DataSourceWithConfigure = &DataSourceSubjects{}
+)
+
+// DataSourceSubjects represents a data source listing the subjects of the
+// Schema Registry of a cluster with their latest version.
+type DataSourceSubjects struct {
+	// Client is used instead of the cluster Schema Registry when set.
+	Client *Client
+
+	dsData config.Datasource
+}
+
+// Metadata returns the metadata for the Schema Registry subjects data source.
+func (*DataSourceSubjects) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "redpanda_schema_registry_subjects"
+}
+
+// Configure configures the Schema Registry subjects data source.
+func (d *DataSourceSubjects) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.dsData = p
+}
+
+// Schema returns the schema for the Schema Registry subjects data source.
+func (*DataSourceSubjects) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasourceSubjectsSchema()
+}
+
+func datasourceSubjectsSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Lists the subjects of the Schema Registry of a cluster with their latest version, e.g. to reference the ID of a schema registered outside of Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster whose Schema Registry holds the subjects.",
+			},
+			"subject_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the subjects whose name starts with this prefix.",
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the Schema Registry. When unset, the provider credentials are used.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the Schema Registry.",
+			},
+			"subjects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The subjects, ordered by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the subject.",
+						},
+						"version": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Latest version of the subject.",
+						},
+						"schema_id": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Global ID of the schema of the latest version.",
+						},
+						"schema_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the schema of the latest version: AVRO, PROTOBUF or JSON.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists the subjects and reads their latest version.
+func (d *DataSourceSubjects) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.SchemaRegistrySubjects
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client := d.Client
+	if client == nil {
+		var err error
+		client, err = clusterClient(ctx, d.dsData.ControlPlaneConnection, d.dsData.Proxy, d.dsData.AuthToken, model.ClusterID.ValueString(), model.Username.ValueString(), model.Password.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+			return
+		}
+	}
+	subjects, err := latestVersions(ctx, client, model.SubjectPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list the schema registry subjects", err.Error())
+		return
+	}
+	model.Subjects = subjects
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// latestVersions returns the latest version of the subjects whose name starts
+// with prefix, ordered by subject.
+func latestVersions(ctx context.Context, client *Client, prefix string) ([]models.SchemaRegistrySubjectsEntry, error) {
+	names, err := client.Subjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	subjects := []models.SchemaRegistrySubjectsEntry{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		latest, err := client.LatestVersion(ctx, name)
+		if err != nil {
+			if IsNotFound(err) {
+				// deleted since it was listed
+				continue
+			}
+			return nil, fmt.Errorf("unable to read subject %q: %w", name, err)
+		}
+		subjects = append(subjects, models.SchemaRegistrySubjectsEntry{
+			Subject:    types.StringValue(name),
+			Version:    types.Int64Value(latest.Version),
+			SchemaID:   types.Int64Value(latest.ID),
+			SchemaType: types.StringValue(stateSchemaType(latest.SchemaType)),
+		})
+	}
+	return subjects, nil
+}
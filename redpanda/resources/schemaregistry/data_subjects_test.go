@@ -0,0 +1,41 @@
+package schemaregistry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasourceSubjectsSchema(t *testing.T) {
+	require.False(t, datasourceSubjectsSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestLatestVersions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subjects":
+			_, _ = w.Write([]byte(`["payments-value", "orders-value", "orders-key", "deleted-value"]`))
+		case "/subjects/orders-key/versions/latest":
+			_, _ = w.Write([]byte(`{"subject": "orders-key", "id": 3, "version": 1, "schema": "\"string\""}`))
+		case "/subjects/orders-value/versions/latest":
+			_, _ = w.Write([]byte(`{"subject": "orders-value", "id": 12, "version": 4, "schema": "syntax = \"proto3\";", "schemaType": "PROTOBUF"}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	got, err := latestVersions(context.Background(), NewClient(srv.URL, srv.Client(), "", "", "token"), "orders-")
+	require.NoError(t, err)
+	assert.Equal(t, []models.SchemaRegistrySubjectsEntry{
+		{Subject: types.StringValue("orders-key"), Version: types.Int64Value(1), SchemaID: types.Int64Value(3), SchemaType: types.StringValue(schemaTypeAvro)},
+		{Subject: types.StringValue("orders-value"), Version: types.Int64Value(4), SchemaID: types.Int64Value(12), SchemaType: types.StringValue(schemaTypeProtobuf)},
+	}, got)
+}
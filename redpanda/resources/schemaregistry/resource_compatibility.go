@@ -0,0 +1,240 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// compatibilityLevels are the compatibility levels supported by the Schema
+// Registry.
+var compatibilityLevels = []string{
+	"NONE",
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Compatibility{}
+	_ resource.ResourceWithConfigure   = &Compatibility{}
+	_ resource.ResourceWithImportState = &Compatibility{}
+)
+
+// Compatibility represents the Schema Registry compatibility level Terraform
+// resource, either global or for a single subject.
+type Compatibility struct {
+	// Client is used instead of the cluster Schema Registry when set.
+	Client *Client
+
+	resData config.Resource
+}
+
+// Metadata returns the metadata for the Compatibility resource.
+func (*Compatibility) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_schema_registry_compatibility"
+}
+
+// Configure configures the Compatibility resource.
+func (c *Compatibility) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	c.resData = p
+}
+
+// Schema returns the schema for the Compatibility resource.
+func (*Compatibility) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceCompatibilitySchema()
+}
+
+func resourceCompatibilitySchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages the compatibility level of the Schema Registry of a cluster, either globally or for a single subject.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster whose Schema Registry is configured.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"subject": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Subject to set the compatibility level of. When unset, the global compatibility level is managed.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"level": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Compatibility level, one of " + strings.Join(compatibilityLevels, ", ") + ".",
+				Validators: []validator.String{
+					stringvalidator.OneOf(compatibilityLevels...),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the Schema Registry. When unset, the provider credentials are used.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the Schema Registry.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the compatibility setting, the subject and the cluster ID separated by a comma, or only the cluster ID for the global setting",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create sets the compatibility level.
+func (c *Compatibility) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.SchemaRegistryCompatibility
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c.set(ctx, model, &resp.State, &resp.Diagnostics)
+}
+
+// Read reads the compatibility level. A subject level removed outside of
+// Terraform removes the resource from state.
+func (c *Compatibility) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.SchemaRegistryCompatibility
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := c.client(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	level, err := client.Compatibility(ctx, model.Subject.ValueString())
+	if err != nil {
+		if IsNotFound(err) && model.Subject.ValueString() != "" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read the compatibility level", err.Error())
+		return
+	}
+	model.Level = types.StringValue(level)
+	model.ID = types.StringValue(compatibilityID(model))
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update sets the compatibility level.
+func (c *Compatibility) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model models.SchemaRegistryCompatibility
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	c.set(ctx, model, &resp.State, &resp.Diagnostics)
+}
+
+// Delete removes the compatibility level of the subject, which falls back to
+// the global level. The global level is left unchanged.
+func (c *Compatibility) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model models.SchemaRegistryCompatibility
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() || model.Subject.ValueString() == "" {
+		return
+	}
+	client, err := c.client(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	if err := client.DeleteCompatibility(ctx, model.Subject.ValueString()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete the compatibility level of subject %s", model.Subject), err.Error())
+	}
+}
+
+// ImportState imports the state of the Compatibility resource. The ID is
+// either <cluster_id>/<subject> or <cluster_id> for the global level.
+func (*Compatibility) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// a bare cluster ID imports the cluster-wide compatibility level
+	model := models.SchemaRegistryCompatibility{ClusterID: types.StringValue(req.ID), Subject: types.StringNull()}
+	if strings.ContainsAny(req.ID, "/,") {
+		clusterID, subject, err := utils.ParseClusterImportID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<subject> or <cluster_id>")
+			return
+		}
+		model.ClusterID, model.Subject = types.StringValue(clusterID), types.StringValue(subject)
+	}
+	if model.ClusterID.ValueString() == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<subject> or <cluster_id>")
+		return
+	}
+	if !model.Subject.IsNull() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), model.Subject)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), model.ClusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(compatibilityID(model)))...)
+}
+
+func (c *Compatibility) set(ctx context.Context, model models.SchemaRegistryCompatibility, state *tfsdk.State, diags *diag.Diagnostics) {
+	client, err := c.client(ctx, model)
+	if err != nil {
+		diags.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	if err := client.SetCompatibility(ctx, model.Subject.ValueString(), model.Level.ValueString()); err != nil {
+		diags.AddError("failed to set the compatibility level", err.Error())
+		return
+	}
+	model.ID = types.StringValue(compatibilityID(model))
+	diags.Append(state.Set(ctx, model)...)
+}
+
+// compatibilityID returns the import ID of the compatibility level.
+func compatibilityID(model models.SchemaRegistryCompatibility) string {
+	if model.Subject.ValueString() == "" {
+		return model.ClusterID.ValueString()
+	}
+	return model.Subject.ValueString() + "," + model.ClusterID.ValueString()
+}
+
+func (c *Compatibility) client(ctx context.Context, model models.SchemaRegistryCompatibility) (*Client, error) {
+	if c.Client != nil {
+		return c.Client, nil
+	}
+	return clusterClient(ctx, c.resData.ControlPlaneConnection, c.resData.Proxy, c.resData.AuthToken, model.ClusterID.ValueString(), model.Username.ValueString(), model.Password.ValueString())
+}
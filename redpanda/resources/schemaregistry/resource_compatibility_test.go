@@ -0,0 +1,20 @@
+package schemaregistry
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompatibilityID(t *testing.T) {
+	assert.Equal(t, "cluster-id", compatibilityID(models.SchemaRegistryCompatibility{
+		ClusterID: types.StringValue("cluster-id"),
+		Subject:   types.StringNull(),
+	}))
+	assert.Equal(t, "orders-value,cluster-id", compatibilityID(models.SchemaRegistryCompatibility{
+		ClusterID: types.StringValue("cluster-id"),
+		Subject:   types.StringValue("orders-value"),
+	}))
+}
@@ -0,0 +1,312 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schemaregistry
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc"
+)
+
+const (
+	schemaTypeAvro     = "AVRO"
+	schemaTypeProtobuf = "PROTOBUF"
+	schemaTypeJSON     = "JSON"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &Schema{}
+	_ resource.ResourceWithConfigure   = &Schema{}
+	_ resource.ResourceWithImportState = &Schema{}
+)
+
+// Schema represents the Schema Terraform resource, a subject registered in
+// the Schema Registry of a cluster.
+type Schema struct {
+	resData config.Resource
+	// client is used instead of the cluster Schema Registry when set.
+	client *Client
+}
+
+// Metadata returns the metadata for the Schema resource.
+func (*Schema) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_schema"
+}
+
+// Configure configures the Schema resource.
+func (s *Schema) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	s.resData = p
+}
+
+// Schema returns the schema for the Schema resource.
+func (*Schema) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceSchemaSchema()
+}
+
+func resourceSchemaSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Registers a schema under a subject of the Schema Registry of a cluster. New versions are registered when the schema changes, and versions registered outside of Terraform are reported as drift.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the cluster whose Schema Registry holds the subject.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"subject": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the subject, e.g. orders-value.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"schema": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The schema definition. Changing it registers a new version of the subject, subject to its compatibility level.",
+			},
+			"schema_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Type of the schema: AVRO, PROTOBUF or JSON. Defaults to AVRO.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(schemaTypeAvro, schemaTypeProtobuf, schemaTypeJSON),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username used to authenticate against the Schema Registry. When unset, the provider credentials are used.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password used to authenticate against the Schema Registry.",
+			},
+			"allow_deletion": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When set to true, destroying the resource permanently deletes all the versions of the subject, and applying a schema that is already registered as an older version soft deletes the versions registered after it outside of Terraform.",
+			},
+			"schema_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Global ID of the schema in the Schema Registry.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Version of the subject holding the schema.",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the schema, equal to its subject",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// Create registers the schema.
+func (s *Schema) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.Schema
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.SchemaType.IsUnknown() || model.SchemaType.IsNull() {
+		model.SchemaType = types.StringValue(schemaTypeAvro)
+	}
+	client, err := s.registryClient(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	registered, err := client.Register(ctx, model.Subject.ValueString(), model.Schema.ValueString(), model.SchemaType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to register schema for subject %q", model.Subject.ValueString()), err.Error())
+		return
+	}
+	model.SchemaID = types.Int64Value(registered.ID)
+	model.Version = types.Int64Value(registered.Version)
+	model.ID = model.Subject
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read reads the latest version of the subject. A version registered outside
+// of Terraform replaces the schema in state so that it shows up as drift.
+func (s *Schema) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.Schema
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	client, err := s.registryClient(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	latest, err := client.LatestVersion(ctx, model.Subject.ValueString())
+	if err != nil {
+		if IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read subject %q", model.Subject.ValueString()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, readModel(model, latest))...)
+}
+
+// readModel returns the state for the latest version of the subject. The
+// Schema Registry may normalize the schema text, so the text from state is
+// kept as long as the schema ID is unchanged.
+func readModel(state models.Schema, latest *SubjectSchema) models.Schema {
+	if state.SchemaID.IsNull() || state.SchemaID.ValueInt64() != latest.ID {
+		state.Schema = types.StringValue(latest.Schema)
+	}
+	state.SchemaType = types.StringValue(stateSchemaType(latest.SchemaType))
+	state.SchemaID = types.Int64Value(latest.ID)
+	state.Version = types.Int64Value(latest.Version)
+	state.ID = state.Subject
+	return state
+}
+
+// Update registers a new version of the subject when the schema changes. A
+// schema that is already registered under the subject isn't registered
+// again, so when it is an older version the newer ones are soft deleted to
+// make it the latest version again.
+func (s *Schema) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.Schema
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SchemaID, plan.Version = state.SchemaID, state.Version
+	if !plan.Schema.Equal(state.Schema) || !plan.SchemaType.Equal(state.SchemaType) {
+		client, err := s.registryClient(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+			return
+		}
+		registered, err := client.Register(ctx, plan.Subject.ValueString(), plan.Schema.ValueString(), plan.SchemaType.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to register a new schema version for subject %q", plan.Subject.ValueString()), err.Error())
+			return
+		}
+		newer, err := newerVersions(ctx, client, plan.Subject.ValueString(), registered.Version)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to list the versions of subject %q", plan.Subject.ValueString()), err.Error())
+			return
+		}
+		if len(newer) > 0 && !plan.AllowDeletion.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("schema"), "schema is not the latest version of the subject",
+				fmt.Sprintf("The schema is already registered as version %d of subject %q, and versions %v were registered after it. Set allow_deletion to soft delete them, or delete them from the Schema Registry.", registered.Version, plan.Subject.ValueString(), newer))
+			return
+		}
+		for _, v := range newer {
+			if err := client.DeleteVersion(ctx, plan.Subject.ValueString(), v); err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("failed to delete version %d of subject %q", v, plan.Subject.ValueString()), err.Error())
+				return
+			}
+		}
+		plan.SchemaID = types.Int64Value(registered.ID)
+		plan.Version = types.Int64Value(registered.Version)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// newerVersions returns the versions of the subject after the given one.
+func newerVersions(ctx context.Context, client *Client, subject string, version int64) ([]int64, error) {
+	versions, err := client.Versions(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	var newer []int64
+	for _, v := range versions {
+		if v > version {
+			newer = append(newer, v)
+		}
+	}
+	return newer, nil
+}
+
+// Delete permanently deletes the subject when allow_deletion is set.
+func (s *Schema) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model models.Schema
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !model.AllowDeletion.ValueBool() {
+		resp.Diagnostics.AddError(fmt.Sprintf("subject %s does not allow deletion", model.Subject), "")
+		return
+	}
+	client, err := s.registryClient(ctx, model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schema registry client", err.Error())
+		return
+	}
+	if err := client.DeleteSubject(ctx, model.Subject.ValueString()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete subject %s", model.Subject), err.Error())
+	}
+}
+
+// ImportState imports the state of the Schema resource.
+func (*Schema) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterID, subject, err := utils.ParseClusterImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<subject>")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), types.StringValue(subject))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(subject))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), types.StringValue(clusterID))...)
+}
+
+// registryClient returns a client of the Schema Registry of the cluster.
+func (s *Schema) registryClient(ctx context.Context, model models.Schema) (*Client, error) {
+	if s.client != nil {
+		return s.client, nil
+	}
+	return clusterClient(ctx, s.resData.ControlPlaneConnection, s.resData.Proxy, s.resData.AuthToken, model.ClusterID.ValueString(), model.Username.ValueString(), model.Password.ValueString())
+}
+
+// clusterClient returns a client of the Schema Registry of the cluster,
+// authenticated with the given credentials or with the provider token.
+func clusterClient(ctx context.Context, conn *grpc.ClientConn, proxy *cloud.Proxy, token, clusterID, username, password string) (*Client, error) {
+	cluster, err := cloud.NewControlPlaneClientSet(conn).ClusterForID(ctx, clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find cluster %q: %v", clusterID, err)
+	}
+	srURL := cluster.GetSchemaRegistry().GetUrl()
+	if srURL == "" {
+		return nil, fmt.Errorf("cluster %q has no Schema Registry URL", clusterID)
+	}
+	return NewClient(srURL, proxy.HTTPClient(), username, password, token), nil
+}
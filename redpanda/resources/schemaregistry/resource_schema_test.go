@@ -0,0 +1,152 @@
+package schemaregistry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadModel(t *testing.T) {
+	subject := types.StringValue("orders-value")
+	configured := models.Schema{
+		Subject:    subject,
+		Schema:     types.StringValue("{\n  \"type\": \"string\"\n}"),
+		SchemaType: types.StringValue(schemaTypeAvro),
+		SchemaID:   types.Int64Value(1),
+		Version:    types.Int64Value(1),
+		ID:         subject,
+	}
+	for _, tt := range []struct {
+		name   string
+		state  models.Schema
+		latest *SubjectSchema
+		exp    models.Schema
+	}{
+		{
+			name:   "same schema keeps the configured text",
+			state:  configured,
+			latest: &SubjectSchema{ID: 1, Version: 1, Schema: `"string"`},
+			exp:    configured,
+		},
+		{
+			name:   "version registered out of band",
+			state:  configured,
+			latest: &SubjectSchema{ID: 7, Version: 2, Schema: `"bytes"`},
+			exp: models.Schema{
+				Subject:    subject,
+				Schema:     types.StringValue(`"bytes"`),
+				SchemaType: types.StringValue(schemaTypeAvro),
+				SchemaID:   types.Int64Value(7),
+				Version:    types.Int64Value(2),
+				ID:         subject,
+			},
+		},
+		{
+			name:   "imported",
+			state:  models.Schema{Subject: subject, SchemaID: types.Int64Null()},
+			latest: &SubjectSchema{ID: 4, Version: 1, Schema: `syntax = "proto3";`, SchemaType: schemaTypeProtobuf},
+			exp: models.Schema{
+				Subject:    subject,
+				Schema:     types.StringValue(`syntax = "proto3";`),
+				SchemaType: types.StringValue(schemaTypeProtobuf),
+				SchemaID:   types.Int64Value(4),
+				Version:    types.Int64Value(1),
+				ID:         subject,
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, readModel(tt.state, tt.latest))
+		})
+	}
+}
+
+func TestUpdateSchemaRestoresOlderVersion(t *testing.T) {
+	ctx := context.Background()
+	configured := `"string"`
+	for _, tt := range []struct {
+		name          string
+		allowDeletion bool
+		expRequests   []string
+		expErr        bool
+	}{
+		{
+			name:          "soft deletes the newer versions",
+			allowDeletion: true,
+			expRequests: []string{
+				"POST /subjects/orders-value/versions",
+				"POST /subjects/orders-value",
+				"GET /subjects/orders-value/versions",
+				"DELETE /subjects/orders-value/versions/2",
+				"DELETE /subjects/orders-value/versions/3",
+			},
+		},
+		{
+			name: "fails without allow_deletion",
+			expRequests: []string{
+				"POST /subjects/orders-value/versions",
+				"POST /subjects/orders-value",
+				"GET /subjects/orders-value/versions",
+			},
+			expErr: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/subjects/orders-value/versions":
+					_, _ = w.Write([]byte(`{"id": 1}`))
+				case r.Method == http.MethodPost:
+					_, _ = w.Write([]byte(`{"subject": "orders-value", "id": 1, "version": 1, "schema": "\"string\""}`))
+				case r.Method == http.MethodGet:
+					_, _ = w.Write([]byte(`[1, 2, 3]`))
+				case r.Method == http.MethodDelete:
+					_, _ = w.Write([]byte(`2`))
+				}
+			}))
+			defer srv.Close()
+
+			s := resourceSchemaSchema()
+			state := models.Schema{
+				ClusterID:     types.StringValue("cl-123"),
+				Subject:       types.StringValue("orders-value"),
+				Schema:        types.StringValue(`"bytes"`),
+				SchemaType:    types.StringValue(schemaTypeAvro),
+				AllowDeletion: types.BoolValue(tt.allowDeletion),
+				SchemaID:      types.Int64Value(9),
+				Version:       types.Int64Value(3),
+				ID:            types.StringValue("orders-value"),
+			}
+			plan := state
+			plan.Schema = types.StringValue(configured)
+			req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: tfsdk.State{Schema: s}}
+			require.False(t, req.Plan.Set(ctx, plan).HasError())
+			require.False(t, req.State.Set(ctx, state).HasError())
+			resp := &resource.UpdateResponse{State: req.State}
+
+			r := &Schema{client: NewClient(srv.URL, srv.Client(), "", "", "")}
+			r.Update(ctx, req, resp)
+			assert.Equal(t, tt.expRequests, requests)
+			if tt.expErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			var got models.Schema
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, types.StringValue(configured), got.Schema)
+			assert.Equal(t, types.Int64Value(1), got.SchemaID)
+			assert.Equal(t, types.Int64Value(1), got.Version)
+		})
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package secret contains the implementation of the Secret resource following
+// the Terraform framework interfaces.
+package secret
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/grpc"
+)
+
+// defaultConnectClusterName is the name of the Kafka Connect cluster of
+// Redpanda Cloud clusters.
+const defaultConnectClusterName = "redpanda"
+
+const nameReplaceDescription = "Changing the name replaces the secret, unless the secret was imported and has no name in state yet."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &Secret{}
+	_ resource.ResourceWithConfigure      = &Secret{}
+	_ resource.ResourceWithImportState    = &Secret{}
+	_ resource.ResourceWithModifyPlan     = &Secret{}
+	_ resource.ResourceWithValidateConfig = &Secret{}
+)
+
+// Secret represents the Secret Terraform resource, a secret referenced by the
+// connectors of a cluster.
+type Secret struct {
+	SecretClient dataplanev1alpha2grpc.SecretServiceClient
+
+	resData       config.Resource
+	dataplaneConn *grpc.ClientConn
+}
+
+// Metadata returns the metadata for the Secret resource.
+func (*Secret) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "redpanda_secret"
+}
+
+// Configure configures the Secret resource.
+func (s *Secret) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	s.resData = p
+}
+
+// Schema returns the schema for the Secret resource.
+func (*Secret) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resourceSecretSchema()
+}
+
+func resourceSecretSchema() schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Secret stored in Redpanda Cloud and referenced by the connectors of a cluster. The value is write-only: only its SHA-256 hash is kept in the state, to detect changes of the configured value.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the secret, referenced by the connectors configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(nameRequiresReplace, nameReplaceDescription, nameReplaceDescription),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Value of the secret. It is write-only and never stored in the state. Changing it rotates the secret. Requires Terraform 1.11 or later.",
+			},
+			"value_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SHA-256 hash of the value of the secret.",
+			},
+			"labels": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Labels of the secret.",
+			},
+			"connect_cluster_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultConnectClusterName),
+				MarkdownDescription: "Name of the Kafka Connect cluster the secret belongs to.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
+					"change this value unless you are planning to do state imports",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the secret.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the Secret resource configuration.
+func (*Secret) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var value types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if !value.IsUnknown() && !value.IsNull() && value.ValueString() == "" {
+		response.Diagnostics.AddAttributeError(path.Root("value"), "empty secret value", "the value of a secret cannot be empty")
+	}
+}
+
+// ModifyPlan plans the hash of the configured value, so that changing the
+// write-only value updates the secret.
+func (*Secret) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var value types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	planned := types.StringUnknown()
+	if !value.IsUnknown() {
+		planned = types.StringValue(valueSHA256(value.ValueString()))
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("value_sha256"), planned)...)
+}
+
+// Create creates the secret.
+func (s *Secret) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var model models.Secret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &model)...)
+	var value types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	labels := utils.TypeMapToStringMap(model.Labels)
+	if err := s.createSecretClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create secret client", err.Error())
+		return
+	}
+	defer s.closeConn()
+	created, err := s.SecretClient.CreateConnectSecret(ctx, &dataplanev1alpha2.CreateConnectSecretRequest{
+		ClusterName: model.ConnectClusterName.ValueString(),
+		Name:        model.Name.ValueString(),
+		Labels:      labels,
+		SecretData:  []byte(value.ValueString()),
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to create secret %q", model.Name.ValueString()), err.Error())
+		return
+	}
+	model.ID = types.StringValue(created.GetSecret().GetId())
+	model.Value = types.StringNull()
+	model.ValueSHA256 = types.StringValue(valueSHA256(value.ValueString()))
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Read reads the secret. Its value can't be read back, so only its existence
+// and labels are refreshed.
+func (s *Secret) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var model models.Secret
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := s.createSecretClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create secret client", err.Error())
+		return
+	}
+	defer s.closeConn()
+	res, err := s.SecretClient.GetConnectSecret(ctx, &dataplanev1alpha2.GetConnectSecretRequest{
+		ClusterName: model.ConnectClusterName.ValueString(),
+		Id:          model.ID.ValueString(),
+	})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("failed to read secret %q", model.ID.ValueString()), err.Error())
+		return
+	}
+	model.Labels = labelsValue(model.Labels, res.GetSecret().GetLabels())
+	response.Diagnostics.Append(response.State.Set(ctx, model)...)
+}
+
+// Update updates the value and labels of the secret.
+func (s *Secret) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan models.Secret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	var value types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	labels := utils.TypeMapToStringMap(plan.Labels)
+	if err := s.createSecretClient(plan.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create secret client", err.Error())
+		return
+	}
+	defer s.closeConn()
+	_, err := s.SecretClient.UpdateConnectSecret(ctx, &dataplanev1alpha2.UpdateConnectSecretRequest{
+		ClusterName: plan.ConnectClusterName.ValueString(),
+		Id:          plan.ID.ValueString(),
+		Labels:      labels,
+		SecretData:  []byte(value.ValueString()),
+	})
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to update secret %q", plan.ID.ValueString()), err.Error())
+		return
+	}
+	plan.Value = types.StringNull()
+	plan.ValueSHA256 = types.StringValue(valueSHA256(value.ValueString()))
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the secret.
+func (s *Secret) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var model models.Secret
+	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if err := s.createSecretClient(model.ClusterAPIURL.ValueString()); err != nil {
+		response.Diagnostics.AddError("failed to create secret client", err.Error())
+		return
+	}
+	defer s.closeConn()
+	_, err := s.SecretClient.DeleteConnectSecret(ctx, &dataplanev1alpha2.DeleteConnectSecretRequest{
+		ClusterName: model.ConnectClusterName.ValueString(),
+		Id:          model.ID.ValueString(),
+	})
+	if err != nil && !utils.IsNotFound(err) {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to delete secret %q", model.ID.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports the state of the Secret resource from an ID of the form
+// <cluster_id>/<secret_id>. The value and the name of an imported secret can't
+// be read back, so the value is written again and the name is taken from the
+// configuration on the next apply.
+func (s *Secret) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	clusterID, secretID, err := utils.ParseClusterImportID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", request.ID), "ADDR ID format is <cluster_id>/<secret_id>")
+		return
+	}
+
+	client := cloud.NewControlPlaneClientSet(s.resData.ControlPlaneConnection)
+	cluster, err := client.ClusterForID(ctx, clusterID)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<secret_id>", clusterID), err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), types.StringValue(secretID))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("connect_cluster_name"), types.StringValue(defaultConnectClusterName))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(cluster.GetDataplaneApi().GetUrl()))...)
+}
+
+// nameRequiresReplace replaces the secret when its name changes. The API
+// doesn't return the name of a secret, so imported secrets have none in state
+// and take the configured one on the next apply.
+func nameRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (s *Secret) createSecretClient(clusterURL string) error {
+	if s.SecretClient != nil { // Client already started, no need to create another one.
+		return nil
+	}
+	if s.dataplaneConn == nil {
+		conn, err := cloud.SpawnConn(clusterURL, s.resData.AuthToken, s.resData.Proxy)
+		if err != nil {
+			return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
+		}
+		s.dataplaneConn = conn
+	}
+	s.SecretClient = dataplanev1alpha2grpc.NewSecretServiceClient(s.dataplaneConn)
+	return nil
+}
+
+func (s *Secret) closeConn() {
+	if s.dataplaneConn != nil {
+		s.dataplaneConn.Close()
+	}
+}
+
+// valueSHA256 returns the hex encoded SHA-256 hash of the secret value.
+func valueSHA256(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+// labelsValue returns the labels reported by the API, keeping them null when
+// they are not configured and the secret has none.
+func labelsValue(state types.Map, labels map[string]string) types.Map {
+	if len(labels) == 0 && state.IsNull() {
+		return state
+	}
+	values := make(map[string]attr.Value, len(labels))
+	for k, v := range labels {
+		values[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, values)
+}
@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	if d := resourceSecretSchema().ValidateImplementation(context.Background()); d.HasError() {
+		t.Errorf("Unexpected error in schema: %s", d)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockSecretServiceClient(ctrl)
+	client.EXPECT().CreateConnectSecret(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateConnectSecretRequest, _ ...any) (*dataplanev1alpha2.CreateConnectSecretResponse, error) {
+		assert.Equal(t, "redpanda", req.ClusterName)
+		assert.Equal(t, "DB_PASSWORD", req.Name)
+		assert.Equal(t, []byte("hunter2"), req.SecretData)
+		return &dataplanev1alpha2.CreateConnectSecretResponse{Secret: &dataplanev1alpha2.Secret{Id: "DB_PASSWORD"}}, nil
+	})
+
+	s := resourceSecretSchema()
+	objType := s.Type().TerraformType(ctx)
+	value := func(secret tftypes.Value, hash tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objType, map[string]tftypes.Value{
+			"name":                 tftypes.NewValue(tftypes.String, "DB_PASSWORD"),
+			"value":                secret,
+			"value_sha256":         hash,
+			"labels":               tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"connect_cluster_name": tftypes.NewValue(tftypes.String, "redpanda"),
+			"cluster_api_url":      tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+			"id":                   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})
+	}
+	hash := valueSHA256("hunter2")
+	req := resource.CreateRequest{
+		Config: tfsdk.Config{Schema: s, Raw: value(tftypes.NewValue(tftypes.String, "hunter2"), tftypes.NewValue(tftypes.String, nil))},
+		Plan:   tfsdk.Plan{Schema: s, Raw: value(tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, hash))},
+	}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	(&Secret{SecretClient: client}).Create(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.Secret
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.StringValue("DB_PASSWORD"), got.ID)
+	assert.True(t, got.Value.IsNull())
+	assert.Equal(t, types.StringValue(hash), got.ValueSHA256)
+}
+
+func TestLabelsValue(t *testing.T) {
+	assert.True(t, labelsValue(types.MapNull(types.StringType), nil).IsNull())
+	assert.Equal(t,
+		types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}),
+		labelsValue(types.MapNull(types.StringType), map[string]string{"team": "data"}),
+	)
+	assert.Equal(t,
+		types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		labelsValue(types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("data")}), nil),
+	)
+}
+
+func TestNameRequiresReplace(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		state types.String
+		want  bool
+	}{
+		{name: "renamed", state: types.StringValue("DB_PASSWORD"), want: true},
+		{name: "imported", state: types.StringNull(), want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+			nameRequiresReplace(context.Background(), planmodifier.StringRequest{
+				StateValue: tt.state,
+				PlanValue:  types.StringValue("DB_PASS"),
+			}, resp)
+			assert.Equal(t, tt.want, resp.RequiresReplace)
+		})
+	}
+}
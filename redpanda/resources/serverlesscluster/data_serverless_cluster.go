@@ -46,16 +46,8 @@ func (*DataSourceServerlessCluster) Metadata(_ context.Context, _ datasource.Met
 
 // Configure uses provider level data to configure DataSourceServerlessCluster's client.
 func (d *DataSourceServerlessCluster) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	p, ok := req.ProviderData.(config.Datasource)
-
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -89,26 +81,34 @@ func datasourceServerlessClusterSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Required:    true,
-				Description: "The ID of the serverless cluster",
+				Required:            true,
+				MarkdownDescription: "The ID of the serverless cluster",
 			},
 			"name": schema.StringAttribute{
-				Computed:    true,
-				Description: "Name of the serverless cluster",
+				Computed:            true,
+				MarkdownDescription: "Name of the serverless cluster",
 			},
 			"serverless_region": schema.StringAttribute{
-				Computed:    true,
-				Description: "Redpanda specific region for the serverless cluster",
+				Computed:            true,
+				MarkdownDescription: "Redpanda specific region for the serverless cluster",
 			},
 			"resource_group_id": schema.StringAttribute{
-				Computed:    true,
-				Description: "The ID of the resource group in which to create the serverless cluster",
+				Computed:            true,
+				MarkdownDescription: "The ID of the resource group in which to create the serverless cluster",
 			},
 			"cluster_api_url": schema.StringAttribute{
-				Computed:    true,
-				Description: "The URL of the cluster API",
+				Computed:            true,
+				MarkdownDescription: "The URL of the cluster API",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: stateDescription,
+			},
+			"dataplane_ready": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: dataplaneReadyDescription,
 			},
 		},
-		Description: "Data source for a Redpanda Cloud serverless cluster",
+		MarkdownDescription: "Data source for a Redpanda Cloud serverless cluster",
 	}
 }
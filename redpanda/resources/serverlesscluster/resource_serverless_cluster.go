@@ -23,12 +23,12 @@ import (
 	"time"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
@@ -45,6 +45,9 @@ var (
 // ServerlessCluster represents a cluster managed resource.
 type ServerlessCluster struct {
 	CpCl *cloud.ControlPlaneClientSet
+
+	timeouts config.Timeouts
+	polling  config.Polling
 }
 
 // Metadata returns the full name of the ServerlessCluster resource.
@@ -54,20 +57,14 @@ func (*ServerlessCluster) Metadata(_ context.Context, _ resource.MetadataRequest
 
 // Configure uses provider level data to configure ServerlessCluster's clients.
 func (c *ServerlessCluster) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	p, ok := req.ProviderData.(config.Resource)
-
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 
 	c.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	c.timeouts = p.Timeouts
+	c.polling = p.Polling
 }
 
 // Schema returns the schema for the ServerlessCluster resource.
@@ -79,31 +76,48 @@ func resourceServerlessClusterSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required:      true,
-				Description:   "Name of the serverless cluster",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Name of the serverless cluster",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"serverless_region": schema.StringAttribute{
 				// TODO: validate against ListServerlessRegions
-				Required:      true,
-				Description:   "Redpanda specific region of the serverless cluster",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "Redpanda specific region of the serverless cluster",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"resource_group_id": schema.StringAttribute{
-				Required:      true,
-				Description:   "The ID of the Resource Group in which to create the serverless cluster",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:            true,
+				MarkdownDescription: "The ID of the Resource Group in which to create the serverless cluster",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				Description:   "The ID of the serverless cluster",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "The ID of the serverless cluster",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"cluster_api_url": schema.StringAttribute{
-				Computed:      true,
-				Description:   "The URL of the dataplane API for the serverless cluster",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "The URL of the dataplane API for the serverless cluster",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"allow_deletion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Allows deletion of the serverless cluster. Defaults to true. Should probably be set to false for production use.",
+				Default:             &utils.DefaultBoolValue{Value: true, Desc: "Defaults to true"},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: stateDescription,
 			},
+			"dataplane_ready": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: dataplaneReadyDescription,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(context.Background(), timeouts.Opts{Create: true, Delete: true}),
 		},
 	}
 }
@@ -111,10 +125,15 @@ func resourceServerlessClusterSchema() schema.Schema {
 // Create creates a new ServerlessCluster resource. It updates the state if the resource
 // is successfully created.
 func (c *ServerlessCluster) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var model models.ServerlessCluster
+	var model models.ServerlessClusterResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	createTimeout, diags := model.Timeouts.Create(ctx, c.timeouts.Scale(time.Minute))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	clusterReq, err := GenerateServerlessClusterRequest(model)
+	clusterReq, err := GenerateServerlessClusterRequest(model.ServerlessCluster)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to parse CreateServerlessCluster request", err.Error())
 		return
@@ -130,7 +149,9 @@ func (c *ServerlessCluster) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := utils.AreWeDoneYet(ctx, op, time.Minute, c.CpCl.Operation); err != nil {
+	pollCtx, watchdog := c.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&resp.Diagnostics, watchdog)
+	if err := utils.AreWeDoneYet(pollCtx, op, createTimeout, c.CpCl.Operation); err != nil {
 		resp.Diagnostics.AddError("operation error while creating serverless cluster", err.Error())
 		return
 	}
@@ -139,13 +160,12 @@ func (c *ServerlessCluster) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError(fmt.Sprintf("successfully created the serverless cluster with ID %q, but failed to read the serverless cluster configuration: %v", op.GetResourceId(), err), err.Error())
 		return
 	}
-	persist := generateModel(cluster)
-	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(cluster, model))...)
 }
 
 // Read reads ServerlessCluster resource's values and updates the state.
 func (c *ServerlessCluster) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var model models.ServerlessCluster
+	var model models.ServerlessClusterResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 
 	cluster, err := c.CpCl.ServerlessClusterForID(ctx, model.ID.ValueString())
@@ -166,29 +186,37 @@ func (c *ServerlessCluster) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, models.ServerlessCluster{
-		Name:             types.StringValue(cluster.Name),
-		ServerlessRegion: types.StringValue(cluster.ServerlessRegion),
-		ResourceGroupID:  types.StringValue(cluster.ResourceGroupId),
-		ID:               types.StringValue(cluster.Id),
-		ClusterAPIURL:    types.StringValue(cluster.DataplaneApi.Url),
-	})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(cluster, model))...)
 }
 
-// Update all serverless cluster updates are currently delete and recreate.
-func (*ServerlessCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan models.ServerlessCluster
+// Update only updates allow_deletion and the timeouts, which are not stored by
+// the API: every other serverless cluster change needs a resource replacement.
+// The cluster is read again to refresh its state.
+func (c *ServerlessCluster) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ServerlessClusterResource
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	// We pass through the plan to state. Currently, every serverless cluster change needs
-	// a resource replacement.
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cluster, err := c.CpCl.ServerlessClusterForID(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read serverless cluster %s", plan.ID), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, resourceModel(cluster, plan))...)
 }
 
 // Delete deletes the ServerlessCluster resource.
 func (c *ServerlessCluster) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var model models.ServerlessCluster
+	var model models.ServerlessClusterResource
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 
+	// clusters created before allow_deletion existed have it unset
+	if !model.AllowDeletion.IsNull() && !model.AllowDeletion.ValueBool() {
+		resp.Diagnostics.AddError("serverless cluster deletion not allowed", "allow_deletion is set to false")
+		return
+	}
+
 	clResp, err := c.CpCl.ServerlessCluster.DeleteServerlessCluster(ctx, &controlplanev1beta2.DeleteServerlessClusterRequest{
 		Id: model.ID.ValueString(),
 	})
@@ -196,8 +224,15 @@ func (c *ServerlessCluster) Delete(ctx context.Context, req resource.DeleteReque
 		resp.Diagnostics.AddError("failed to delete serverless cluster", err.Error())
 		return
 	}
+	deleteTimeout, diags := model.Timeouts.Delete(ctx, c.timeouts.Scale(time.Minute))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err := utils.AreWeDoneYet(ctx, clResp.Operation, time.Minute, c.CpCl.Operation); err != nil {
+	pollCtx, watchdog := c.polling.Context(ctx, model.Name.ValueString())
+	defer utils.WarnStalledOperations(&resp.Diagnostics, watchdog)
+	if err := utils.AreWeDoneYet(pollCtx, clResp.Operation, deleteTimeout, c.CpCl.Operation); err != nil {
 		resp.Diagnostics.AddError("failed to delete serverless cluster", err.Error())
 		return
 	}
@@ -216,3 +251,10 @@ func GenerateServerlessClusterRequest(model models.ServerlessCluster) (*controlp
 		ResourceGroupId:  model.ResourceGroupID.ValueString(),
 	}, nil
 }
+
+// resourceModel returns the state of the serverless cluster resource, keeping
+// the attributes that only configure the resource from cfg.
+func resourceModel(cluster *controlplanev1beta2.ServerlessCluster, cfg models.ServerlessClusterResource) models.ServerlessClusterResource {
+	cfg.ServerlessCluster = *generateModel(cluster)
+	return cfg
+}
@@ -13,6 +13,8 @@ func generateModel(cluster *controlplanev1beta2.ServerlessCluster) *models.Serve
 		ServerlessRegion: types.StringValue(cluster.ServerlessRegion),
 		ResourceGroupID:  types.StringValue(cluster.ResourceGroupId),
 		ID:               types.StringValue(cluster.Id),
+		State:            types.StringValue(serverlessClusterState(cluster.GetState())),
+		DataplaneReady:   types.BoolValue(cluster.GetState() == controlplanev1beta2.ServerlessCluster_STATE_READY && cluster.GetDataplaneApi().GetUrl() != ""),
 	}
 	if cluster.DataplaneApi != nil {
 		output.ClusterAPIURL = types.StringValue(cluster.DataplaneApi.Url)
@@ -20,6 +22,31 @@ func generateModel(cluster *controlplanev1beta2.ServerlessCluster) *models.Serve
 	return output
 }
 
+const (
+	stateDescription          = "Lifecycle state of the serverless cluster: provisioning, ready, failed, deleting, suspended or unknown."
+	dataplaneReadyDescription = "Whether the serverless cluster is ready and its dataplane API reachable, so that topics, users " +
+		"and ACLs can be managed on it."
+)
+
+// serverlessClusterState returns the lifecycle state of the serverless cluster,
+// using the same names as the status of dedicated clusters.
+func serverlessClusterState(state controlplanev1beta2.ServerlessCluster_State) string {
+	switch state {
+	case controlplanev1beta2.ServerlessCluster_STATE_PLACING, controlplanev1beta2.ServerlessCluster_STATE_CREATING:
+		return "provisioning"
+	case controlplanev1beta2.ServerlessCluster_STATE_READY:
+		return "ready"
+	case controlplanev1beta2.ServerlessCluster_STATE_FAILED:
+		return "failed"
+	case controlplanev1beta2.ServerlessCluster_STATE_DELETING:
+		return "deleting"
+	case controlplanev1beta2.ServerlessCluster_STATE_SUSPENDED:
+		return "suspended"
+	default:
+		return "unknown"
+	}
+}
+
 // generateServerlessClusterRequest was pulled out to enable unit testing
 func generateServerlessClusterRequest(model models.ServerlessCluster) (*controlplanev1beta2.ServerlessClusterCreate, error) {
 	return &controlplanev1beta2.ServerlessClusterCreate{
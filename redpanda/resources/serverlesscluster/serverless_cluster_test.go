@@ -1,14 +1,21 @@
 package serverlesscluster
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/testutil"
 )
 
 func TestGenerateServerlessClusterRequest(t *testing.T) {
@@ -48,3 +55,107 @@ func TestGenerateServerlessClusterRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateModel(t *testing.T) {
+	tests := []struct {
+		name      string
+		cluster   *controlplanev1beta2.ServerlessCluster
+		wantState string
+		wantReady bool
+	}{
+		{
+			name: "ready",
+			cluster: &controlplanev1beta2.ServerlessCluster{
+				Id:           "abc",
+				State:        controlplanev1beta2.ServerlessCluster_STATE_READY,
+				DataplaneApi: &controlplanev1beta2.ServerlessCluster_DataplaneAPI{Url: "https://api.example.com"},
+			},
+			wantState: "ready",
+			wantReady: true,
+		},
+		{
+			name: "ready_without_dataplane",
+			cluster: &controlplanev1beta2.ServerlessCluster{
+				Id:    "abc",
+				State: controlplanev1beta2.ServerlessCluster_STATE_READY,
+			},
+			wantState: "ready",
+		},
+		{
+			name: "creating",
+			cluster: &controlplanev1beta2.ServerlessCluster{
+				Id:           "abc",
+				State:        controlplanev1beta2.ServerlessCluster_STATE_CREATING,
+				DataplaneApi: &controlplanev1beta2.ServerlessCluster_DataplaneAPI{Url: "https://api.example.com"},
+			},
+			wantState: "provisioning",
+		},
+		{
+			name:      "unspecified",
+			cluster:   &controlplanev1beta2.ServerlessCluster{Id: "abc"},
+			wantState: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := generateModel(tt.cluster)
+			if got.State.ValueString() != tt.wantState {
+				t.Errorf("State = %q, want %q", got.State.ValueString(), tt.wantState)
+			}
+			if got.DataplaneReady.ValueBool() != tt.wantReady {
+				t.Errorf("DataplaneReady = %v, want %v", got.DataplaneReady.ValueBool(), tt.wantReady)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockServerlessClusterServiceClient(ctrl)
+	client.EXPECT().GetServerlessCluster(gomock.Any(), &controlplanev1beta2.GetServerlessClusterRequest{Id: "abc"}).Return(&controlplanev1beta2.GetServerlessClusterResponse{
+		ServerlessCluster: &controlplanev1beta2.ServerlessCluster{
+			Id:               "abc",
+			Name:             "test",
+			ServerlessRegion: "pro-us-east-1",
+			ResourceGroupId:  "rg-1",
+			State:            controlplanev1beta2.ServerlessCluster_STATE_READY,
+			DataplaneApi:     &controlplanev1beta2.ServerlessCluster_DataplaneAPI{Url: "https://api.example.com"},
+		},
+	}, nil)
+
+	s := resourceServerlessClusterSchema()
+	plan := tfsdk.Plan{Schema: s}
+	if d := plan.Set(ctx, models.ServerlessClusterResource{
+		ServerlessCluster: models.ServerlessCluster{
+			Name:             types.StringValue("test"),
+			ID:               types.StringValue("abc"),
+			ServerlessRegion: types.StringValue("pro-us-east-1"),
+			ResourceGroupID:  types.StringValue("rg-1"),
+			ClusterAPIURL:    types.StringValue("https://api.example.com"),
+			State:            types.StringUnknown(),
+			DataplaneReady:   types.BoolUnknown(),
+		},
+		AllowDeletion: types.BoolValue(false),
+		Timeouts:      testutil.NullTimeouts("create", "delete"),
+	}); d.HasError() {
+		t.Fatalf("unable to set plan: %v", d)
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+	c := &ServerlessCluster{CpCl: &cloud.ControlPlaneClientSet{ServerlessCluster: client}}
+	c.Update(ctx, resource.UpdateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got models.ServerlessClusterResource
+	if d := resp.State.Get(ctx, &got); d.HasError() {
+		t.Fatalf("unable to read state: %v", d)
+	}
+	if got.State.ValueString() != "ready" || !got.DataplaneReady.ValueBool() {
+		t.Errorf("State = %v, DataplaneReady = %v, want ready and true", got.State, got.DataplaneReady)
+	}
+	if got.AllowDeletion.ValueBool() {
+		t.Errorf("AllowDeletion = %v, want false", got.AllowDeletion)
+	}
+}
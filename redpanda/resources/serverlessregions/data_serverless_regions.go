@@ -19,7 +19,6 @@ package serverlessregions
 
 import (
 	"context"
-	"fmt"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -46,32 +45,32 @@ func DataSourceServerlessRegionsSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"cloud_provider": schema.StringAttribute{
-				Required:    true,
-				Description: "Cloud provider where the serverless regions exist",
-				Validators:  validators.CloudProviders(),
+				Required:            true,
+				MarkdownDescription: "Cloud provider where the serverless regions exist",
+				Validators:          validators.CloudProviders(),
 			},
 			"serverless_regions": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"available": schema.BoolAttribute{
-							Computed:    true,
-							Description: "Region available",
+							Computed:            true,
+							MarkdownDescription: "Region available",
 						},
 						"display_name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Display name of the serverless region",
+							Computed:            true,
+							MarkdownDescription: "Display name of the serverless region",
 						},
 						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Name of the serverless region",
+							Computed:            true,
+							MarkdownDescription: "Name of the serverless region",
 						},
 					},
 				},
-				Description: "Serverless regions available for the cloud provider",
+				MarkdownDescription: "Serverless regions available for the cloud provider",
 			},
 		},
-		Description: "Data source for a list of Redpanda Cloud serverless regions",
+		MarkdownDescription: "Data source for a list of Redpanda Cloud serverless regions",
 	}
 }
 
@@ -124,16 +123,8 @@ func (r *DataSourceServerlessRegions) Read(ctx context.Context, req datasource.R
 
 // Configure uses provider level data to configure DataSourceServerlessRegions client.
 func (r *DataSourceServerlessRegions) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -0,0 +1,147 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+
+	iamv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &Credentials{}
+	_ resource.ResourceWithConfigure = &Credentials{}
+)
+
+// Credentials represents the client credentials of a Redpanda Cloud service
+// account.
+type Credentials struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the full name of the Credentials resource.
+func (*Credentials) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_service_account_credentials"
+}
+
+// Configure uses provider level data to configure Credentials client.
+func (c *Credentials) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	c.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// Schema returns the schema for the Credentials resource.
+func (*Credentials) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceCredentialsSchema()
+}
+
+func resourceCredentialsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"service_account_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID of the service account owning the credentials",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"client_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Client ID of the service account",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client secret of the service account. It is read from the API, so it is stored in the state: write-only attributes can't be computed",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the credentials, the client ID",
+			},
+		},
+		MarkdownDescription: "Client credentials of a Redpanda Cloud service account, to authenticate automation such as other Terraform workspaces against the Redpanda Cloud API. A service account has a single pair of credentials: to rotate them, replace the service account.",
+	}
+}
+
+// Create reads the credentials of the service account.
+func (c *Credentials) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.ServiceAccountCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := c.CpCl.ServiceAccount.GetServiceAccountCredentials(ctx, &iamv1alpha1.GetServiceAccountCredentialsRequest{Id: model.ServiceAccountID.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read the credentials of service account %q", model.ServiceAccountID.ValueString()), err.Error())
+		return
+	}
+	model.ClientID = types.StringValue(res.GetCredentials().GetClientId())
+	model.ClientSecret = types.StringValue(res.GetCredentials().GetClientSecret())
+	model.ID = model.ClientID
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read removes the credentials from state when their service account no
+// longer exists, or when its client ID changed.
+func (c *Credentials) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.ServiceAccountCredentials
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := c.CpCl.ServiceAccount.GetServiceAccount(ctx, &iamv1alpha1.GetServiceAccountRequest{Id: model.ServiceAccountID.ValueString()})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read service account %q", model.ServiceAccountID.ValueString()), err.Error())
+		return
+	}
+	if id := res.GetServiceAccount().GetAuth0ClientCredentials().GetClientId(); id != "" && id != model.ClientID.ValueString() {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+// Update is not supported: service_account_id, the only configurable
+// attribute, requires a replacement.
+func (*Credentials) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ServiceAccountCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the credentials from state. They stay valid until their
+// service account is deleted.
+func (*Credentials) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// The API has no call to revoke the credentials of a service account, so
+	// they are left in place.
+}
@@ -0,0 +1,55 @@
+package serviceaccount
+
+import (
+	"context"
+	"testing"
+
+	iamv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCredentialsSchema(t *testing.T) {
+	if d := resourceCredentialsSchema().ValidateImplementation(context.Background()); d.HasError() {
+		t.Errorf("Unexpected error in schema: %s", d)
+	}
+}
+
+func TestCredentialsRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		clientID    string
+		wantRemoved bool
+	}{
+		{name: "unchanged", clientID: "client-1"},
+		{name: "rotated", clientID: "client-2", wantRemoved: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			client := mocks.NewMockServiceAccountServiceClient(ctrl)
+			client.EXPECT().GetServiceAccount(gomock.Any(), &iamv1alpha1.GetServiceAccountRequest{Id: "sa-1"}).Return(&iamv1alpha1.GetServiceAccountResponse{
+				ServiceAccount: &iamv1alpha1.ServiceAccount{Id: "sa-1", Auth0ClientCredentials: &iamv1alpha1.ServiceAccountCredentials{ClientId: tt.clientID}},
+			}, nil)
+
+			s := resourceCredentialsSchema()
+			state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"service_account_id": tftypes.NewValue(tftypes.String, "sa-1"),
+				"client_id":          tftypes.NewValue(tftypes.String, "client-1"),
+				"client_secret":      tftypes.NewValue(tftypes.String, "hunter2"),
+				"id":                 tftypes.NewValue(tftypes.String, "client-1"),
+			})}
+			resp := &resource.ReadResponse{State: state}
+			(&Credentials{CpCl: &cloud.ControlPlaneClientSet{ServiceAccount: client}}).Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+		})
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package serviceaccount contains the implementation of the ServiceAccount
+// resource following the Terraform framework interfaces.
+package serviceaccount
+
+import (
+	"context"
+	"fmt"
+
+	iamv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1alpha1"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ServiceAccount{}
+	_ resource.ResourceWithConfigure   = &ServiceAccount{}
+	_ resource.ResourceWithImportState = &ServiceAccount{}
+)
+
+// ServiceAccount represents a Redpanda Cloud service account, the identity
+// owning a client ID used by automation.
+type ServiceAccount struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// Metadata returns the full name of the ServiceAccount resource.
+func (*ServiceAccount) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "redpanda_service_account"
+}
+
+// Configure uses provider level data to configure ServiceAccount client.
+func (s *ServiceAccount) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	s.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// Schema returns the schema for the ServiceAccount resource.
+func (*ServiceAccount) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resourceServiceAccountSchema()
+}
+
+func resourceServiceAccountSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the service account",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the service account",
+			},
+			"client_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Client ID of the service account, used to authenticate against the Redpanda Cloud API",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client secret of the service account. It is only known for service accounts created by Terraform",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the service account",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		MarkdownDescription: "A Redpanda Cloud service account, the identity owning the client ID and secret used by automation",
+	}
+}
+
+// Create creates a new ServiceAccount resource.
+func (s *ServiceAccount) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model models.ServiceAccount
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	created, err := s.CpCl.ServiceAccount.CreateServiceAccount(ctx, &iamv1alpha1.CreateServiceAccountRequest{
+		ServiceAccount: &iamv1alpha1.ServiceAccountCreate{
+			Name:        model.Name.ValueString(),
+			Description: model.Description.ValueString(),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to create service account %q", model.Name.ValueString()), err.Error())
+		return
+	}
+	sa := created.GetServiceAccount()
+	creds := sa.GetAuth0ClientCredentials()
+	if creds.GetClientSecret() == "" {
+		res, err := s.CpCl.ServiceAccount.GetServiceAccountCredentials(ctx, &iamv1alpha1.GetServiceAccountCredentialsRequest{Id: sa.GetId()})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to read the credentials of service account %q", sa.GetId()), err.Error())
+			return
+		}
+		creds = res.GetCredentials()
+	}
+	model.ID = types.StringValue(sa.GetId())
+	model.ClientID = types.StringValue(creds.GetClientId())
+	model.ClientSecret = types.StringValue(creds.GetClientSecret())
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read reads ServiceAccount resource's values and updates the state.
+func (s *ServiceAccount) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model models.ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	res, err := s.CpCl.ServiceAccount.GetServiceAccount(ctx, &iamv1alpha1.GetServiceAccountRequest{Id: model.ID.ValueString()})
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read service account %q", model.ID.ValueString()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, readModel(model, res.GetServiceAccount()))...)
+}
+
+// readModel returns the state of the service account. The client secret is
+// not returned when reading a service account, so the one from state is kept.
+func readModel(state models.ServiceAccount, sa *iamv1alpha1.ServiceAccount) models.ServiceAccount {
+	state.ID = types.StringValue(sa.GetId())
+	state.Name = types.StringValue(sa.GetName())
+	if sa.GetDescription() != "" || !state.Description.IsNull() {
+		state.Description = types.StringValue(sa.GetDescription())
+	}
+	if id := sa.GetAuth0ClientCredentials().GetClientId(); id != "" {
+		state.ClientID = types.StringValue(id)
+	}
+	if state.ClientSecret.IsUnknown() {
+		state.ClientSecret = types.StringNull()
+	}
+	return state
+}
+
+// Update updates the name and description of the ServiceAccount resource.
+func (s *ServiceAccount) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.ServiceAccount
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := s.CpCl.ServiceAccount.UpdateServiceAccount(ctx, &iamv1alpha1.UpdateServiceAccountRequest{
+		Id: plan.ID.ValueString(),
+		ServiceAccount: &iamv1alpha1.ServiceAccountUpdate{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "description"}},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to update service account %q", plan.ID.ValueString()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the ServiceAccount resource.
+func (s *ServiceAccount) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model models.ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := s.CpCl.ServiceAccount.DeleteServiceAccount(ctx, &iamv1alpha1.DeleteServiceAccountRequest{Id: model.ID.ValueString()})
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete service account %q", model.ID.ValueString()), err.Error())
+	}
+}
+
+// ImportState imports the ServiceAccount resource by ID. The client secret of
+// an imported service account is unknown.
+func (*ServiceAccount) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
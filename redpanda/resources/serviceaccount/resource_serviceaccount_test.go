@@ -0,0 +1,81 @@
+package serviceaccount
+
+import (
+	"context"
+	"testing"
+
+	iamv1alpha1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSchema(t *testing.T) {
+	if d := resourceServiceAccountSchema().ValidateImplementation(context.Background()); d.HasError() {
+		t.Errorf("Unexpected error in schema: %s", d)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockServiceAccountServiceClient(ctrl)
+	client.EXPECT().CreateServiceAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *iamv1alpha1.CreateServiceAccountRequest, _ ...any) (*iamv1alpha1.CreateServiceAccountResponse, error) {
+		assert.Equal(t, "ci-pipeline", req.GetServiceAccount().GetName())
+		return &iamv1alpha1.CreateServiceAccountResponse{ServiceAccount: &iamv1alpha1.ServiceAccount{
+			Id:                     "sa-1",
+			Name:                   "ci-pipeline",
+			Auth0ClientCredentials: &iamv1alpha1.ServiceAccountCredentials{ClientId: "client-1"},
+		}}, nil
+	})
+	secret := "hunter2"
+	client.EXPECT().GetServiceAccountCredentials(gomock.Any(), &iamv1alpha1.GetServiceAccountCredentialsRequest{Id: "sa-1"}).Return(
+		&iamv1alpha1.GetServiceAccountCredentialsResponse{Credentials: &iamv1alpha1.ServiceAccountCredentials{ClientId: "client-1", ClientSecret: &secret}}, nil,
+	)
+
+	s := resourceServiceAccountSchema()
+	objType := s.Type().TerraformType(ctx)
+	plan := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"name":          tftypes.NewValue(tftypes.String, "ci-pipeline"),
+		"description":   tftypes.NewValue(tftypes.String, nil),
+		"client_id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"client_secret": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"id":            tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(objType, nil)}}
+	(&ServiceAccount{CpCl: &cloud.ControlPlaneClientSet{ServiceAccount: client}}).Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.ServiceAccount
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, models.ServiceAccount{
+		Name:         types.StringValue("ci-pipeline"),
+		Description:  types.StringNull(),
+		ClientID:     types.StringValue("client-1"),
+		ClientSecret: types.StringValue("hunter2"),
+		ID:           types.StringValue("sa-1"),
+	}, got)
+}
+
+func TestReadModel(t *testing.T) {
+	state := models.ServiceAccount{
+		Name:         types.StringValue("ci"),
+		Description:  types.StringNull(),
+		ClientID:     types.StringValue("client-1"),
+		ClientSecret: types.StringValue("hunter2"),
+		ID:           types.StringValue("sa-1"),
+	}
+	got := readModel(state, &iamv1alpha1.ServiceAccount{Id: "sa-1", Name: "ci-pipeline"})
+	assert.Equal(t, types.StringValue("ci-pipeline"), got.Name)
+	assert.True(t, got.Description.IsNull())
+	assert.Equal(t, types.StringValue("client-1"), got.ClientID)
+	assert.Equal(t, types.StringValue("hunter2"), got.ClientSecret)
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package throughputtiers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+)
+
+var _ datasource.DataSource = &DataSourceThroughputTierAvailability{}
+
+// DataSourceThroughputTierAvailability represents a data source checking
+// whether a throughput tier is offered in a region and its zones, so that an
+// unsupported combination fails at plan instead of during cluster creation.
+type DataSourceThroughputTierAvailability struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// DataSourceThroughputTierAvailabilitySchema defines the schema for a
+// Throughput Tier Availability data source.
+func DataSourceThroughputTierAvailabilitySchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cloud_provider": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cloud provider of the cluster",
+				Validators:          validators.CloudProviders(),
+			},
+			"cluster_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Type of the cluster, `dedicated` or `byoc`. When unset, tiers of either type are accepted",
+				Validators:          validators.ClusterTypes(),
+			},
+			"region": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Region of the cluster",
+			},
+			"zones": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Zones of the cluster",
+			},
+			"throughput_tier": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the throughput tier of the cluster",
+			},
+			"fail_on_unavailable": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail the plan when the combination is not offered. Defaults to `true`; set to `false` to inspect `available` and `reasons` instead",
+			},
+			"available": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the throughput tier is offered in the region and zones",
+			},
+			"reasons": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Why the combination is not offered, empty when it is",
+			},
+			"available_zones": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Zones of the region, empty when the region is not offered",
+			},
+			"available_tiers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Names of the throughput tiers offered in the region",
+			},
+		},
+		MarkdownDescription: "Data source checking whether a throughput tier is offered in a region and zones of a cloud provider, to fail at plan time instead of after a failed cluster creation",
+	}
+}
+
+// Metadata returns the metadata for the Throughput Tier Availability data source.
+func (*DataSourceThroughputTierAvailability) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_throughput_tier_availability"
+}
+
+// Schema returns the schema for the Throughput Tier Availability data source.
+func (*DataSourceThroughputTierAvailability) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceThroughputTierAvailabilitySchema()
+}
+
+// Read checks the combination of the Throughput Tier Availability data source
+// and updates the state.
+func (r *DataSourceThroughputTierAvailability) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.ThroughputTierAvailability
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudProvider, err := utils.StringToCloudProvider(model.CloudProvider.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("unsupported cloud provider", err.Error())
+		return
+	}
+	clusterType := controlplanev1beta2.Cluster_TYPE_UNSPECIFIED
+	if !model.ClusterType.IsNull() {
+		if clusterType, err = utils.StringToClusterType(model.ClusterType.ValueString()); err != nil {
+			resp.Diagnostics.AddError("unsupported cluster type", err.Error())
+			return
+		}
+	}
+
+	availability, err := checkAvailability(ctx, r.CpCl, cloudProvider, clusterType, model.Region.ValueString(), model.ThroughputTier.ValueString(), utils.TypeListToStringSlice(model.Zones))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to check the availability of throughput tier %s", model.ThroughputTier), err.Error())
+		return
+	}
+
+	model.Available = types.BoolValue(len(availability.reasons) == 0)
+	model.Reasons = utils.StringSliceToTypeList(availability.reasons)
+	model.AvailableZones = utils.StringSliceToTypeList(availability.zones)
+	model.AvailableTiers = utils.StringSliceToTypeList(availability.tiers)
+	if len(availability.reasons) != 0 && (model.FailOnUnavailable.IsNull() || model.FailOnUnavailable.ValueBool()) {
+		resp.Diagnostics.AddError("throughput tier not available",
+			fmt.Sprintf("Creating a cluster with this configuration would fail: %s.", strings.Join(availability.reasons, "; ")))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Configure uses provider level data to configure DataSourceThroughputTierAvailability client.
+func (r *DataSourceThroughputTierAvailability) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// availability holds what a region offers, and why a combination is not
+// offered.
+type availability struct {
+	reasons []string
+	zones   []string
+	tiers   []string
+}
+
+// checkAvailability checks that the region is offered on the cloud provider,
+// that it has every zone, and that the throughput tier is offered in it for
+// the cluster type.
+func checkAvailability(ctx context.Context, cpCl *cloud.ControlPlaneClientSet, cloudProvider controlplanev1beta2.CloudProvider, clusterType controlplanev1beta2.Cluster_Type, region, tier string, zones []string) (availability, error) {
+	a := availability{reasons: []string{}, zones: []string{}, tiers: []string{}}
+	provider := utils.CloudProviderToString(cloudProvider)
+	regionResp, err := cpCl.Region.GetRegion(ctx, &controlplanev1beta2.GetRegionRequest{Name: region, CloudProvider: cloudProvider})
+	if err != nil {
+		if !utils.IsNotFound(err) {
+			return a, err
+		}
+		a.reasons = append(a.reasons, fmt.Sprintf("region %q is not offered on %s", region, provider))
+		return a, nil
+	}
+	a.zones = append(a.zones, regionResp.GetRegion().GetZones()...)
+	for _, zone := range zones {
+		if !slices.Contains(a.zones, zone) {
+			a.reasons = append(a.reasons, fmt.Sprintf("zone %q is not in %s region %q, which has zones %s", zone, provider, region, strings.Join(a.zones, ", ")))
+		}
+	}
+
+	listReq := &controlplanev1beta2.ListThroughputTiersRequest{
+		Filter: &controlplanev1beta2.ListThroughputTiersRequest_Filter{CloudProvider: cloudProvider, ClusterType: clusterType, Region: region},
+	}
+	for {
+		tiers, err := cpCl.ThroughputTier.ListThroughputTiers(ctx, listReq)
+		if err != nil {
+			return a, err
+		}
+		for _, t := range tiers.GetThroughputTiers() {
+			a.tiers = append(a.tiers, t.GetName())
+		}
+		listReq.PageToken = tiers.GetNextPageToken()
+		if listReq.PageToken == "" || len(tiers.GetThroughputTiers()) == 0 {
+			break
+		}
+	}
+	if !slices.Contains(a.tiers, tier) {
+		a.reasons = append(a.reasons, fmt.Sprintf("throughput tier %q is not offered in %s region %q", tier, provider, region))
+	}
+	return a, nil
+}
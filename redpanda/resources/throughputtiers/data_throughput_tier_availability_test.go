@@ -0,0 +1,77 @@
+package throughputtiers
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func TestDataSourceThroughputTierAvailabilitySchema(t *testing.T) {
+	require.False(t, DataSourceThroughputTierAvailabilitySchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestCheckAvailability(t *testing.T) {
+	region := &controlplanev1beta2.GetRegionResponse{Region: &controlplanev1beta2.Region{Name: "us-east-2", Zones: []string{"use2-az1", "use2-az2"}}}
+	tests := []struct {
+		name        string
+		regionErr   error
+		zones       []string
+		tier        string
+		wantReasons []string
+	}{
+		{
+			name:        "offered",
+			zones:       []string{"use2-az1"},
+			tier:        "tier-1-aws",
+			wantReasons: []string{},
+		},
+		{
+			name:        "unknown zone and tier",
+			zones:       []string{"use2-az1", "use2-az9"},
+			tier:        "tier-9-aws",
+			wantReasons: []string{`zone "use2-az9" is not in aws region "us-east-2", which has zones use2-az1, use2-az2`, `throughput tier "tier-9-aws" is not offered in aws region "us-east-2"`},
+		},
+		{
+			name:        "unknown region",
+			regionErr:   grpcstatus.Error(grpccodes.NotFound, "not found"),
+			tier:        "tier-1-aws",
+			wantReasons: []string{`region "us-east-2" is not offered on aws`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			regions := mocks.NewMockRegionServiceClient(ctrl)
+			tiers := mocks.NewMockThroughputTierServiceClient(ctrl)
+			if tt.regionErr != nil {
+				regions.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(nil, tt.regionErr)
+			} else {
+				regions.EXPECT().GetRegion(gomock.Any(), gomock.Any()).Return(region, nil)
+				gomock.InOrder(
+					tiers.EXPECT().ListThroughputTiers(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *controlplanev1beta2.ListThroughputTiersRequest, _ ...any) (*controlplanev1beta2.ListThroughputTiersResponse, error) {
+						assert.Equal(t, "us-east-2", req.GetFilter().GetRegion())
+						assert.Equal(t, controlplanev1beta2.Cluster_TYPE_DEDICATED, req.GetFilter().GetClusterType())
+						return &controlplanev1beta2.ListThroughputTiersResponse{ThroughputTiers: []*controlplanev1beta2.ThroughputTier{{Name: "tier-1-aws"}}, NextPageToken: "next"}, nil
+					}),
+					tiers.EXPECT().ListThroughputTiers(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.ListThroughputTiersResponse{ThroughputTiers: []*controlplanev1beta2.ThroughputTier{{Name: "tier-2-aws"}}}, nil),
+				)
+			}
+			cpCl := &cloud.ControlPlaneClientSet{Region: regions, ThroughputTier: tiers}
+
+			got, err := checkAvailability(context.Background(), cpCl, controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS, controlplanev1beta2.Cluster_TYPE_DEDICATED, "us-east-2", tt.tier, tt.zones)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantReasons, got.reasons)
+			if tt.regionErr == nil {
+				assert.Equal(t, []string{"tier-1-aws", "tier-2-aws"}, got.tiers)
+			}
+		})
+	}
+}
@@ -19,7 +19,6 @@ package throughputtiers
 
 import (
 	"context"
-	"fmt"
 
 	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -46,32 +45,32 @@ func DataSourceThroughputTiersSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"cloud_provider": schema.StringAttribute{
-				Optional:    true,
-				Description: "Cloud provider where the Throughput Tiers are available",
-				Validators:  validators.CloudProviders(),
+				Optional:            true,
+				MarkdownDescription: "Cloud provider where the Throughput Tiers are available",
+				Validators:          validators.CloudProviders(),
 			},
 			"throughput_tiers": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"cloud_provider": schema.StringAttribute{
-							Computed:    true,
-							Description: "Cloud provider where the Throughput Tier is available",
+							Computed:            true,
+							MarkdownDescription: "Cloud provider where the Throughput Tier is available",
 						},
 						"display_name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Display name of the Throughput Tier",
+							Computed:            true,
+							MarkdownDescription: "Display name of the Throughput Tier",
 						},
 						"name": schema.StringAttribute{
-							Computed:    true,
-							Description: "Unique name of the Throughput Tier",
+							Computed:            true,
+							MarkdownDescription: "Unique name of the Throughput Tier",
 						},
 					},
 				},
-				Description: "Throughput Tiers",
+				MarkdownDescription: "Throughput Tiers",
 			},
 		},
-		Description: "Data source for a list of Redpanda Cloud throughput tiers",
+		MarkdownDescription: "Data source for a list of Redpanda Cloud throughput tiers",
 	}
 }
 
@@ -130,16 +129,8 @@ func (r *DataSourceThroughputTiers) Read(ctx context.Context, req datasource.Rea
 
 // Configure uses provider level data to configure DataSourceThroughputTiers client.
 func (r *DataSourceThroughputTiers) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-
-	p, ok := request.ProviderData.(config.Datasource)
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	r.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
@@ -0,0 +1,156 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+const (
+	accessRoleReader = "reader"
+	accessRoleWriter = "writer"
+	accessRoleAdmin  = "admin"
+
+	// accessHost is the host of the ACLs created for the access block.
+	accessHost = "*"
+)
+
+// accessRoleOperations are the operations granted on the topic by each role
+// of the access block.
+var accessRoleOperations = map[string][]dataplanev1alpha2.ACL_Operation{
+	accessRoleReader: {dataplanev1alpha2.ACL_OPERATION_READ, dataplanev1alpha2.ACL_OPERATION_DESCRIBE},
+	accessRoleWriter: {dataplanev1alpha2.ACL_OPERATION_WRITE, dataplanev1alpha2.ACL_OPERATION_DESCRIBE},
+	accessRoleAdmin:  {dataplanev1alpha2.ACL_OPERATION_ALL},
+}
+
+// accessRule is an ACL granted on the topic by the access block.
+type accessRule struct {
+	principal string
+	operation dataplanev1alpha2.ACL_Operation
+}
+
+// accessRules expands the access entries into the ACLs they grant, sorted and
+// without duplicates since several roles grant the same operations.
+func accessRules(access []models.TopicAccess) []accessRule {
+	var rules []accessRule
+	for _, a := range access {
+		for _, op := range accessRoleOperations[a.Role.ValueString()] {
+			rules = append(rules, accessRule{principal: a.Principal.ValueString(), operation: op})
+		}
+	}
+	slices.SortFunc(rules, func(a, b accessRule) int {
+		if a.principal != b.principal {
+			if a.principal < b.principal {
+				return -1
+			}
+			return 1
+		}
+		return int(a.operation) - int(b.operation)
+	})
+	return slices.Compact(rules)
+}
+
+// syncAccess creates and deletes the ACLs of the topic so that the ACLs
+// granted by the from access entries become the ones granted by to.
+func syncAccess(ctx context.Context, client dataplanev1alpha2grpc.ACLServiceClient, topic string, from, to []models.TopicAccess) error {
+	current, wanted := accessRules(from), accessRules(to)
+	for _, r := range current {
+		if slices.Contains(wanted, r) {
+			continue
+		}
+		_, err := client.DeleteACLs(ctx, &dataplanev1alpha2.DeleteACLsRequest{
+			Filter: &dataplanev1alpha2.DeleteACLsRequest_Filter{
+				ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+				ResourceName:        utils.StringToStringPointer(topic),
+				ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+				Principal:           utils.StringToStringPointer(r.principal),
+				Host:                utils.StringToStringPointer(accessHost),
+				Operation:           r.operation,
+				PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("unable to delete the %s ACL of %s: %v", r.operation, r.principal, err)
+		}
+	}
+	for _, r := range wanted {
+		if slices.Contains(current, r) {
+			continue
+		}
+		_, err := client.CreateACL(ctx, &dataplanev1alpha2.CreateACLRequest{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName:        topic,
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Principal:           r.principal,
+			Host:                accessHost,
+			Operation:           r.operation,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to create the %s ACL of %s: %v", r.operation, r.principal, err)
+		}
+	}
+	return nil
+}
+
+// readAccess returns the access entries whose ACLs all exist on the topic, so
+// that entries whose ACLs were deleted outside of Terraform show up as drift.
+func readAccess(ctx context.Context, client dataplanev1alpha2grpc.ACLServiceClient, topic string, access []models.TopicAccess) ([]models.TopicAccess, error) {
+	if access == nil {
+		return nil, nil
+	}
+	list, err := client.ListACLs(ctx, &dataplanev1alpha2.ListACLsRequest{
+		Filter: &dataplanev1alpha2.ListACLsRequest_Filter{
+			ResourceType:        dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName:        utils.StringToStringPointer(topic),
+			ResourcePatternType: dataplanev1alpha2.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Operation:           dataplanev1alpha2.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var existing []accessRule
+	for _, res := range list.GetResources() {
+		if res.GetResourceName() != topic {
+			continue
+		}
+		for _, acl := range res.GetAcls() {
+			if acl.GetHost() == accessHost {
+				existing = append(existing, accessRule{principal: acl.GetPrincipal(), operation: acl.GetOperation()})
+			}
+		}
+	}
+	found := []models.TopicAccess{}
+	for _, a := range access {
+		granted := true
+		for _, r := range accessRules([]models.TopicAccess{a}) {
+			granted = granted && slices.Contains(existing, r)
+		}
+		if granted {
+			found = append(found, a)
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,74 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func access(principal, role string) models.TopicAccess {
+	return models.TopicAccess{Principal: types.StringValue(principal), Role: types.StringValue(role)}
+}
+
+func TestSyncAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockACLServiceClient(ctrl)
+
+	var deleted, created []dataplanev1alpha2.ACL_Operation
+	client.EXPECT().DeleteACLs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.DeleteACLsRequest, _ ...any) (*dataplanev1alpha2.DeleteACLsResponse, error) {
+		assert.Equal(t, "orders", req.Filter.GetResourceName())
+		assert.Equal(t, "User:alice", req.Filter.GetPrincipal())
+		deleted = append(deleted, req.Filter.Operation)
+		return &dataplanev1alpha2.DeleteACLsResponse{}, nil
+	}).AnyTimes()
+	client.EXPECT().CreateACL(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.CreateACLRequest, _ ...any) (*dataplanev1alpha2.CreateACLResponse, error) {
+		assert.Equal(t, "orders", req.ResourceName)
+		assert.Equal(t, "User:bob", req.Principal)
+		assert.Equal(t, dataplanev1alpha2.ACL_PERMISSION_TYPE_ALLOW, req.PermissionType)
+		created = append(created, req.Operation)
+		return &dataplanev1alpha2.CreateACLResponse{}, nil
+	}).AnyTimes()
+
+	// alice keeps DESCRIBE, which writer also grants
+	err := syncAccess(context.Background(), client, "orders",
+		[]models.TopicAccess{access("User:alice", accessRoleReader), access("User:alice", accessRoleWriter)},
+		[]models.TopicAccess{access("User:alice", accessRoleWriter), access("User:bob", accessRoleAdmin)},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []dataplanev1alpha2.ACL_Operation{dataplanev1alpha2.ACL_OPERATION_READ}, deleted)
+	assert.Equal(t, []dataplanev1alpha2.ACL_Operation{dataplanev1alpha2.ACL_OPERATION_ALL}, created)
+}
+
+func TestReadAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockACLServiceClient(ctrl)
+	client.EXPECT().ListACLs(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListACLsResponse{
+		Resources: []*dataplanev1alpha2.ListACLsResponse_Resource{{
+			ResourceType: dataplanev1alpha2.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName: "orders",
+			Acls: []*dataplanev1alpha2.ListACLsResponse_Policy{
+				{Principal: "User:alice", Host: "*", Operation: dataplanev1alpha2.ACL_OPERATION_READ},
+				{Principal: "User:alice", Host: "*", Operation: dataplanev1alpha2.ACL_OPERATION_DESCRIBE},
+				// bob's WRITE ACL was deleted outside of Terraform
+				{Principal: "User:bob", Host: "*", Operation: dataplanev1alpha2.ACL_OPERATION_DESCRIBE},
+			},
+		}},
+	}, nil)
+
+	got, err := readAccess(context.Background(), client, "orders",
+		[]models.TopicAccess{access("User:alice", accessRoleReader), access("User:bob", accessRoleWriter)},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []models.TopicAccess{access("User:alice", accessRoleReader)}, got)
+
+	got, err = readAccess(context.Background(), client, "orders", nil)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
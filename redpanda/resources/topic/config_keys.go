@@ -0,0 +1,136 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+)
+
+// maxKeySuggestions is the maximum number of keys suggested for an unknown
+// configuration key.
+const maxKeySuggestions = 3
+
+// supportedKeys caches the topic configuration keys supported by each
+// cluster, keyed by cluster API URL, for the lifetime of the provider.
+var supportedKeys = &keyCache{keys: map[string][]string{}}
+
+type keyCache struct {
+	mu   sync.Mutex
+	keys map[string][]string
+}
+
+// get returns the configuration keys supported by the cluster, fetching them
+// on the first call. It returns nil keys when the cluster has no topic to
+// read the keys from.
+func (c *keyCache) get(ctx context.Context, clusterURL string, client dataplanev1alpha2grpc.TopicServiceClient) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if keys, ok := c.keys[clusterURL]; ok {
+		return keys, nil
+	}
+	keys, err := fetchSupportedKeys(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if keys != nil {
+		c.keys[clusterURL] = keys
+	}
+	return keys, nil
+}
+
+// fetchSupportedKeys returns the sorted configuration keys of an existing
+// topic, which the API reports for every key supported by the cluster
+// including the ones left to their default.
+func fetchSupportedKeys(ctx context.Context, client dataplanev1alpha2grpc.TopicServiceClient) ([]string, error) {
+	list, err := client.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageSize: 1})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list topics: %v", err)
+	}
+	if len(list.GetTopics()) == 0 {
+		return nil, nil
+	}
+	name := list.GetTopics()[0].GetName()
+	cfg, err := client.GetTopicConfigurations(ctx, &dataplanev1alpha2.GetTopicConfigurationsRequest{TopicName: name})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the configuration of topic %q: %v", name, err)
+	}
+	var keys []string
+	for _, c := range cfg.GetConfigurations() {
+		keys = append(keys, c.GetName())
+	}
+	sort.Strings(keys)
+	return slices.Compact(keys), nil
+}
+
+// unsupportedKeyDetail returns the detail of the error for a configuration
+// key the cluster does not support, suggesting the closest supported keys.
+func unsupportedKeyDetail(key string, supported []string) string {
+	detail := fmt.Sprintf("%q is not a topic configuration supported by the cluster", key)
+	if s := suggestKeys(key, supported); len(s) > 0 {
+		detail += fmt.Sprintf(" (did you mean %s?)", strings.Join(s, " or "))
+	}
+	return detail
+}
+
+// suggestKeys returns the supported keys closest to key by edit distance,
+// ignoring the ones that differ by more than half of the key.
+func suggestKeys(key string, supported []string) []string {
+	type candidate struct {
+		key      string
+		distance int
+	}
+	var candidates []candidate
+	for _, s := range supported {
+		if d := levenshtein(key, s); d <= len(key)/2 {
+			candidates = append(candidates, candidate{s, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	var keys []string
+	for i := 0; i < len(candidates) && i < maxKeySuggestions; i++ {
+		keys = append(keys, candidates[i].key)
+	}
+	return keys
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,115 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSupportedKeys = []string{
+	"cleanup.policy",
+	"compression.type",
+	"max.message.bytes",
+	"retention.bytes",
+	"retention.local.target.ms",
+	"retention.ms",
+	"segment.bytes",
+}
+
+func TestSuggestKeys(t *testing.T) {
+	tests := []struct {
+		key  string
+		want []string
+	}{
+		{key: "retention.hours", want: []string{"retention.bytes", "retention.ms"}},
+		{key: "cleanup.polcy", want: []string{"cleanup.policy"}},
+		{key: "compresion.type", want: []string{"compression.type"}},
+		{key: "foo", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.want, suggestKeys(tt.key, testSupportedKeys))
+		})
+	}
+}
+
+func topicPlan(ctx context.Context, t *testing.T, clusterURL string, configuration map[string]string) tfsdk.Plan {
+	t.Helper()
+	s := resourceTopicSchema()
+	cfg := map[string]tftypes.Value{}
+	for k, v := range configuration {
+		cfg[k] = tftypes.NewValue(tftypes.String, v)
+	}
+	attrs := map[string]tftypes.Value{}
+	for name, typ := range s.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes {
+		attrs[name] = tftypes.NewValue(typ, nil)
+	}
+	attrs["name"] = tftypes.NewValue(tftypes.String, "orders")
+	attrs["cluster_api_url"] = tftypes.NewValue(tftypes.String, clusterURL)
+	attrs["configuration"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, cfg)
+	return tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), attrs)}
+}
+
+func TestModifyPlanConfigurationKeys(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		config     map[string]string
+		topics     []*dataplanev1alpha2.ListTopicsResponse_Topic
+		wantErrors []string
+	}{
+		{
+			name:   "supported keys",
+			config: map[string]string{"retention.ms": "1000", "cleanup.policy": "compact"},
+			topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "existing"}},
+		},
+		{
+			name:       "typo",
+			config:     map[string]string{"retention.hours": "24"},
+			topics:     []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "existing"}},
+			wantErrors: []string{`"retention.hours" is not a topic configuration supported by the cluster (did you mean retention.bytes or retention.ms?)`},
+		},
+		{
+			name:   "no topic to read the keys from",
+			config: map[string]string{"retention.hours": "24"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := mocks.NewMockTopicServiceClient(ctrl)
+			client.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{Topics: tt.topics}, nil)
+			if len(tt.topics) > 0 {
+				var cfg []*dataplanev1alpha2.Topic_Configuration
+				for _, k := range testSupportedKeys {
+					cfg = append(cfg, &dataplanev1alpha2.Topic_Configuration{Name: k})
+				}
+				client.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.GetTopicConfigurationsResponse{Configurations: cfg}, nil)
+			}
+
+			// each case uses its own cluster so that the cached keys don't leak
+			plan := topicPlan(ctx, t, "api-"+string(rune('a'+i))+".cluster.redpanda.com:443", tt.config)
+			s := resourceTopicSchema()
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			tp := &Topic{TopicClient: client}
+			tp.ModifyPlan(ctx, resource.ModifyPlanRequest{
+				Plan:  plan,
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}, resp)
+
+			var got []string
+			for _, d := range resp.Diagnostics.Errors() {
+				got = append(got, d.Detail())
+			}
+			require.Equal(t, tt.wantErrors, got)
+		})
+	}
+}
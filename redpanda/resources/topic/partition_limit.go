@@ -0,0 +1,122 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+)
+
+// partitionLimitWarningRatio is the share of the partition limit of the
+// throughput tier of a cluster above which adding partitions warns.
+const partitionLimitWarningRatio = 0.8
+
+// partitionLimitWarning returns a warning when the partitions of the cluster,
+// used before the plan and added by it, reach the warning ratio of the
+// partition limit of its tier, or an empty string otherwise.
+func partitionLimitWarning(used, added, limit int64) string {
+	total := used + added
+	if limit <= 0 || float64(total) < partitionLimitWarningRatio*float64(limit) {
+		return ""
+	}
+	if total > limit {
+		return fmt.Sprintf("The cluster would have %d partitions, over the limit of %d partitions of its throughput tier, "+
+			"so creating the topic is likely to fail. Consider a larger throughput tier or fewer partitions.", total, limit)
+	}
+	return fmt.Sprintf("The cluster would have %d partitions, %d%% of the limit of %d partitions of its throughput tier.",
+		total, total*100/limit, limit)
+}
+
+// countPartitions returns the number of partitions of the topics of the
+// cluster, without replication.
+func countPartitions(ctx context.Context, client dataplanev1alpha2grpc.TopicServiceClient) (int64, error) {
+	var count int64
+	var pageToken string
+	for {
+		list, err := client.ListTopics(ctx, &dataplanev1alpha2.ListTopicsRequest{PageToken: pageToken})
+		if err != nil {
+			return 0, fmt.Errorf("unable to list topics: %v", err)
+		}
+		for _, topic := range list.GetTopics() {
+			count += int64(topic.GetPartitionCount())
+		}
+		pageToken = list.GetNextPageToken()
+		if pageToken == "" || len(list.GetTopics()) == 0 {
+			return count, nil
+		}
+	}
+}
+
+// tierPartitionLimit returns the partition limit of the throughput tier of
+// the cluster with the given cluster API URL.
+func tierPartitionLimit(ctx context.Context, cpCl *cloud.ControlPlaneClientSet, clusterURL string) (int64, error) {
+	cluster, err := cpCl.ClusterForAPIURL(ctx, clusterURL)
+	if err != nil {
+		return 0, err
+	}
+	tier, err := cpCl.ThroughputTier.GetThroughputTier(ctx, &controlplanev1beta2.GetThroughputTierRequest{Name: cluster.GetThroughputTier()})
+	if err != nil {
+		return 0, fmt.Errorf("unable to get throughput tier %q: %v", cluster.GetThroughputTier(), err)
+	}
+	return int64(tier.GetThroughputTier().GetMaxPartitionCount()), nil
+}
+
+// warnPartitionLimit warns when adding partitions to the cluster brings it
+// close to or over the partition limit of its throughput tier. The connection
+// opened to count the partitions is closed before returning.
+func (t *Topic) warnPartitionLimit(ctx context.Context, clusterURL string, added int64, response *resource.ModifyPlanResponse) {
+	if added <= 0 || t.resData.ControlPlaneConnection == nil {
+		return
+	}
+	limit, err := tierPartitionLimit(ctx, cloud.NewControlPlaneClientSet(t.resData.ControlPlaneConnection), clusterURL)
+	if err != nil {
+		// the warning is informative only, it must not block the plan
+		tflog.Warn(ctx, "unable to get the partition limit of the cluster", map[string]any{"error": err.Error()})
+		return
+	}
+	if limit <= 0 {
+		return
+	}
+	used, err := t.countPartitions(ctx, clusterURL)
+	if err != nil {
+		tflog.Warn(ctx, "unable to count the partitions of the cluster", map[string]any{"error": err.Error()})
+		return
+	}
+	if w := partitionLimitWarning(used, added, limit); w != "" {
+		response.Diagnostics.AddAttributeWarning(path.Root("partition_count"), "partition limit", w)
+	}
+}
+
+func (t *Topic) countPartitions(ctx context.Context, clusterURL string) (int64, error) {
+	if t.TopicClient == nil {
+		if err := t.createTopicClient(clusterURL); err != nil {
+			return 0, err
+		}
+		defer func() {
+			t.dataplaneConn.Close()
+			t.dataplaneConn, t.TopicClient, t.ACLClient = nil, nil, nil
+		}()
+	}
+	return countPartitions(ctx, t.TopicClient)
+}
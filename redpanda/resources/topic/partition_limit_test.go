@@ -0,0 +1,60 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionLimitWarning(t *testing.T) {
+	tests := []struct {
+		name        string
+		used, added int64
+		limit       int64
+		want        string
+	}{
+		{name: "below the warning ratio", used: 500, added: 200, limit: 1000},
+		{name: "no limit", used: 5000, added: 200},
+		{
+			name: "close to the limit", used: 700, added: 150, limit: 1000,
+			want: "The cluster would have 850 partitions, 85% of the limit of 1000 partitions of its throughput tier.",
+		},
+		{
+			name: "over the limit", used: 950, added: 100, limit: 1000,
+			want: "The cluster would have 1050 partitions, over the limit of 1000 partitions of its throughput tier, so creating the topic is likely to fail. Consider a larger throughput tier or fewer partitions.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, partitionLimitWarning(tt.used, tt.added, tt.limit))
+		})
+	}
+}
+
+func TestCountPartitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockTopicServiceClient(ctrl)
+	gomock.InOrder(
+		client.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+			Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{
+				{Name: "orders", PartitionCount: 12, ReplicationFactor: 3},
+				{Name: "_schemas", PartitionCount: 1, ReplicationFactor: 3, Internal: true},
+			},
+			NextPageToken: "next",
+		}, nil),
+		client.EXPECT().ListTopics(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.ListTopicsRequest, _ ...any) (*dataplanev1alpha2.ListTopicsResponse, error) {
+			assert.Equal(t, "next", req.GetPageToken())
+			return &dataplanev1alpha2.ListTopicsResponse{
+				Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "payments", PartitionCount: 6, ReplicationFactor: 3}},
+			}, nil
+		}),
+	)
+	count, err := countPartitions(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, int64(19), count)
+}
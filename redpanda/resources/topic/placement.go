@@ -0,0 +1,100 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+)
+
+// leadersPreferenceKey is the topic configuration key pinning the partition
+// leaders of the topic to racks, which are the zones of a Redpanda Cloud
+// cluster.
+const leadersPreferenceKey = "redpanda.leaders.preference"
+
+// parseLeadersPreference returns the racks of a racks:<rack>[,<rack>...]
+// leaders preference. Other values, none as well as the formats of newer
+// brokers such as ordered_racks:, have no racks to check and are left to the
+// broker to validate.
+func parseLeadersPreference(v string) ([]string, error) {
+	list, ok := strings.CutPrefix(v, "racks:")
+	if !ok {
+		return nil, nil
+	}
+	var racks []string
+	for _, r := range strings.Split(list, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			return nil, fmt.Errorf("%s has an empty rack in %q", leadersPreferenceKey, v)
+		}
+		racks = append(racks, r)
+	}
+	return racks, nil
+}
+
+// unknownRacks returns a description of the racks that are not zones of the
+// cluster, or an empty string if all are.
+func unknownRacks(racks, zones []string) string {
+	var unknown []string
+	for _, r := range racks {
+		if !slices.Contains(zones, r) {
+			unknown = append(unknown, r)
+		}
+	}
+	if len(unknown) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("racks %s are not zones of the cluster, use some of: %s", strings.Join(unknown, ", "), strings.Join(zones, ", "))
+}
+
+// validatePlanPlacement checks the racks of a new or changed racks: leaders
+// preference, first their syntax and then against the zones of the cluster.
+func (t *Topic) validatePlanPlacement(ctx context.Context, clusterURL string, planned types.Map, current map[string]string, response *resource.ModifyPlanResponse) {
+	pref, ok := planned.Elements()[leadersPreferenceKey].(types.String)
+	if !ok || pref.IsNull() || pref.IsUnknown() {
+		return
+	}
+	v := pref.ValueString()
+	if c, ok := current[leadersPreferenceKey]; ok && c == v {
+		return
+	}
+	attr := path.Root("configuration").AtMapKey(leadersPreferenceKey)
+	racks, err := parseLeadersPreference(v)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(attr, "invalid leaders preference", err.Error())
+		return
+	}
+	if len(racks) == 0 || t.resData.ControlPlaneConnection == nil {
+		return
+	}
+	cluster, err := cloud.NewControlPlaneClientSet(t.resData.ControlPlaneConnection).ClusterForAPIURL(ctx, clusterURL)
+	if err != nil {
+		// the check is best effort, the cluster validates the racks again on apply
+		tflog.Warn(ctx, "unable to check the leaders preference against the cluster zones", map[string]any{"error": err.Error()})
+		return
+	}
+	if detail := unknownRacks(racks, cluster.GetZones()); detail != "" {
+		response.Diagnostics.AddAttributeError(attr, "invalid leaders preference", detail)
+	}
+}
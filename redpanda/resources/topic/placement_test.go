@@ -0,0 +1,40 @@
+package topic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLeadersPreference(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{value: "none"},
+		{value: "racks:use2-az1", want: []string{"use2-az1"}},
+		{value: "racks:use2-az1, use2-az2", want: []string{"use2-az1", "use2-az2"}},
+		{value: "racks:", wantErr: true},
+		{value: "racks:use2-az1,,use2-az2", wantErr: true},
+		{value: "ordered_racks:use2-az1,use2-az2"},
+		{value: "use2-az1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseLeadersPreference(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnknownRacks(t *testing.T) {
+	zones := []string{"use2-az1", "use2-az2", "use2-az3"}
+	assert.Empty(t, unknownRacks([]string{"use2-az1", "use2-az3"}, zones))
+	assert.Equal(t, "racks us-east-2a are not zones of the cluster, use some of: use2-az1, use2-az2, use2-az3", unknownRacks([]string{"use2-az1", "us-east-2a"}, zones))
+}
@@ -18,18 +18,25 @@ package topic
 import (
 	"context"
 	"fmt"
+	"math"
+	"slices"
 	"strings"
 
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
@@ -39,14 +46,18 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &Topic{}
-	_ resource.ResourceWithConfigure   = &Topic{}
-	_ resource.ResourceWithImportState = &Topic{}
+	_ resource.Resource                 = &Topic{}
+	_ resource.ResourceWithConfigure    = &Topic{}
+	_ resource.ResourceWithImportState  = &Topic{}
+	_ resource.ResourceWithUpgradeState = &Topic{}
+	_ resource.ResourceWithIdentity     = &Topic{}
+	_ resource.ResourceWithModifyPlan   = &Topic{}
 )
 
 // Topic represents the Topic Terraform resource.
 type Topic struct {
 	TopicClient dataplanev1alpha2grpc.TopicServiceClient
+	ACLClient   dataplanev1alpha2grpc.ACLServiceClient
 
 	resData       config.Resource
 	dataplaneConn *grpc.ClientConn
@@ -54,15 +65,8 @@ type Topic struct {
 
 // Configure configures the Topic resource.
 func (t *Topic) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
-	if request.ProviderData == nil {
-		return
-	}
-	p, ok := request.ProviderData.(config.Resource)
+	p, ok := config.ResourceData(request.ProviderData, &response.Diagnostics)
 	if !ok {
-		response.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", request.ProviderData),
-		)
 		return
 	}
 	t.resData = p
@@ -75,52 +79,77 @@ func (*Topic) Metadata(_ context.Context, _ resource.MetadataRequest, response *
 
 func resourceTopicSchema() schema.Schema {
 	return schema.Schema{
-		Description: "Topic represents a Kafka topic configuration",
+		MarkdownDescription: "Topic represents a Kafka topic configuration",
+		Version:             1,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description:   "The name of the topic.",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "The name of the topic.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
-			"partition_count": schema.NumberAttribute{
-				Description: "The number of partitions for the topic. This determines how the data is distributed across brokers.",
-				Optional:    true,
-				Computed:    true,
-				PlanModifiers: []planmodifier.Number{
-					numberplanmodifier.RequiresReplace(),
-					numberplanmodifier.UseStateForUnknown(),
+			"partition_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of partitions for the topic. This determines how the data is distributed across brokers. Plans adding partitions warn when the cluster would reach 80% of the partition limit of its throughput tier.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, math.MaxInt32)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
-			"replication_factor": schema.NumberAttribute{
-				Description: "The replication factor for the topic, which defines how many copies of the data are kept across different brokers for fault tolerance.",
-				Optional:    true,
-				Computed:    true,
-				PlanModifiers: []planmodifier.Number{
-					numberplanmodifier.RequiresReplace(),
-					numberplanmodifier.UseStateForUnknown(),
+			"replication_factor": schema.Int64Attribute{
+				MarkdownDescription: "The replication factor for the topic, which defines how many copies of the data are kept across different brokers for fault tolerance.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, math.MaxInt32)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"allow_deletion": schema.BoolAttribute{
-				Description: "Indicates whether the topic can be deleted.",
-				Optional:    true,
+				MarkdownDescription: "Indicates whether the topic can be deleted.",
+				Optional:            true,
 			},
 			"configuration": schema.MapAttribute{
-				ElementType:   types.StringType,
-				Description:   "A map of string key/value pairs of topic configurations.",
-				Optional:      true,
-				Computed:      true,
-				PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+				ElementType:         types.StringType,
+				MarkdownDescription: "A map of string key/value pairs of topic configurations. Keys not supported by the cluster fail the plan.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
 			},
 			"cluster_api_url": schema.StringAttribute{
 				Required: true,
-				Description: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
 					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
 					"change this value unless you are planning to do state imports",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "Identifier of the topic, equal to its name",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"access": schema.SetNestedAttribute{
+				MarkdownDescription: "Principals granted access to the topic. Each entry is expanded into literal ACLs on the topic, " +
+					"allowed from any host, which are created and deleted together with the topic: reader grants READ and DESCRIBE, " +
+					"writer grants WRITE and DESCRIBE, and admin grants ALL.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"principal": schema.StringAttribute{
+							MarkdownDescription: "The principal to grant access to, e.g. User:alice.",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "The role granted to the principal: reader, writer or admin.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(accessRoleReader, accessRoleWriter, accessRoleAdmin),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -131,6 +160,96 @@ func (*Topic) Schema(_ context.Context, _ resource.SchemaRequest, response *reso
 	response.Schema = resourceTopicSchema()
 }
 
+// IdentitySchema returns the identity schema for the Topic resource.
+func (*Topic) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, response *resource.IdentitySchemaResponse) {
+	response.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the topic.",
+			},
+			"cluster_api_url": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The cluster API URL of the cluster holding the topic.",
+			},
+		},
+	}
+}
+
+// ModifyPlan checks the configuration keys added to the topic against the
+// keys supported by the cluster, so that typos fail the plan rather than the
+// apply, and the racks its leaders are pinned to against the cluster zones.
+// It also warns when the partitions added to the cluster bring it close to
+// the partition limit of its throughput tier.
+func (t *Topic) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.Topic
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() || plan.ClusterAPIURL.IsUnknown() {
+		return
+	}
+	current := map[string]string{}
+	var statePartitions int64
+	if !request.State.Raw.IsNull() {
+		var state models.Topic
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		current = utils.TypeMapToStringMap(state.Configuration)
+		statePartitions = state.PartitionCount.ValueInt64()
+	}
+	if !plan.PartitionCount.IsUnknown() {
+		t.warnPartitionLimit(ctx, plan.ClusterAPIURL.ValueString(), plan.PartitionCount.ValueInt64()-statePartitions, response)
+	}
+	if plan.Configuration.IsNull() || plan.Configuration.IsUnknown() {
+		return
+	}
+	t.validatePlanPlacement(ctx, plan.ClusterAPIURL.ValueString(), plan.Configuration, current, response)
+	var added []string
+	for k := range plan.Configuration.Elements() {
+		if _, ok := current[k]; !ok {
+			added = append(added, k)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	supported, err := t.supportedKeys(ctx, plan.ClusterAPIURL.ValueString())
+	if err != nil {
+		// the check is best effort, the API validates the keys again on apply
+		tflog.Warn(ctx, "unable to fetch the topic configuration keys supported by the cluster", map[string]any{"error": err.Error()})
+		return
+	}
+	if supported == nil {
+		return
+	}
+	slices.Sort(added)
+	for _, k := range added {
+		if _, found := slices.BinarySearch(supported, k); !found {
+			response.Diagnostics.AddAttributeError(path.Root("configuration").AtMapKey(k), "unsupported topic configuration", unsupportedKeyDetail(k, supported))
+		}
+	}
+}
+
+// supportedKeys returns the configuration keys supported by the cluster. The
+// connection opened to fetch them is closed before returning.
+func (t *Topic) supportedKeys(ctx context.Context, clusterURL string) ([]string, error) {
+	if t.TopicClient == nil {
+		if err := t.createTopicClient(clusterURL); err != nil {
+			return nil, err
+		}
+		defer func() {
+			t.dataplaneConn.Close()
+			t.dataplaneConn, t.TopicClient, t.ACLClient = nil, nil, nil
+		}()
+	}
+	return supportedKeys.get(ctx, clusterURL, t.TopicClient)
+}
+
 // Create creates a Topic resource.
 func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var model models.Topic
@@ -146,13 +265,23 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		response.Diagnostics.AddError("failed to create topic client", err.Error())
 		return
 	}
-	defer t.dataplaneConn.Close()
+	defer t.closeConn()
 	var p, rf *int32
 	if !model.PartitionCount.IsUnknown() {
-		p = utils.NumberToInt32(model.PartitionCount)
+		v, err := utils.Int64ToInt32(model.PartitionCount.ValueInt64())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("partition_count"), "invalid partition count", err.Error())
+			return
+		}
+		p = &v
 	}
 	if !model.ReplicationFactor.IsUnknown() {
-		rf = utils.NumberToInt32(model.ReplicationFactor)
+		v, err := utils.Int64ToInt32(model.ReplicationFactor.ValueInt64())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("replication_factor"), "invalid replication factor", err.Error())
+			return
+		}
+		rf = &v
 	}
 	topic, err := t.TopicClient.CreateTopic(ctx, &dataplanev1alpha2.CreateTopicRequest{
 		Topic: &dataplanev1alpha2.CreateTopicRequest_Topic{
@@ -166,7 +295,7 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		if isAlreadyExistsError(err) {
 			response.Diagnostics.AddError(
 				fmt.Sprintf("Failed to create topic; topic %q already exists", model.Name.ValueString()),
-				"Topic resource can be imported using 'terraform import redpanda_topic.<resource_name> <topic_name>,<cluster_id>'",
+				"Topic resource can be imported using 'terraform import redpanda_topic.<resource_name> <cluster_id>/<topic_name>'",
 			)
 			return
 		}
@@ -185,14 +314,26 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		response.Diagnostics.AddError("unable to parse the topic configuration", err.Error())
 		return
 	}
+	access := model.Access
+	if err := syncAccess(ctx, t.ACLClient, topic.Name, nil, access); err != nil {
+		// keep track of the topic, the access entries are granted again on
+		// the next apply.
+		response.Diagnostics.AddError(fmt.Sprintf("failed to grant access to topic %q", topic.Name), err.Error())
+		access = nil
+	}
 	response.Diagnostics.Append(response.State.Set(ctx, models.Topic{
 		Name:              types.StringValue(topic.Name),
-		PartitionCount:    utils.Int32ToNumber(topic.PartitionCount),
-		ReplicationFactor: utils.Int32ToNumber(topic.ReplicationFactor),
+		PartitionCount:    types.Int64Value(int64(topic.PartitionCount)),
+		ReplicationFactor: types.Int64Value(int64(topic.ReplicationFactor)),
 		Configuration:     tpCfgMap,
 		AllowDeletion:     model.AllowDeletion,
 		ClusterAPIURL:     model.ClusterAPIURL,
 		ID:                types.StringValue(topic.Name),
+		Access:            access,
+	})...)
+	response.Diagnostics.Append(utils.SetIdentity(ctx, response.Identity, models.TopicIdentity{
+		Name:          types.StringValue(topic.Name),
+		ClusterAPIURL: model.ClusterAPIURL,
 	})...)
 }
 
@@ -200,12 +341,15 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 func (t *Topic) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
 	var model models.Topic
 	response.Diagnostics.Append(request.State.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	err := t.createTopicClient(model.ClusterAPIURL.ValueString())
 	if err != nil {
 		response.Diagnostics.AddError("failed to create topic client", err.Error())
 		return
 	}
-	defer t.dataplaneConn.Close()
+	defer t.closeConn()
 	tp, err := utils.FindTopicByName(ctx, model.Name.ValueString(), t.TopicClient)
 	if err != nil {
 		if utils.IsNotFound(err) {
@@ -217,6 +361,11 @@ func (t *Topic) Read(ctx context.Context, request resource.ReadRequest, response
 	}
 	tpCfgRes, err := t.TopicClient.GetTopicConfigurations(ctx, &dataplanev1alpha2.GetTopicConfigurationsRequest{TopicName: tp.Name})
 	if err != nil {
+		if utils.IsNotFound(err) {
+			// deleted since it was listed
+			response.State.RemoveResource(ctx)
+			return
+		}
 		response.Diagnostics.AddError(fmt.Sprintf("failed to retrieve %q topic configuration", tp.Name), err.Error())
 		return
 	}
@@ -226,14 +375,24 @@ func (t *Topic) Read(ctx context.Context, request resource.ReadRequest, response
 		response.Diagnostics.AddError("unable to parse the topic configuration", err.Error())
 		return
 	}
+	access, err := readAccess(ctx, t.ACLClient, tp.Name, model.Access)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to list the ACLs of topic %q", tp.Name), err.Error())
+		return
+	}
 	response.Diagnostics.Append(response.State.Set(ctx, models.Topic{
 		Name:              types.StringValue(tp.Name),
-		PartitionCount:    utils.Int32ToNumber(tp.PartitionCount),
-		ReplicationFactor: utils.Int32ToNumber(tp.ReplicationFactor),
+		PartitionCount:    types.Int64Value(int64(tp.PartitionCount)),
+		ReplicationFactor: types.Int64Value(int64(tp.ReplicationFactor)),
 		Configuration:     topicCfg,
 		AllowDeletion:     model.AllowDeletion,
 		ClusterAPIURL:     model.ClusterAPIURL,
 		ID:                types.StringValue(tp.Name),
+		Access:            access,
+	})...)
+	response.Diagnostics.Append(utils.SetIdentity(ctx, response.Identity, models.TopicIdentity{
+		Name:          types.StringValue(tp.Name),
+		ClusterAPIURL: model.ClusterAPIURL,
 	})...)
 }
 
@@ -247,7 +406,7 @@ func (t *Topic) Update(ctx context.Context, request resource.UpdateRequest, resp
 		response.Diagnostics.AddError("failed to create topic client", err.Error())
 		return
 	}
-	defer t.dataplaneConn.Close()
+	defer t.closeConn()
 	if !plan.Configuration.Equal(state.Configuration) {
 		cfgToSet, err := utils.MapToSetTopicConfiguration(plan.Configuration)
 		if err != nil {
@@ -259,10 +418,21 @@ func (t *Topic) Update(ctx context.Context, request resource.UpdateRequest, resp
 			Configurations: cfgToSet,
 		})
 		if err != nil {
+			if utils.IsNotFound(err) {
+				response.Diagnostics.AddError(
+					fmt.Sprintf("topic %q was deleted outside of Terraform", plan.Name.ValueString()),
+					"Run terraform apply again to recreate it.",
+				)
+				return
+			}
 			response.Diagnostics.AddError("failed to update topic configuration", err.Error())
 			return
 		}
 	}
+	if err := syncAccess(ctx, t.ACLClient, plan.Name.ValueString(), state.Access, plan.Access); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to update access to topic %q", plan.Name.ValueString()), err.Error())
+		return
+	}
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
@@ -279,28 +449,44 @@ func (t *Topic) Delete(ctx context.Context, request resource.DeleteRequest, resp
 		response.Diagnostics.AddError("failed to create topic client", err.Error())
 		return
 	}
-	defer t.dataplaneConn.Close()
+	defer t.closeConn()
+	// ACLs outlive the topics they are granted on, so remove them first.
+	if err := syncAccess(ctx, t.ACLClient, model.Name.ValueString(), model.Access, nil); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to revoke access to topic %s", model.Name), err.Error())
+		return
+	}
 	_, err = t.TopicClient.DeleteTopic(ctx, &dataplanev1alpha2.DeleteTopicRequest{
 		Name: model.Name.ValueString(),
 	})
-	if err != nil {
+	if err != nil && !utils.IsNotFound(err) {
 		response.Diagnostics.AddError(fmt.Sprintf("failed to delete topic %s", model.Name), err.Error())
 	}
 }
 
-// ImportState imports the state of the Topic resource.
+// ImportState imports the state of the Topic resource, either from an ID of
+// the form <cluster_id>/<topic_name> or from the topic identity.
 func (t *Topic) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	split := strings.SplitN(req.ID, ",", 2)
-	if len(split) != 2 {
-		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <topic_name>,<cluster_id>")
+	if req.ID == "" && req.Identity != nil {
+		var identity models.TopicIdentity
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Name)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_api_url"), identity.ClusterAPIURL)...)
+		return
+	}
+	clusterID, topicName, err := utils.ParseClusterImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<topic_name>")
 		return
 	}
-	topicName, clusterID := split[0], split[1]
 
 	client := cloud.NewControlPlaneClientSet(t.resData.ControlPlaneConnection)
 	cluster, err := client.ClusterForID(ctx, clusterID)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <topic_name>,<cluster_id>", clusterID), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<topic_name>", clusterID), err.Error())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(topicName))...)
@@ -313,16 +499,25 @@ func (t *Topic) createTopicClient(clusterURL string) error {
 		return nil
 	}
 	if t.dataplaneConn == nil {
-		conn, err := cloud.SpawnConn(clusterURL, t.resData.AuthToken)
+		conn, err := cloud.SpawnConn(clusterURL, t.resData.AuthToken, t.resData.Proxy)
 		if err != nil {
 			return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
 		}
 		t.dataplaneConn = conn
 	}
 	t.TopicClient = dataplanev1alpha2grpc.NewTopicServiceClient(t.dataplaneConn)
+	if t.ACLClient == nil {
+		t.ACLClient = dataplanev1alpha2grpc.NewACLServiceClient(t.dataplaneConn)
+	}
 	return nil
 }
 
+func (t *Topic) closeConn() {
+	if t.dataplaneConn != nil {
+		t.dataplaneConn.Close()
+	}
+}
+
 // filterDynamicConfig filters the configs and returns only the one with a
 // DYNAMIC_TOPIC_CONFIG source.
 func filterDynamicConfig(configs []*dataplanev1alpha2.Topic_Configuration) []*dataplanev1alpha2.Topic_Configuration {
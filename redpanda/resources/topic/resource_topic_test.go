@@ -0,0 +1,139 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+func TestImportStateByIdentity(t *testing.T) {
+	ctx := context.Background()
+	tp := &Topic{}
+
+	var identitySchema resource.IdentitySchemaResponse
+	tp.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+	require.False(t, identitySchema.Diagnostics.HasError())
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema.IdentitySchema,
+		Raw: tftypes.NewValue(identitySchema.IdentitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name":            tftypes.NewValue(tftypes.String, "orders"),
+			"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		}),
+	}
+
+	s := resourceTopicSchema()
+	resp := &resource.ImportStateResponse{State: tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}}
+	tp.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.Topic
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.StringValue("orders"), got.Name)
+	assert.Equal(t, types.StringValue("orders"), got.ID)
+	assert.Equal(t, types.StringValue("api-1234.cluster.redpanda.com:443"), got.ClusterAPIURL)
+}
+
+func topicState(ctx context.Context, t *testing.T) tfsdk.State {
+	t.Helper()
+	s := resourceTopicSchema()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	cfg, err := utils.TopicConfigurationToMap([]*dataplanev1alpha2.Topic_Configuration{
+		{Name: "retention.ms", Value: utils.StringToStringPointer("1000")},
+	})
+	require.NoError(t, err)
+	diags := state.Set(ctx, models.Topic{
+		Name:              types.StringValue("orders"),
+		PartitionCount:    types.Int64Value(3),
+		ReplicationFactor: types.Int64Value(3),
+		Configuration:     cfg,
+		AllowDeletion:     types.BoolValue(true),
+		ClusterAPIURL:     types.StringValue("api-1234.cluster.redpanda.com:443"),
+		ID:                types.StringValue("orders"),
+	})
+	require.False(t, diags.HasError(), diags)
+	return state
+}
+
+func TestReadDeletedTopic(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func(m *mocks.MockTopicServiceClient)
+	}{
+		{
+			name: "topic is not listed",
+			mockSetup: func(m *mocks.MockTopicServiceClient) {
+				m.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{}, nil)
+			},
+		},
+		{
+			name: "topic is deleted after being listed",
+			mockSetup: func(m *mocks.MockTopicServiceClient) {
+				m.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+					Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "orders", PartitionCount: 3, ReplicationFactor: 3}},
+				}, nil)
+				m.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(nil, grpcstatus.Error(grpccodes.NotFound, "topic not found"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			client := mocks.NewMockTopicServiceClient(ctrl)
+			tt.mockSetup(client)
+
+			state := topicState(ctx, t)
+			resp := &resource.ReadResponse{State: state}
+			tp := &Topic{TopicClient: client}
+			tp.Read(ctx, resource.ReadRequest{State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.True(t, resp.State.Raw.IsNull())
+		})
+	}
+}
+
+func TestReadRecreatedTopic(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockTopicServiceClient(ctrl)
+	client.EXPECT().ListTopics(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.ListTopicsResponse{
+		Topics: []*dataplanev1alpha2.ListTopicsResponse_Topic{{Name: "orders", PartitionCount: 1, ReplicationFactor: 3}},
+	}, nil)
+	client.EXPECT().GetTopicConfigurations(gomock.Any(), gomock.Any()).Return(&dataplanev1alpha2.GetTopicConfigurationsResponse{
+		Configurations: []*dataplanev1alpha2.Topic_Configuration{
+			{Name: "retention.ms", Value: utils.StringToStringPointer("2000"), Source: dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+			{Name: "cleanup.policy", Value: utils.StringToStringPointer("delete"), Source: dataplanev1alpha2.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+		},
+	}, nil)
+
+	state := topicState(ctx, t)
+	resp := &resource.ReadResponse{State: state}
+	tp := &Topic{TopicClient: client}
+	tp.Read(ctx, resource.ReadRequest{State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// the new partition count and configuration are read, so that the plan
+	// shows the drift from the configuration
+	var got models.Topic
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.Int64Value(1), got.PartitionCount)
+	assert.Equal(t, map[string]string{"retention.ms": "2000"}, utils.TypeMapToStringMap(got.Configuration))
+}
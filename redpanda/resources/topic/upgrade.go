@@ -0,0 +1,92 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topic
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// topicV0 is the state of a topic before partition_count and
+// replication_factor were changed from numbers to integers.
+type topicV0 struct {
+	Name              types.String `tfsdk:"name"`
+	PartitionCount    types.Number `tfsdk:"partition_count"`
+	ReplicationFactor types.Number `tfsdk:"replication_factor"`
+	Configuration     types.Map    `tfsdk:"configuration"`
+	AllowDeletion     types.Bool   `tfsdk:"allow_deletion"`
+	ClusterAPIURL     types.String `tfsdk:"cluster_api_url"`
+	ID                types.String `tfsdk:"id"`
+}
+
+func resourceTopicSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":               schema.StringAttribute{Required: true},
+			"partition_count":    schema.NumberAttribute{Optional: true, Computed: true},
+			"replication_factor": schema.NumberAttribute{Optional: true, Computed: true},
+			"allow_deletion":     schema.BoolAttribute{Optional: true},
+			"configuration":      schema.MapAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+			"cluster_api_url":    schema.StringAttribute{Required: true},
+			"id":                 schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// UpgradeState migrates topic states written by previous versions of the
+// provider to the current schema.
+func (*Topic) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	priorSchema := resourceTopicSchemaV0()
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &priorSchema,
+			StateUpgrader: upgradeTopicStateV0,
+		},
+	}
+}
+
+func upgradeTopicStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior topicV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	partitions, err := utils.NumberToInt64(prior.PartitionCount)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("partition_count"), "unable to upgrade partition_count", err.Error())
+		return
+	}
+	replication, err := utils.NumberToInt64(prior.ReplicationFactor)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("replication_factor"), "unable to upgrade replication_factor", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, models.Topic{
+		Name:              prior.Name,
+		PartitionCount:    partitions,
+		ReplicationFactor: replication,
+		Configuration:     prior.Configuration,
+		AllowDeletion:     prior.AllowDeletion,
+		ClusterAPIURL:     prior.ClusterAPIURL,
+		ID:                prior.ID,
+	})...)
+}
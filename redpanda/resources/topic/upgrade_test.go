@@ -0,0 +1,59 @@
+package topic
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeTopicStateV0(t *testing.T) {
+	tests := []struct {
+		name       string
+		partitions *big.Float
+		want       types.Int64
+		wantErr    bool
+	}{
+		{name: "integer", partitions: big.NewFloat(6), want: types.Int64Value(6)},
+		{name: "fraction", partitions: big.NewFloat(6.5), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			prior := resourceTopicSchemaV0()
+			priorState := tfsdk.State{Schema: prior, Raw: tftypes.NewValue(prior.Type().TerraformType(ctx), nil)}
+			diags := priorState.Set(ctx, topicV0{
+				Name:              types.StringValue("orders"),
+				PartitionCount:    types.NumberValue(tt.partitions),
+				ReplicationFactor: types.NumberValue(big.NewFloat(3)),
+				Configuration:     types.MapNull(types.StringType),
+				AllowDeletion:     types.BoolValue(true),
+				ClusterAPIURL:     types.StringValue("https://api.example.com"),
+				ID:                types.StringValue("orders"),
+			})
+			require.False(t, diags.HasError(), diags)
+
+			current := resourceTopicSchema()
+			resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: current, Raw: tftypes.NewValue(current.Type().TerraformType(ctx), nil)}}
+			upgradeTopicStateV0(ctx, resource.UpgradeStateRequest{State: &priorState}, resp)
+			if tt.wantErr {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var got models.Topic
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.want, got.PartitionCount)
+			assert.Equal(t, types.Int64Value(3), got.ReplicationFactor)
+			assert.Equal(t, types.StringValue("orders"), got.Name)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package user
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &DataSourceUsers{}
+	_ datasource.DataSourceWithConfigure = &DataSourceUsers{}
+)
+
+// DataSourceUsers represents a data source listing the SASL/SCRAM users of a
+// cluster.
+type DataSourceUsers struct {
+	UserClient dataplanev1alpha2grpc.UserServiceClient
+
+	dsData config.Datasource
+}
+
+// Metadata returns the metadata for the Users data source.
+func (*DataSourceUsers) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "redpanda_users"
+}
+
+// Configure uses provider level data to configure DataSourceUsers.
+func (d *DataSourceUsers) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(request.ProviderData, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	d.dsData = p
+}
+
+// Schema returns the schema for the Users data source.
+func (*DataSourceUsers) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = datasourceUsersSchema()
+}
+
+func datasourceUsersSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_api_url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The cluster API URL of the cluster to list the users of",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the users whose name starts with this prefix",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The users of the cluster, ordered by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the user",
+						},
+						"mechanism": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "SASL mechanism of the user's credentials, null if the cluster doesn't report it",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Data source listing the SASL/SCRAM users of a cluster and their mechanisms",
+	}
+}
+
+// Read lists the users of the cluster.
+func (d *DataSourceUsers) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var model models.Users
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	client := d.UserClient
+	if client == nil {
+		conn, err := cloud.SpawnConn(model.ClusterAPIURL.ValueString(), d.dsData.AuthToken, d.dsData.Proxy)
+		if err != nil {
+			response.Diagnostics.AddError("failed to create user client", fmt.Sprintf("unable to open a connection with the cluster API: %v", err))
+			return
+		}
+		defer conn.Close()
+		client = dataplanev1alpha2grpc.NewUserServiceClient(conn)
+	}
+	users, err := listUsers(ctx, client, model.NamePrefix.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("failed to list users", err.Error())
+		return
+	}
+	model.Users = users
+	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
+}
+
+// listUsers returns the users whose name starts with prefix, ordered by
+// name.
+func listUsers(ctx context.Context, client dataplanev1alpha2grpc.UserServiceClient, prefix string) ([]models.UserEntry, error) {
+	users := []models.UserEntry{}
+	pageToken := ""
+	for {
+		resp, err := client.ListUsers(ctx, &dataplanev1alpha2.ListUsersRequest{
+			Filter:    &dataplanev1alpha2.ListUsersRequest_Filter{NameContains: prefix},
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range resp.GetUsers() {
+			if !strings.HasPrefix(u.GetName(), prefix) {
+				continue
+			}
+			users = append(users, models.UserEntry{
+				Name:      types.StringValue(u.GetName()),
+				Mechanism: mechanismValue(types.StringNull(), u.Mechanism),
+			})
+		}
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" || len(resp.GetUsers()) == 0 {
+			break
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name.ValueString() < users[j].Name.ValueString()
+	})
+	return users, nil
+}
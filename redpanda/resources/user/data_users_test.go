@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasourceUsersSchema(t *testing.T) {
+	require.False(t, datasourceUsersSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestReadUsers(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserServiceClient(ctrl)
+	scram256 := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_256
+	scram512 := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_512
+	gomock.InOrder(
+		client.EXPECT().ListUsers(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.ListUsersRequest, _ ...any) (*dataplanev1alpha2.ListUsersResponse, error) {
+			assert.Equal(t, "svc-", req.GetFilter().GetNameContains())
+			assert.Empty(t, req.GetPageToken())
+			return &dataplanev1alpha2.ListUsersResponse{
+				Users: []*dataplanev1alpha2.ListUsersResponse_User{
+					{Name: "svc-orders", Mechanism: &scram512},
+					{Name: "legacy-svc-billing", Mechanism: &scram256},
+				},
+				NextPageToken: "page-2",
+			}, nil
+		}),
+		client.EXPECT().ListUsers(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.ListUsersRequest, _ ...any) (*dataplanev1alpha2.ListUsersResponse, error) {
+			assert.Equal(t, "page-2", req.GetPageToken())
+			return &dataplanev1alpha2.ListUsersResponse{
+				Users: []*dataplanev1alpha2.ListUsersResponse_User{
+					{Name: "svc-billing", Mechanism: &scram256},
+					{Name: "svc-audit"},
+				},
+			}, nil
+		}),
+	)
+
+	s := datasourceUsersSchema()
+	cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+		"name_prefix":     tftypes.NewValue(tftypes.String, "svc-"),
+		"users":           tftypes.NewValue(s.Attributes["users"].GetType().TerraformType(ctx), nil),
+	})}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+	d := &DataSourceUsers{UserClient: client}
+	d.Read(ctx, datasource.ReadRequest{Config: cfg}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.Users
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []models.UserEntry{
+		{Name: types.StringValue("svc-audit"), Mechanism: types.StringNull()},
+		{Name: types.StringValue("svc-billing"), Mechanism: types.StringValue("scram-sha-256")},
+		{Name: types.StringValue("svc-orders"), Mechanism: types.StringValue("scram-sha-512")},
+	}, got.Users)
+}
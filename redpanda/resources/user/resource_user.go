@@ -19,7 +19,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
@@ -60,15 +59,8 @@ func (*User) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resou
 
 // Configure configures the User resource.
 func (u *User) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	p, ok := req.ProviderData.(config.Resource)
+	p, ok := config.ResourceData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *provider.Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
 		return
 	}
 	u.resData = p
@@ -82,37 +74,38 @@ func (*User) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.
 // ResourceUserSchema returns the schema for the User resource.
 func resourceUserSchema() schema.Schema {
 	return schema.Schema{
-		Description: "User is a user that can be created in Redpanda",
+		MarkdownDescription: "User is a user that can be created in Redpanda",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Description:   "Name of the user, must be unique",
-				Required:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Name of the user, must be unique",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"password": schema.StringAttribute{
-				Description:   "Password of the user",
-				Required:      true,
-				Sensitive:     true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Password of the user. Changing the password updates the user in place.",
+				Required:            true,
+				Sensitive:           true,
 			},
 			"mechanism": schema.StringAttribute{
-				Description:   "Which authentication method to use, see https://docs.redpanda.com/current/manage/security/authentication/ for more information",
-				Optional:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "Which authentication method to use, see https://docs.redpanda.com/current/manage/security/authentication/ for more information. Changing the mechanism updates the user in place.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 				Validators: []validator.String{
 					stringvalidator.OneOf("", "scram-sha-256", "scram-sha-512"),
 				},
 			},
 			"cluster_api_url": schema.StringAttribute{
 				Required: true,
-				Description: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing " +
 					"cluster. It is generally a better idea to delete an existing resource and create a new one than to " +
 					"change this value unless you are planning to do state imports",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id": schema.StringAttribute{
-				Computed:      true,
-				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Computed:            true,
+				MarkdownDescription: "Identifier of the user, equal to its name",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
 	}
@@ -128,7 +121,7 @@ func (u *User) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		resp.Diagnostics.AddError("failed to create user client", err.Error())
 		return
 	}
-	defer u.dataplaneConn.Close()
+	defer u.closeConn()
 	user, err := u.UserClient.CreateUser(ctx, &dataplanev1alpha2.CreateUserRequest{
 		User: &dataplanev1alpha2.CreateUserRequest_User{
 			Name:      model.Name.ValueString(),
@@ -144,7 +137,7 @@ func (u *User) Create(ctx context.Context, req resource.CreateRequest, resp *res
 	resp.Diagnostics.Append(resp.State.Set(ctx, models.User{
 		Name:          types.StringValue(user.User.Name),
 		Password:      model.Password,
-		Mechanism:     model.Mechanism,
+		Mechanism:     mechanismValue(model.Mechanism, user.User.Mechanism),
 		ClusterAPIURL: model.ClusterAPIURL,
 		ID:            types.StringValue(user.User.Name),
 	})...)
@@ -159,7 +152,7 @@ func (u *User) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 		resp.Diagnostics.AddError("failed to create user client", err.Error())
 		return
 	}
-	defer u.dataplaneConn.Close()
+	defer u.closeConn()
 	user, err := utils.FindUserByName(ctx, model.Name.ValueString(), u.UserClient)
 	if err != nil {
 		if utils.IsNotFound(err) {
@@ -169,22 +162,53 @@ func (u *User) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 		resp.Diagnostics.AddError(fmt.Sprintf("failed to find user %s", model.Name), err.Error())
 		return
 	}
-	mechanism := model.Mechanism
-	if user.Mechanism != nil {
-		mechanism = types.StringValue(utils.UserMechanismToString(user.Mechanism))
-	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, models.User{
 		Name:          types.StringValue(user.Name),
 		Password:      model.Password,
-		Mechanism:     mechanism,
+		Mechanism:     mechanismValue(model.Mechanism, user.Mechanism),
 		ClusterAPIURL: model.ClusterAPIURL,
 		ID:            types.StringValue(user.Name),
 	})...)
 }
 
-// Update updates the state of the User resource.
-func (*User) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
-	// TODO implement me
+// Update updates the password and the mechanism of the User resource in
+// place. Changes to the other attributes replace the user.
+func (u *User) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.User
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := u.createUserClient(plan.ClusterAPIURL.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create user client", err.Error())
+		return
+	}
+	defer u.closeConn()
+	user, err := u.UserClient.UpdateUser(ctx, &dataplanev1alpha2.UpdateUserRequest{
+		User: &dataplanev1alpha2.UpdateUserRequest_User{
+			Name:      plan.Name.ValueString(),
+			Password:  plan.Password.ValueString(),
+			Mechanism: utils.StringToUserMechanism(plan.Mechanism.ValueString()),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to update user %s", plan.Name), err.Error())
+		return
+	}
+
+	var mechanism *dataplanev1alpha2.SASLMechanism
+	if user.GetUser() != nil {
+		mechanism = user.GetUser().Mechanism
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, models.User{
+		Name:          plan.Name,
+		Password:      plan.Password,
+		Mechanism:     mechanismValue(plan.Mechanism, mechanism),
+		ClusterAPIURL: plan.ClusterAPIURL,
+		ID:            plan.ID,
+	})...)
 }
 
 // Delete deletes the User resource.
@@ -197,7 +221,7 @@ func (u *User) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 		resp.Diagnostics.AddError("failed to create user client", err.Error())
 		return
 	}
-	defer u.dataplaneConn.Close()
+	defer u.closeConn()
 	_, err = u.UserClient.DeleteUser(ctx, &dataplanev1alpha2.DeleteUserRequest{
 		Name: model.Name.ValueString(),
 	})
@@ -212,17 +236,16 @@ func (u *User) ImportState(ctx context.Context, req resource.ImportStateRequest,
 	// We need multiple attributes here: Name and the cluster URL. But asking
 	// for the URL is a bad UX, so we get the cluster ID and get the URL from
 	// there.
-	split := strings.SplitN(req.ID, ",", 2)
-	if len(split) != 2 {
-		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <user_name>,<cluster_id>")
+	clusterID, user, err := utils.ParseClusterImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ADDR ID format: %v", req.ID), "ADDR ID format is <cluster_id>/<user_name>")
 		return
 	}
-	user, clusterID := split[0], split[1]
 
 	client := cloud.NewControlPlaneClientSet(u.resData.ControlPlaneConnection)
 	cluster, err := client.ClusterForID(ctx, clusterID)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <user_name>,<cluster_id>", clusterID), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to find cluster with ID %q; make sure ADDR ID format is <cluster_id>/<user_name>", clusterID), err.Error())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(user))...)
@@ -238,7 +261,7 @@ func (u *User) createUserClient(clusterURL string) error {
 		return errors.New("unable to create client with empty target cluster API URL")
 	}
 	if u.dataplaneConn == nil {
-		conn, err := cloud.SpawnConn(clusterURL, u.resData.AuthToken)
+		conn, err := cloud.SpawnConn(clusterURL, u.resData.AuthToken, u.resData.Proxy)
 		if err != nil {
 			return fmt.Errorf("unable to open a connection with the cluster API: %v", err)
 		}
@@ -247,3 +270,9 @@ func (u *User) createUserClient(clusterURL string) error {
 	u.UserClient = dataplanev1alpha2grpc.NewUserServiceClient(u.dataplaneConn)
 	return nil
 }
+
+func (u *User) closeConn() {
+	if u.dataplaneConn != nil {
+		u.dataplaneConn.Close()
+	}
+}
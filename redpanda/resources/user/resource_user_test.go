@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUser(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	client := mocks.NewMockUserServiceClient(ctrl)
+	scram512 := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_512
+	client.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req *dataplanev1alpha2.UpdateUserRequest, _ ...any) (*dataplanev1alpha2.UpdateUserResponse, error) {
+		assert.Equal(t, "svc-orders", req.GetUser().GetName())
+		assert.Equal(t, "new-password", req.GetUser().GetPassword())
+		assert.Equal(t, scram512, req.GetUser().GetMechanism())
+		return &dataplanev1alpha2.UpdateUserResponse{
+			User: &dataplanev1alpha2.UpdateUserResponse_User{Name: "svc-orders", Mechanism: &scram512},
+		}, nil
+	})
+
+	s := resourceUserSchema()
+	value := func(password, mechanism string) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name":            tftypes.NewValue(tftypes.String, "svc-orders"),
+			"password":        tftypes.NewValue(tftypes.String, password),
+			"mechanism":       tftypes.NewValue(tftypes.String, mechanism),
+			"cluster_api_url": tftypes.NewValue(tftypes.String, "api-1234.cluster.redpanda.com:443"),
+			"id":              tftypes.NewValue(tftypes.String, "svc-orders"),
+		})
+	}
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: s, Raw: value("new-password", "scram-sha-512")},
+		State: tfsdk.State{Schema: s, Raw: value("old-password", "scram-sha-256")},
+	}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: s, Raw: req.State.Raw}}
+	u := &User{UserClient: client}
+	u.Update(ctx, req, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got models.User
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, types.StringValue("new-password"), got.Password)
+	assert.Equal(t, types.StringValue("scram-sha-512"), got.Mechanism)
+	assert.Equal(t, types.StringValue("svc-orders"), got.ID)
+}
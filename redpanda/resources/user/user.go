@@ -0,0 +1,37 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package user
+
+import (
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// mechanismValue returns the mechanism to persist in state. A mechanism set
+// in the configuration is kept unless the API reports a different one, in
+// which case the drift is surfaced. When the mechanism is left unset the one
+// defaulted by the API is stored so that subsequent plans converge.
+func mechanismValue(cfg types.String, m *dataplanev1alpha2.SASLMechanism) types.String {
+	reported := m != nil && *m != dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_UNSPECIFIED
+	if !cfg.IsNull() && !cfg.IsUnknown() && (cfg.ValueString() == "" || !reported) {
+		return cfg
+	}
+	if !reported {
+		return types.StringNull()
+	}
+	return types.StringValue(utils.UserMechanismToString(m))
+}
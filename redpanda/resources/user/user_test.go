@@ -0,0 +1,34 @@
+package user
+
+import (
+	"testing"
+
+	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMechanismValue(t *testing.T) {
+	sha256 := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_256
+	sha512 := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_512
+	unspecified := dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_UNSPECIFIED
+	for _, tt := range []struct {
+		name string
+		cfg  types.String
+		m    *dataplanev1alpha2.SASLMechanism
+		exp  types.String
+	}{
+		{"unset takes api default", types.StringUnknown(), &sha256, types.StringValue("scram-sha-256")},
+		{"unset in state takes api value", types.StringNull(), &sha512, types.StringValue("scram-sha-512")},
+		{"unset and unreported", types.StringUnknown(), nil, types.StringNull()},
+		{"unset and unspecified", types.StringUnknown(), &unspecified, types.StringNull()},
+		{"configured matches", types.StringValue("scram-sha-512"), &sha512, types.StringValue("scram-sha-512")},
+		{"configured drifted", types.StringValue("scram-sha-512"), &sha256, types.StringValue("scram-sha-256")},
+		{"configured and unreported", types.StringValue("scram-sha-512"), nil, types.StringValue("scram-sha-512")},
+		{"configured empty", types.StringValue(""), &sha256, types.StringValue("")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, mechanismValue(tt.cfg, tt.m))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package versions contains the implementation of the Redpanda versions data
+// source following the Terraform framework interfaces.
+package versions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource = &DataSourceVersions{}
+)
+
+var trackRegex = regexp.MustCompile(`^v?\d+\.\d+$`)
+
+// DataSourceVersions represents a data source for the Redpanda versions
+// available to dedicated clusters.
+type DataSourceVersions struct {
+	CpCl *cloud.ControlPlaneClientSet
+}
+
+// DataSourceVersionsSchema defines the schema for a Redpanda versions data source.
+func DataSourceVersionsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"track": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Minor version to restrict the versions to, e.g. `24.2`. Defaults to every version",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(trackRegex, "must be a major and minor version, e.g. 24.2"),
+				},
+			},
+			"versions": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Redpanda versions available to dedicated clusters, newest first",
+			},
+			"latest": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Newest available version, including release candidates",
+			},
+			"stable": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Newest available version that is not a release candidate. Use it as the `redpanda_version` of a cluster to follow the latest patch of a track",
+			},
+		},
+		MarkdownDescription: "Data source for the Redpanda versions available to dedicated clusters",
+	}
+}
+
+// Metadata returns the metadata for the Redpanda versions data source.
+func (*DataSourceVersions) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "redpanda_versions"
+}
+
+// Schema returns the schema for the Redpanda versions data source.
+func (*DataSourceVersions) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = DataSourceVersionsSchema()
+}
+
+// Read reads the Redpanda versions data source's values and updates the state.
+func (d *DataSourceVersions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.Versions
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	available, err := listVersions(ctx, d.CpCl.RedpandaVersion)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list Redpanda versions", err.Error())
+		return
+	}
+	versions, stable, err := selectVersions(available, model.Track.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to select Redpanda versions", err.Error())
+		return
+	}
+
+	model.Versions = utils.StringSliceToTypeList(versions)
+	model.Latest = types.StringValue(versions[0])
+	model.Stable = types.StringNull()
+	if stable != "" {
+		model.Stable = types.StringValue(stable)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Configure uses provider level data to configure DataSourceVersions client.
+func (d *DataSourceVersions) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	p, ok := config.DatasourceData(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	d.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+}
+
+// listVersions returns every Redpanda version the control plane offers.
+func listVersions(ctx context.Context, client controlplanev1beta2grpc.RedpandaVersionServiceClient) ([]string, error) {
+	var versions []string
+	listReq := &controlplanev1beta2.ListRedpandaVersionsRequest{}
+	for {
+		listResp, err := client.ListRedpandaVersions(ctx, listReq)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range listResp.GetVersions() {
+			versions = append(versions, v.GetRedpandaVersion())
+		}
+		listReq.PageToken = listResp.GetNextPageToken()
+		if listReq.PageToken == "" || len(listResp.GetVersions()) == 0 {
+			return versions, nil
+		}
+	}
+}
+
+// selectVersions sorts the versions newest first, keeping only those of the
+// given track when it is set, and returns the newest of them that is not a
+// prerelease. Versions that cannot be parsed are ignored.
+func selectVersions(available []string, track string) ([]string, string, error) {
+	type parsed struct {
+		raw string
+		v   *version.Version
+	}
+	var all []parsed
+	var tracks []string
+	for _, raw := range available {
+		v, err := version.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+		all = append(all, parsed{raw, v})
+		if t := trackOf(v); !slices.Contains(tracks, t) {
+			tracks = append(tracks, t)
+		}
+	}
+	slices.SortFunc(all, func(a, b parsed) int { return b.v.Compare(a.v) })
+
+	var versions []string
+	var stable string
+	for _, p := range all {
+		if track != "" && trackOf(p.v) != strings.TrimPrefix(track, "v") {
+			continue
+		}
+		versions = append(versions, p.raw)
+		if stable == "" && p.v.Prerelease() == "" {
+			stable = p.raw
+		}
+	}
+	if len(versions) > 0 {
+		return versions, stable, nil
+	}
+	if track == "" {
+		return nil, "", errors.New("the control plane returned no Redpanda versions; please report this bug to Redpanda Support")
+	}
+	slices.Sort(tracks)
+	return nil, "", fmt.Errorf("no Redpanda versions in track %q; available tracks are %s", track, strings.Join(tracks, ", "))
+}
+
+func trackOf(v *version.Version) string {
+	s := v.Segments()
+	return fmt.Sprintf("%d.%d", s[0], s[1])
+}
@@ -0,0 +1,81 @@
+package versions
+
+import (
+	"context"
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/golang/mock/gomock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceVersionsSchema(t *testing.T) {
+	require.False(t, DataSourceVersionsSchema().ValidateImplementation(context.Background()).HasError())
+}
+
+func TestListVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mocks.NewMockRedpandaVersionServiceClient(ctrl)
+	page := func(token string, versions ...string) *controlplanev1beta2.ListRedpandaVersionsResponse {
+		resp := &controlplanev1beta2.ListRedpandaVersionsResponse{NextPageToken: token}
+		for _, v := range versions {
+			resp.Versions = append(resp.Versions, &controlplanev1beta2.RedpandaVersion{RedpandaVersion: v})
+		}
+		return resp
+	}
+	gomock.InOrder(
+		client.EXPECT().ListRedpandaVersions(gomock.Any(), &controlplanev1beta2.ListRedpandaVersionsRequest{}).Return(page("next", "v24.1.9"), nil),
+		client.EXPECT().ListRedpandaVersions(gomock.Any(), gomock.Any()).Return(page("", "v24.2.1"), nil),
+	)
+	versions, err := listVersions(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v24.1.9", "v24.2.1"}, versions)
+}
+
+func TestSelectVersions(t *testing.T) {
+	available := []string{"v24.1.9", "v24.2.10", "v24.2.2", "v24.3.1-rc2", "unknown", "v24.1.12"}
+	tests := []struct {
+		name         string
+		track        string
+		wantVersions []string
+		wantStable   string
+		wantErr      string
+	}{
+		{
+			name:         "all versions",
+			wantVersions: []string{"v24.3.1-rc2", "v24.2.10", "v24.2.2", "v24.1.12", "v24.1.9"},
+			wantStable:   "v24.2.10",
+		},
+		{
+			name:         "minor track",
+			track:        "24.1",
+			wantVersions: []string{"v24.1.12", "v24.1.9"},
+			wantStable:   "v24.1.12",
+		},
+		{
+			name:         "track with only release candidates",
+			track:        "v24.3",
+			wantVersions: []string{"v24.3.1-rc2"},
+		},
+		{
+			name:    "unknown track",
+			track:   "23.3",
+			wantErr: `no Redpanda versions in track "23.3"; available tracks are 24.1, 24.2, 24.3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versions, stable, err := selectVersions(available, tt.track)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantVersions, versions)
+			assert.Equal(t, tt.wantStable, stable)
+		})
+	}
+}
@@ -28,11 +28,11 @@ func newTestClients(ctx context.Context, clientID, clientSecret, cloudEnv string
 		return nil, err
 	}
 
-	token, err := cloud.RequestToken(ctx, endpoint, clientID, clientSecret)
+	token, err := cloud.RequestToken(ctx, endpoint, clientID, clientSecret, nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to request auth token: %v", err)
 	}
-	conn, err := cloud.SpawnConn(endpoint.APIURL, token)
+	conn, err := cloud.SpawnConn(endpoint.APIURL, token, nil)
 	if err != nil {
 		return nil, fmt.Errorf("unable to spawn connection with control plane API: %v", err)
 	}
@@ -0,0 +1,125 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package testutil contains helpers shared by the unit tests of the
+// resources.
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// updateGoldenEnv is the environment variable that makes Golden write the
+// golden files instead of comparing them.
+const updateGoldenEnv = "UPDATE_GOLDEN"
+
+// NullTimeouts returns the value of a timeouts block that is not set, for the
+// operations named, e.g. "create" and "delete".
+func NullTimeouts(operations ...string) timeouts.Value {
+	attrTypes := make(map[string]attr.Type, len(operations))
+	for _, op := range operations {
+		attrTypes[op] = types.StringType
+	}
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
+
+// RoundTrip checks that every attribute of the schema that can be set in the
+// configuration has the same value in the planned model and in the model read
+// back from the API after applying it. Computed only attributes are ignored.
+func RoundTrip(ctx context.Context, t *testing.T, s schema.Schema, planned, read any) {
+	t.Helper()
+	want := configurableValue(ctx, t, s, planned)
+	got := configurableValue(ctx, t, s, read)
+	diffs, err := want.Diff(got)
+	if err != nil {
+		t.Fatalf("unable to compare the models: %v", err)
+	}
+	for _, d := range diffs {
+		if d.Value1 == nil || d.Value2 == nil {
+			continue // reported with the parent
+		}
+		t.Errorf("%s does not survive a round trip: planned %s, read back %s", d.Path, d.Value1, d.Value2)
+	}
+}
+
+// configurableValue returns the Terraform value of the model, with the
+// computed only attributes set to null.
+func configurableValue(ctx context.Context, t *testing.T, s schema.Schema, model any) tftypes.Value {
+	t.Helper()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unable to convert the model: %v", diags)
+	}
+	v, err := tftypes.Transform(state.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		a, err := s.AttributeAtTerraformPath(ctx, p)
+		if err != nil || !a.IsComputed() || a.IsOptional() || a.IsRequired() {
+			return v, nil
+		}
+		return tftypes.NewValue(v.Type(), nil), nil
+	})
+	if err != nil {
+		t.Fatalf("unable to filter the model: %v", err)
+	}
+	return v
+}
+
+// Golden compares msg, as indented JSON, with the golden file testdata/name.
+// Run the tests with UPDATE_GOLDEN=1 to write the golden files instead.
+func Golden(t *testing.T, name string, msg proto.Message) {
+	t.Helper()
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		t.Fatalf("unable to marshal %s: %v", name, err)
+	}
+	// protojson output is not stable, reformat it
+	var got bytes.Buffer
+	if err := json.Indent(&got, b, "", "  "); err != nil {
+		t.Fatalf("unable to indent %s: %v", name, err)
+	}
+	got.WriteByte('\n')
+
+	file := filepath.Join("testdata", name)
+	if os.Getenv(updateGoldenEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, got.Bytes(), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("unable to read golden file, run the tests with UPDATE_GOLDEN=1 to create it: %v", err)
+	}
+	if strings.TrimSpace(string(want)) != strings.TrimSpace(got.String()) {
+		t.Errorf("%s does not match its golden file, run the tests with UPDATE_GOLDEN=1 if the change is expected\n got:\n%s\nwant:\n%s", name, got.String(), want)
+	}
+}
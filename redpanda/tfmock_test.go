@@ -0,0 +1,72 @@
+package redpanda
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMockDefaults checks that the mock defaults shipped for terraform test
+// only set computed attributes of existing resources and data sources, which
+// Terraform requires.
+func TestMockDefaults(t *testing.T) {
+	ctx := context.Background()
+	const filename = "../examples/testing/mocks/redpanda.tfmock.hcl"
+	src, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	require.False(t, diags.HasErrors(), diags.Error())
+
+	computed := map[string]map[string]bool{}
+	for _, f := range (&Redpanda{}).Resources(ctx) {
+		r := f()
+		meta := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "redpanda"}, meta)
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+		computed["mock_resource."+meta.TypeName] = map[string]bool{}
+		for name, a := range resp.Schema.Attributes {
+			computed["mock_resource."+meta.TypeName][name] = a.IsComputed()
+		}
+	}
+	for _, f := range (&Redpanda{}).DataSources(ctx) {
+		d := f()
+		meta := &datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "redpanda"}, meta)
+		resp := &datasource.SchemaResponse{}
+		d.Schema(ctx, datasource.SchemaRequest{}, resp)
+		computed["mock_data."+meta.TypeName] = map[string]bool{}
+		for name, a := range resp.Schema.Attributes {
+			computed["mock_data."+meta.TypeName][name] = a.IsComputed()
+		}
+	}
+
+	body := file.Body.(*hclsyntax.Body)
+	require.NotEmpty(t, body.Blocks)
+	for _, block := range body.Blocks {
+		require.Len(t, block.Labels, 1, block.DefRange())
+		key := block.Type + "." + block.Labels[0]
+		attrs, ok := computed[key]
+		if !assert.True(t, ok, "%s does not exist", key) {
+			continue
+		}
+		defaults, ok := block.Body.Attributes["defaults"]
+		if !assert.True(t, ok, "%s has no defaults", key) {
+			continue
+		}
+		v, diags := defaults.Expr.Value(nil)
+		require.False(t, diags.HasErrors(), diags.Error())
+		for name := range v.Type().AttributeTypes() {
+			isComputed, ok := attrs[name]
+			assert.True(t, ok, "%s has no attribute %s", key, name)
+			assert.True(t, !ok || isComputed, "%s.%s is not computed", key, name)
+		}
+	}
+}
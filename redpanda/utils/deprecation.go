@@ -0,0 +1,45 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"google.golang.org/protobuf/proto"
+)
+
+// WarnDeprecatedFields adds a warning for each field set in req that the API
+// marks as deprecated. The warning is tied to the attribute named after the
+// top-level field, as the attributes follow the API field names.
+func WarnDeprecatedFields(diags *diag.Diagnostics, req proto.Message) {
+	for _, field := range cloud.DeprecatedFields(req) {
+		attr, _, _ := strings.Cut(field, ".")
+		diags.AddAttributeWarning(path.Root(attr), "deprecated field",
+			fmt.Sprintf("The %s field of the Redpanda API is deprecated and may be removed in a future release. Migrate away from it before it is removed.", field))
+	}
+}
+
+// WarnDeprecationNotices adds a warning for each deprecation notice returned
+// by the API.
+func WarnDeprecationNotices(diags *diag.Diagnostics, notices *cloud.DeprecationNotices) {
+	for _, notice := range notices.List() {
+		diags.AddWarning("deprecated Redpanda API call", notice+" Upgrade the provider before it is removed.")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnknownEnum reports whether e holds a value missing from the API version
+// the provider was built against, as sent by a newer control plane.
+func UnknownEnum(e protoreflect.Enum) bool {
+	return e.Descriptor().Values().ByNumber(e.Number()) == nil
+}
+
+// unknownEnumString returns the string stored for an enum value the provider
+// does not know. The API only sends its number, which is kept verbatim
+// rather than degraded to unspecified.
+func unknownEnumString(e protoreflect.Enum) string {
+	return strconv.Itoa(int(e.Number()))
+}
+
+// WarnUnknownEnum adds a warning if e, read for attribute, holds a value
+// unknown to the provider, and reports whether it does.
+func WarnUnknownEnum(diags *diag.Diagnostics, attribute string, e protoreflect.Enum) bool {
+	if !UnknownEnum(e) {
+		return false
+	}
+	diags.AddAttributeWarning(path.Root(attribute), "unknown value returned by the Redpanda API",
+		fmt.Sprintf("The Redpanda API returned %s %d for %s, which this version of the provider does not know. Upgrade the provider to read it.",
+			e.Descriptor().Name(), e.Number(), attribute))
+	return true
+}
+
+// KeepUnknownEnum returns the value to store for attribute when it was read
+// from e. If e holds a value unknown to the provider, a warning is added and
+// prior, the value in the state, is kept so that the resource is not planned
+// for replacement; read, which then holds the number of the value as the
+// provider has no name for it, is only used when the state has no value,
+// e.g. on import.
+func KeepUnknownEnum(diags *diag.Diagnostics, attribute string, e protoreflect.Enum, prior, read types.String) types.String {
+	if !WarnUnknownEnum(diags, attribute, e) {
+		return read
+	}
+	if prior.IsNull() || prior.IsUnknown() || prior.ValueString() == "" {
+		return read
+	}
+	return prior
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newCloudProvider is a cloud provider added to the API after the version
+// the provider is built against.
+const newCloudProvider = controlplanev1beta2.CloudProvider(42)
+
+func TestEnumToStringKeepsUnknownValues(t *testing.T) {
+	testCases := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "known cloud provider", got: CloudProviderToString(controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_GCP), want: "gcp"},
+		{name: "unspecified cloud provider", got: CloudProviderToString(controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_UNSPECIFIED), want: "unspecified"},
+		{name: "unknown cloud provider", got: CloudProviderToString(newCloudProvider), want: "42"},
+		{name: "unknown cluster type", got: ClusterTypeToString(controlplanev1beta2.Cluster_Type(7)), want: "7"},
+		{name: "unknown connection type", got: ConnectionTypeToString(controlplanev1beta2.Cluster_ConnectionType(9)), want: "9"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("Expected %q, got %q", tc.want, tc.got)
+			}
+		})
+	}
+}
+
+func TestKeepUnknownEnum(t *testing.T) {
+	testCases := []struct {
+		name        string
+		value       controlplanev1beta2.CloudProvider
+		prior       types.String
+		want        types.String
+		wantWarning bool
+	}{
+		{
+			name:  "known value",
+			value: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS,
+			prior: types.StringValue("gcp"),
+			want:  types.StringValue("aws"),
+		},
+		{
+			name:        "unknown value keeps the state",
+			value:       newCloudProvider,
+			prior:       types.StringValue("oci"),
+			want:        types.StringValue("oci"),
+			wantWarning: true,
+		},
+		{
+			name:        "unknown value without state",
+			value:       newCloudProvider,
+			prior:       types.StringNull(),
+			want:        types.StringValue("42"),
+			wantWarning: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := KeepUnknownEnum(&diags, "cloud_provider", tc.value, tc.prior, types.StringValue(CloudProviderToString(tc.value)))
+			if !got.Equal(tc.want) {
+				t.Errorf("Expected %v, got %v", tc.want, got)
+			}
+			if diags.HasError() || (diags.WarningsCount() == 1) != tc.wantWarning {
+				t.Errorf("Unexpected diagnostics %v", diags)
+			}
+		})
+	}
+}
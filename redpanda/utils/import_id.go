@@ -0,0 +1,49 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// clusterIDRegex matches the IDs of Redpanda Cloud clusters.
+var clusterIDRegex = regexp.MustCompile(`^[0-9a-v]{20}$`)
+
+// ClusterImportID returns the canonical import ID of a resource living on a
+// cluster: the cluster ID and the resource's key separated by a slash.
+func ClusterImportID(clusterID, key string) string {
+	return clusterID + "/" + key
+}
+
+// ParseClusterImportID splits the import ID of a resource living on a cluster
+// into the cluster ID and the resource's key. The canonical format is
+// <cluster_id>/<key>; the <key>,<cluster_id> format of earlier releases is
+// still accepted. Keys may contain slashes and commas, since the cluster ID
+// is matched first in the canonical format and last in the earlier one.
+func ParseClusterImportID(id string) (clusterID, key string, err error) {
+	if before, after, ok := strings.Cut(id, "/"); ok && clusterIDRegex.MatchString(before) {
+		if after == "" {
+			return "", "", fmt.Errorf("import ID %q has no resource after the cluster ID", id)
+		}
+		return before, after, nil
+	}
+	if i := strings.LastIndex(id, ","); i > 0 && i < len(id)-1 {
+		return id[i+1:], id[:i], nil
+	}
+	return "", "", fmt.Errorf("import ID %q is not of the form <cluster_id>/<key>", id)
+}
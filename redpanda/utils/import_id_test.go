@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterImportID(t *testing.T) {
+	const clusterID = "cq1s2k0n6t8ov0b8d0kg"
+	tests := []struct {
+		name    string
+		id      string
+		cluster string
+		key     string
+		wantErr bool
+	}{
+		{name: "canonical", id: clusterID + "/orders", cluster: clusterID, key: "orders"},
+		{name: "canonical key with slash and comma", id: clusterID + "/reader,User:CN=a,OU=b/c", cluster: clusterID, key: "reader,User:CN=a,OU=b/c"},
+		{name: "earlier format", id: "orders," + clusterID, cluster: clusterID, key: "orders"},
+		{name: "earlier format key with comma", id: "reader,User:alice," + clusterID, cluster: clusterID, key: "reader,User:alice"},
+		{name: "earlier format non canonical cluster ID", id: "orders,clusterId", cluster: "clusterId", key: "orders"},
+		{name: "canonical without key", id: clusterID + "/", wantErr: true},
+		{name: "cluster ID only", id: clusterID, wantErr: true},
+		{name: "empty", id: "", wantErr: true},
+		{name: "earlier format without cluster", id: "orders,", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster, key, err := ParseClusterImportID(tt.id)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.cluster, cluster)
+			assert.Equal(t, tt.key, key)
+			assert.Equal(t, clusterID+"/"+tt.key, ClusterImportID(clusterID, tt.key))
+		})
+	}
+}
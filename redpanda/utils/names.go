@@ -0,0 +1,66 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// PlanUniqueName fails the plan when the resource of type typeName is about
+// to create an object under a name already taken in the organization, e.g.
+// by another team or another state. The check only runs when the object is
+// created or renamed; idsForName returns the IDs of the objects of that name.
+func PlanUniqueName(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, typeName string, idsForName func(context.Context, string) ([]string, error)) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsNull() || name.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var stateName types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("name"), &stateName)...)
+		if resp.Diagnostics.HasError() || stateName.Equal(name) {
+			return
+		}
+	}
+	ids, err := idsForName(ctx, name.ValueString())
+	if err != nil {
+		// the check is best effort, it must not block the plan
+		tflog.Warn(ctx, "unable to check for objects of the same name", map[string]any{"resource": typeName, "name": name.ValueString(), "error": err.Error()})
+		return
+	}
+	if len(ids) == 0 {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("name"),
+		"duplicate name",
+		fmt.Sprintf("A %s named %q already exists (ID %s). Import it with `terraform import %s.<name> %s` "+
+			"to manage it instead of creating another one, or choose another name. Set prevent_duplicate_names "+
+			"to false in the provider configuration to disable this check.",
+			typeName, name.ValueString(), strings.Join(ids, ", "), typeName, ids[0]),
+	)
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanUniqueName(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+	}}
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	none := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+	existing := func(_ context.Context, name string) ([]string, error) {
+		if name == "taken" {
+			return []string{"cl-1"}, nil
+		}
+		return nil, nil
+	}
+
+	tests := []struct {
+		name       string
+		state      tftypes.Value
+		plan       tftypes.Value
+		idsForName func(context.Context, string) ([]string, error)
+		wantErr    bool
+	}{
+		{name: "new object with a free name", state: none, plan: value("free"), idsForName: existing},
+		{name: "new object with a taken name", state: none, plan: value("taken"), idsForName: existing, wantErr: true},
+		{name: "renamed to a taken name", state: value("free"), plan: value("taken"), idsForName: existing, wantErr: true},
+		{
+			name: "unchanged name", state: value("taken"), plan: value("taken"),
+			idsForName: func(context.Context, string) ([]string, error) {
+				t.Error("unexpected lookup of an unchanged name")
+				return nil, nil
+			},
+		},
+		{name: "destroyed", state: value("taken"), plan: none, idsForName: existing},
+		{
+			name: "lookup error", state: none, plan: value("taken"),
+			idsForName: func(context.Context, string) ([]string, error) { return nil, errors.New("unavailable") },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: tt.state},
+				Plan:  tfsdk.Plan{Schema: s, Raw: tt.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			PlanUniqueName(ctx, req, resp, "redpanda_cluster", tt.idsForName)
+			if !tt.wantErr {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "terraform import redpanda_cluster.<name> cl-1")
+		})
+	}
+}
@@ -2,29 +2,141 @@ package utils
 
 import (
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
+// DiffOption configures how GenerateProtobufDiffAndUpdateMask compares
+// messages.
+type DiffOption func(*differ)
+
+// WithUnorderedFields makes the repeated fields with the given names, at any
+// depth of the message, be compared as sets: two lists holding the same
+// elements in a different order are considered equal.
+func WithUnorderedFields(names ...string) DiffOption {
+	return func(d *differ) {
+		for _, n := range names {
+			d.unordered[protoreflect.Name(n)] = true
+		}
+	}
+}
+
 // GenerateProtobufDiffAndUpdateMask takes two proto.Message objects and calculates the
 // diff in toplevel fields between them. It returns a new proto.Message object with only
 // the differing values and a fieldmaskpb.FieldMask object with the names of the
 // differing fields. If there are no differing fields, it returns a proto.Message object
 // with no fields set and a FieldMask with an empty Paths slice.
+//
+// Map fields are compared by key regardless of insertion order, and repeated
+// fields passed to WithUnorderedFields are compared regardless of element
+// order. When a toplevel field differs, its whole new value is copied to the
+// diff.
 func GenerateProtobufDiffAndUpdateMask[P interface {
 	*T
 	proto.Message
-}, T any](newMessage, oldMessage P) (*T, *fieldmaskpb.FieldMask) {
+}, T any](newMessage, oldMessage P, opts ...DiffOption) (*T, *fieldmaskpb.FieldMask) {
 	var t T
 	diff := P(&t)
 	mask := &fieldmaskpb.FieldMask{}
 
-	fields := newMessage.ProtoReflect().Descriptor().Fields()
+	d := &differ{unordered: map[protoreflect.Name]bool{}}
+	for _, o := range opts {
+		o(d)
+	}
+
+	n, o := newMessage.ProtoReflect(), oldMessage.ProtoReflect()
+	fields := n.Descriptor().Fields()
 	for i := range fields.Len() {
 		field := fields.Get(i)
-		if !newMessage.ProtoReflect().Get(field).Equal(oldMessage.ProtoReflect().Get(field)) {
-			diff.ProtoReflect().Set(field, newMessage.ProtoReflect().Get(field))
+		if !d.fieldEqual(field, n, o) {
+			// an empty map or list can't be copied, leaving it unset in the
+			// diff while listing it in the mask clears it
+			if n.Has(field) {
+				diff.ProtoReflect().Set(field, n.Get(field))
+			}
 			mask.Paths = append(mask.Paths, string(field.Name()))
 		}
 	}
 	return diff, mask
 }
+
+type differ struct {
+	unordered map[protoreflect.Name]bool
+}
+
+func (d *differ) messageEqual(a, b protoreflect.Message) bool {
+	fields := a.Descriptor().Fields()
+	for i := range fields.Len() {
+		if !d.fieldEqual(fields.Get(i), a, b) {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *differ) fieldEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Message) bool {
+	if fd.HasPresence() && a.Has(fd) != b.Has(fd) {
+		return false
+	}
+	av, bv := a.Get(fd), b.Get(fd)
+	switch {
+	case fd.IsList():
+		return d.listEqual(fd, av.List(), bv.List())
+	case fd.IsMap():
+		return d.mapEqual(fd, av.Map(), bv.Map())
+	case fd.Message() != nil:
+		return d.messageEqual(av.Message(), bv.Message())
+	default:
+		return av.Equal(bv)
+	}
+}
+
+func (d *differ) listEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.List) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	if !d.unordered[fd.Name()] {
+		for i := range a.Len() {
+			if !d.valueEqual(fd, a.Get(i), b.Get(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	matched := make([]bool, b.Len())
+	for i := range a.Len() {
+		found := false
+		for j := range b.Len() {
+			if !matched[j] && d.valueEqual(fd, a.Get(i), b.Get(j)) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *differ) mapEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Map) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	equal := true
+	a.Range(func(k protoreflect.MapKey, av protoreflect.Value) bool {
+		if !b.Has(k) || !d.valueEqual(fd.MapValue(), av, b.Get(k)) {
+			equal = false
+		}
+		return equal
+	})
+	return equal
+}
+
+// valueEqual compares a single list element or map value of the given field.
+func (d *differ) valueEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	if fd.Message() != nil {
+		return d.messageEqual(a.Message(), b.Message())
+	}
+	return a.Equal(b)
+}
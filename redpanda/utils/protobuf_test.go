@@ -13,6 +13,7 @@ func TestGenerateProtobufDiffAndUpdateMask(t *testing.T) {
 		name         string
 		old          *controlplanev1beta2.ClusterUpdate
 		new          *controlplanev1beta2.ClusterUpdate
+		opts         []DiffOption
 		expected     *controlplanev1beta2.ClusterUpdate
 		expectedMask []string
 	}{
@@ -103,12 +104,183 @@ func TestGenerateProtobufDiffAndUpdateMask(t *testing.T) {
 			},
 			expectedMask: []string{"aws_private_link", "name"},
 		},
+		{
+			name: "same tags",
+			old: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "prod", "team": "data"},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"team": "data", "env": "prod"},
+			},
+			expected:     &controlplanev1beta2.ClusterUpdate{},
+			expectedMask: nil,
+		},
+		{
+			name: "changed tag value",
+			old: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "prod", "team": "data"},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "staging", "team": "data"},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "staging", "team": "data"},
+			},
+			expectedMask: []string{"cloud_provider_tags"},
+		},
+		{
+			name: "renamed tag key",
+			old: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "prod"},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"environment": "prod"},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"environment": "prod"},
+			},
+			expectedMask: []string{"cloud_provider_tags"},
+		},
+		{
+			name: "removed all tags",
+			old: &controlplanev1beta2.ClusterUpdate{
+				CloudProviderTags: map[string]string{"env": "prod"},
+			},
+			new:          &controlplanev1beta2.ClusterUpdate{},
+			expected:     &controlplanev1beta2.ClusterUpdate{},
+			expectedMask: []string{"cloud_provider_tags"},
+		},
+		{
+			name: "reordered consumers are different by default",
+			old: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					Enabled: true,
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/a"}, {Source: "projects/b"},
+					},
+				},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					Enabled: true,
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/b"}, {Source: "projects/a"},
+					},
+				},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					Enabled: true,
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/b"}, {Source: "projects/a"},
+					},
+				},
+			},
+			expectedMask: []string{"gcp_private_service_connect"},
+		},
+		{
+			name: "reordered unordered consumers",
+			opts: []DiffOption{WithUnorderedFields("consumer_accept_list")},
+			old: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					Enabled: true,
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/a"}, {Source: "projects/b"},
+					},
+				},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					Enabled: true,
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/b"}, {Source: "projects/a"},
+					},
+				},
+			},
+			expected:     &controlplanev1beta2.ClusterUpdate{},
+			expectedMask: nil,
+		},
+		{
+			name: "unordered consumers with duplicates",
+			opts: []DiffOption{WithUnorderedFields("consumer_accept_list")},
+			old: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/a"}, {Source: "projects/a"},
+					},
+				},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/a"}, {Source: "projects/b"},
+					},
+				},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				GcpPrivateServiceConnect: &controlplanev1beta2.GCPPrivateServiceConnectSpec{
+					ConsumerAcceptList: []*controlplanev1beta2.GCPPrivateServiceConnectConsumer{
+						{Source: "projects/a"}, {Source: "projects/b"},
+					},
+				},
+			},
+			expectedMask: []string{"gcp_private_service_connect"},
+		},
+		{
+			name: "reordered unordered principals",
+			opts: []DiffOption{WithUnorderedFields("allowed_principals")},
+			old: &controlplanev1beta2.ClusterUpdate{
+				AwsPrivateLink: &controlplanev1beta2.AWSPrivateLinkSpec{
+					Enabled:           true,
+					AllowedPrincipals: []string{"arn:aws:iam::1:root", "arn:aws:iam::2:root"},
+				},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				AwsPrivateLink: &controlplanev1beta2.AWSPrivateLinkSpec{
+					Enabled:           true,
+					AllowedPrincipals: []string{"arn:aws:iam::2:root", "arn:aws:iam::1:root"},
+				},
+			},
+			expected:     &controlplanev1beta2.ClusterUpdate{},
+			expectedMask: nil,
+		},
+		{
+			name: "reordered principal mapping rules stay ordered",
+			opts: []DiffOption{WithUnorderedFields("allowed_principals")},
+			old: &controlplanev1beta2.ClusterUpdate{
+				KafkaApi: &controlplanev1beta2.KafkaAPISpec{
+					Mtls: &controlplanev1beta2.MTLSSpec{PrincipalMappingRules: []string{"RULE:a", "DEFAULT"}},
+				},
+			},
+			new: &controlplanev1beta2.ClusterUpdate{
+				KafkaApi: &controlplanev1beta2.KafkaAPISpec{
+					Mtls: &controlplanev1beta2.MTLSSpec{PrincipalMappingRules: []string{"DEFAULT", "RULE:a"}},
+				},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				KafkaApi: &controlplanev1beta2.KafkaAPISpec{
+					Mtls: &controlplanev1beta2.MTLSSpec{PrincipalMappingRules: []string{"DEFAULT", "RULE:a"}},
+				},
+			},
+			expectedMask: []string{"kafka_api"},
+		},
+		{
+			name: "unset and empty message differ",
+			old:  &controlplanev1beta2.ClusterUpdate{},
+			new: &controlplanev1beta2.ClusterUpdate{
+				KafkaApi: &controlplanev1beta2.KafkaAPISpec{},
+			},
+			expected: &controlplanev1beta2.ClusterUpdate{
+				KafkaApi: &controlplanev1beta2.KafkaAPISpec{},
+			},
+			expectedMask: []string{"kafka_api"},
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			var result *controlplanev1beta2.ClusterUpdate // assert type is correct
-			result, resultMask := GenerateProtobufDiffAndUpdateMask(tc.new, tc.old)
+			result, resultMask := GenerateProtobufDiffAndUpdateMask(tc.new, tc.old, tc.opts...)
 			assert.EqualExportedValues(t, tc.expected, result)
 
 			var resultPaths []string
@@ -70,7 +70,11 @@ func Retry(ctx context.Context, timeout time.Duration, f func() *RetryError) err
 		if waitUnit > maxWaitUnit {
 			waitUnit = maxWaitUnit
 		}
-		sleeper := time.NewTimer(waitUnit)
+		wait := waitUnit
+		if err.Delay > wait {
+			wait = err.Delay
+		}
+		sleeper := time.NewTimer(wait)
 		select {
 		case <-ctx.Done():
 			if !sleeper.Stop() {
@@ -88,6 +92,9 @@ func Retry(ctx context.Context, timeout time.Duration, f func() *RetryError) err
 type RetryError struct {
 	Err       error
 	Retryable bool
+	// Delay is the minimum wait before the next call, e.g. when the API asked
+	// to back off.
+	Delay time.Duration
 }
 
 // RetryableError is a helper to create a RetryError that's retryable
@@ -98,6 +105,16 @@ func RetryableError(err error) *RetryError {
 	return &RetryError{Err: err, Retryable: true}
 }
 
+// RetryableErrorAfter is a helper to create a RetryError that's retryable no
+// sooner than after delay
+func RetryableErrorAfter(err error, delay time.Duration) *RetryError {
+	retryErr := RetryableError(err)
+	if retryErr.Retryable {
+		retryErr.Delay = delay
+	}
+	return retryErr
+}
+
 // NonRetryableError is a helper to create a RetryError that's _not_ retryable
 func NonRetryableError(err error) *RetryError {
 	if err == nil {
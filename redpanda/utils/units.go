@@ -0,0 +1,112 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// durationPart matches a number followed by a unit of time, one of the parts
+// of a duration.
+var durationPart = regexp.MustCompile(`(\d+(?:\.\d*)?|\.\d+)(ns|us|µs|ms|s|m|h|d|w)`)
+
+// durationUnits are the units accepted by ParseDuration.
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"µs": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
+// sizeUnits are the multipliers of the units accepted by ParseSize, decimal
+// for the SI prefixes and binary for the IEC ones.
+var sizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// ParseDuration parses a human-readable duration such as "45m", "2h30m" or
+// "7d". On top of the units of time.ParseDuration it accepts "d" for days and
+// "w" for weeks.
+func ParseDuration(s string) (time.Duration, error) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "0" {
+		return 0, nil
+	}
+	parts := durationPart.FindAllStringSubmatchIndex(trimmed, -1)
+	var total float64
+	end := 0
+	for _, p := range parts {
+		if p[0] != end {
+			break
+		}
+		n, err := strconv.ParseFloat(trimmed[p[2]:p[3]], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %v", s, err)
+		}
+		total += n * float64(durationUnits[trimmed[p[4]:p[5]]])
+		end = p[1]
+	}
+	if len(parts) == 0 || end != len(trimmed) {
+		return 0, fmt.Errorf("invalid duration %q: expected a number followed by a unit such as 45m, 2h or 7d", s)
+	}
+	if total > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid duration %q: longer than %s", s, time.Duration(math.MaxInt64))
+	}
+	return time.Duration(total), nil
+}
+
+// ParseSize parses a human-readable size such as "512MiB" or "1GB" into a
+// number of bytes. A number without unit is a number of bytes.
+func ParseSize(s string) (int64, error) {
+	trimmed := strings.TrimSpace(s)
+	i := strings.IndexFunc(trimmed, func(r rune) bool { return !unicode.IsDigit(r) && r != '.' })
+	if i < 0 {
+		i = len(trimmed)
+	}
+	n, err := strconv.ParseFloat(trimmed[:i], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: expected a number followed by a unit such as 512MiB or 1GB", s)
+	}
+	unit, ok := sizeUnits[strings.ToLower(strings.TrimSpace(trimmed[i:]))]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q, expected one of B, KB, MB, GB, TB, KiB, MiB, GiB or TiB", s, strings.TrimSpace(trimmed[i:]))
+	}
+	bytes := n * unit
+	if bytes > math.MaxInt64 {
+		return 0, fmt.Errorf("invalid size %q: larger than %d bytes", s, int64(math.MaxInt64))
+	}
+	return int64(bytes), nil
+}
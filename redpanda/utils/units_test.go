@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "45m", want: 45 * time.Minute},
+		{input: "2h30m", want: 150 * time.Minute},
+		{input: "7d", want: 7 * 24 * time.Hour},
+		{input: "1w2d", want: 9 * 24 * time.Hour},
+		{input: "1.5d", want: 36 * time.Hour},
+		{input: " 500ms ", want: 500 * time.Millisecond},
+		{input: "0", want: 0},
+		{input: "", wantErr: true},
+		{input: "45", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "5y", wantErr: true},
+		{input: "5m extra", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseDuration(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Errorf("Expected %v, got %v (error %v)", tc.want, got, err)
+			}
+		})
+	}
+}
+
+func TestParseSize(t *testing.T) {
+	testCases := []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "512MiB", want: 512 << 20},
+		{input: "1GB", want: 1_000_000_000},
+		{input: "1.5 kib", want: 1536},
+		{input: "1048576", want: 1048576},
+		{input: "10B", want: 10},
+		{input: "", wantErr: true},
+		{input: "MiB", wantErr: true},
+		{input: "5PB", wantErr: true},
+		{input: "100000000TiB", wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			got, err := ParseSize(tc.input)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Errorf("Expected %v, got %v (error %v)", tc.want, got, err)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1alpha2/dataplanev1alpha2grpc"
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -89,6 +90,7 @@ func StringToCloudProvider(p string) (controlplanev1beta2.CloudProvider, error)
 
 // CloudProviderToString returns the cloud provider string based on the
 // controlplanev1beta2's CloudProvider code.
+// Values unknown to the provider are returned as their number.
 func CloudProviderToString(provider controlplanev1beta2.CloudProvider) string {
 	switch provider {
 	case controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS:
@@ -98,6 +100,9 @@ func CloudProviderToString(provider controlplanev1beta2.CloudProvider) string {
 	case controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AZURE:
 		return CloudProviderStringAzure
 	default:
+		if UnknownEnum(provider) {
+			return unknownEnumString(provider)
+		}
 		return providerUnspecified
 	}
 }
@@ -117,6 +122,7 @@ func StringToClusterType(p string) (controlplanev1beta2.Cluster_Type, error) {
 
 // ClusterTypeToString returns the cloud cluster type string based on the
 // controlplanev1beta2's Cluster_Type code.
+// Values unknown to the provider are returned as their number.
 func ClusterTypeToString(provider controlplanev1beta2.Cluster_Type) string {
 	switch provider {
 	case controlplanev1beta2.Cluster_TYPE_DEDICATED:
@@ -124,30 +130,61 @@ func ClusterTypeToString(provider controlplanev1beta2.Cluster_Type) string {
 	case controlplanev1beta2.Cluster_TYPE_BYOC:
 		return "byoc"
 	default:
+		if UnknownEnum(provider) {
+			return unknownEnumString(provider)
+		}
 		return providerUnspecified
 	}
 }
 
+type verbosePollingKey struct{}
+
+// WithVerbosePolling returns a context in which AreWeDoneYet logs every poll
+// at INFO, naming target, the resource the operation acts on.
+func WithVerbosePolling(ctx context.Context, target string) context.Context {
+	return context.WithValue(ctx, verbosePollingKey{}, target)
+}
+
 // AreWeDoneYet checks an operation's state until one of completion, failure or timeout is reached.
 func AreWeDoneYet(ctx context.Context, op *controlplanev1beta2.Operation, timeout time.Duration, client controlplanev1beta2grpc.OperationServiceClient) error {
+	start := time.Now()
+	target, verbose := ctx.Value(verbosePollingKey{}).(string)
+	watchdog, _ := ctx.Value(operationWatchdogKey{}).(*OperationWatchdog)
+	stall := &operationStall{progressStall: progressStall{watchdog: watchdog}}
 	return Retry(ctx, timeout, func() *RetryError {
 		// Get the latest operation status
-		tflog.Info(ctx, "getting operation")
 		latestOp, err := client.GetOperation(ctx, &controlplanev1beta2.GetOperationRequest{
 			Id: op.GetId(),
 		})
-		tflog.Info(ctx, "got result of operation")
 		if err != nil {
+			if delay, ok := cloud.RetryDelay(err); ok {
+				return RetryableErrorAfter(err, delay)
+			}
 			return NonRetryableError(err)
 		}
 		op = latestOp.Operation
-		tflog.Info(ctx, fmt.Sprintf("op %v %s", op, op.GetState()))
+		fields := map[string]any{
+			"operation_id":   op.GetId(),
+			"operation_type": op.GetType().String(),
+			"resource_id":    op.GetResourceId(),
+			"state":          op.GetState().String(),
+			"elapsed":        time.Since(start).Round(time.Second).String(),
+		}
+		if verbose {
+			fields["resource"] = target
+			tflog.Info(ctx, "polled operation", fields)
+		} else {
+			tflog.Debug(ctx, "polled operation", fields)
+		}
 
 		// Check the operation state
 		if op.GetState() == controlplanev1beta2.Operation_STATE_FAILED {
 			return NonRetryableError(fmt.Errorf("operation failed: %s", op.GetError().GetMessage()))
 		}
 		if op.GetState() != controlplanev1beta2.Operation_STATE_COMPLETED {
+			if err := stall.observe(ctx, op); err != nil {
+				return NonRetryableError(err)
+			}
 			return RetryableError(fmt.Errorf("expected operation to be completed but was in state %s", op.GetState()))
 		}
 		return nil
@@ -169,6 +206,7 @@ func StringToConnectionType(s string) controlplanev1beta2.Cluster_ConnectionType
 
 // ConnectionTypeToString returns the cloud cluster connection type string based
 // on the controlplanev1beta2's Cluster_ConnectionType code.
+// Values unknown to the provider are returned as their number.
 func ConnectionTypeToString(t controlplanev1beta2.Cluster_ConnectionType) string {
 	switch t {
 	case controlplanev1beta2.Cluster_CONNECTION_TYPE_PUBLIC:
@@ -176,6 +214,9 @@ func ConnectionTypeToString(t controlplanev1beta2.Cluster_ConnectionType) string
 	case controlplanev1beta2.Cluster_CONNECTION_TYPE_PRIVATE:
 		return "private"
 	default:
+		if UnknownEnum(t) {
+			return unknownEnumString(t)
+		}
 		return providerUnspecified
 	}
 }
@@ -214,6 +255,16 @@ func StringSliceToTypeList(elements []string) types.List {
 	return types.ListValueMust(types.StringType, values)
 }
 
+// DiagnosticsError returns the errors in diags as a single error, or nil if
+// there are none. It is used where diagnostics can't be appended to a response.
+func DiagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return errors.Join(errs...)
+}
+
 // TrimmedStringValue returns the string value of a types.String with the quotes removed.
 // This is necessary as terraform has a tendency to slap these bad boys in at random which causes the API to fail
 func TrimmedStringValue(s string) types.String {
@@ -267,6 +318,9 @@ func UserMechanismToString(m *dataplanev1alpha2.SASLMechanism) string {
 	case dataplanev1alpha2.SASLMechanism_SASL_MECHANISM_SCRAM_SHA_512:
 		return "scram-sha-512"
 	default:
+		if UnknownEnum(m) {
+			return unknownEnumString(m)
+		}
 		return "unspecified"
 	}
 }
@@ -281,9 +335,9 @@ func TopicConfigurationToMap(cfg []*dataplanev1alpha2.Topic_Configuration) (type
 		}
 		configs[v.Name] = types.StringValue(*v.Value)
 	}
-	cfgMap, diag := types.MapValue(types.StringType, configs)
-	if diag.HasError() {
-		return types.Map{}, errors.New("unable to parse the configuration map")
+	cfgMap, diags := types.MapValue(types.StringType, configs)
+	if err := DiagnosticsError(diags); err != nil {
+		return types.Map{}, fmt.Errorf("unable to parse the configuration map: %v", err)
 	}
 	return cfgMap, nil
 }
@@ -323,24 +377,34 @@ func MapToSetTopicConfiguration(cfg types.Map) ([]*dataplanev1alpha2.SetTopicCon
 	return output, nil
 }
 
-// NumberToInt32 converts a types.Number to an *int32
-func NumberToInt32(n types.Number) *int32 {
-	i, _ := n.ValueBigFloat().Int64()
-	var i32 int32
-	switch {
-	case i > math.MaxInt32:
-		i32 = math.MaxInt32
-	case i < math.MinInt32:
-		i32 = math.MinInt32
-	default:
-		i32 = int32(i)
+// Int64ToInt32 converts an int64 to an int32, failing if the value doesn't fit
+// instead of truncating it.
+func Int64ToInt32(i int64) (int32, error) {
+	if i > math.MaxInt32 || i < math.MinInt32 {
+		return 0, fmt.Errorf("value %d is out of the int32 range", i)
 	}
-	return &i32
+	return int32(i), nil
 }
 
-// Int32ToNumber converts an int32 to a types.Number
-func Int32ToNumber(i int32) types.Number {
-	return types.NumberValue(big.NewFloat(float64(i)))
+// NumberToInt64 converts a types.Number to a types.Int64, failing if the
+// number is not an integer or doesn't fit in an int64. Null and unknown
+// values are preserved.
+func NumberToInt64(n types.Number) (types.Int64, error) {
+	if n.IsNull() {
+		return types.Int64Null(), nil
+	}
+	if n.IsUnknown() {
+		return types.Int64Unknown(), nil
+	}
+	f := n.ValueBigFloat()
+	if !f.IsInt() {
+		return types.Int64Null(), fmt.Errorf("value %v is not an integer", f)
+	}
+	i, acc := f.Int64()
+	if acc != big.Exact {
+		return types.Int64Null(), fmt.Errorf("value %v is out of the int64 range", f)
+	}
+	return types.Int64Value(i), nil
 }
 
 // FindTopicByName searches for a topic by name using the provided client.
@@ -375,9 +439,12 @@ func SplitSchemeDefPort(url, def string) (string, error) {
 }
 
 // RetryGetCluster will retry a function, passing in the latest state of the given cluster id, until
-// it either no longer returns an error or times out
+// it either no longer returns an error or times out. Clusters that stop changing while f retries
+// are reported to the OperationWatchdog of ctx, if any.
 func RetryGetCluster(ctx context.Context, timeout time.Duration, clusterID string, client *cloud.ControlPlaneClientSet, f func(*controlplanev1beta2.Cluster) *RetryError) (*controlplanev1beta2.Cluster, error) {
 	var cluster *controlplanev1beta2.Cluster
+	watchdog, _ := ctx.Value(operationWatchdogKey{}).(*OperationWatchdog)
+	stall := &clusterStall{progressStall: progressStall{watchdog: watchdog}}
 	err := Retry(ctx, timeout, func() *RetryError {
 		var err error
 		cluster, err = client.ClusterForID(ctx, clusterID)
@@ -390,7 +457,13 @@ func RetryGetCluster(ctx context.Context, timeout time.Duration, clusterID strin
 			return NonRetryableError(err)
 		}
 		tflog.Info(ctx, fmt.Sprintf("cluster %v : %v", clusterID, cluster.GetState()))
-		return f(cluster)
+		rerr := f(cluster)
+		if rerr != nil && rerr.Retryable {
+			if err := stall.observe(ctx, cluster); err != nil {
+				return NonRetryableError(err)
+			}
+		}
+		return rerr
 	})
 	return cluster, err
 }
@@ -1,10 +1,14 @@
 package utils
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,10 +16,17 @@ import (
 	dataplanev1alpha2 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1alpha2"
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestAreWeDoneYet(t *testing.T) {
@@ -70,6 +81,19 @@ func TestAreWeDoneYet(t *testing.T) {
 			timeout: 5 * time.Minute,
 			wantErr: "operation failed: operation failed",
 		},
+		{
+			name: "Operation polling is throttled but then completes",
+			op: &controlplanev1beta2.Operation{
+				State: controlplanev1beta2.Operation_STATE_IN_PROGRESS,
+			},
+			mockSetup: func(m *mocks.MockOperationServiceClient) {
+				gomock.InOrder(
+					m.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(nil, throttledError(t, 10*time.Millisecond)),
+					m.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(createOpResponse(controlplanev1beta2.Operation_STATE_COMPLETED), nil),
+				)
+			},
+			timeout: 5 * time.Minute,
+		},
 		{
 			name:    "Operation times out",
 			op:      &controlplanev1beta2.Operation{State: controlplanev1beta2.Operation_STATE_IN_PROGRESS},
@@ -115,6 +139,125 @@ func TestAreWeDoneYet(t *testing.T) {
 	}
 }
 
+func TestAreWeDoneYetVerbosePolling(t *testing.T) {
+	for _, verbose := range []bool{false, true} {
+		t.Run(fmt.Sprintf("verbose=%t", verbose), func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mocks.NewMockOperationServiceClient(ctrl)
+			mockClient.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(createOpResponse(controlplanev1beta2.Operation_STATE_COMPLETED), nil)
+
+			var out bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &out)
+			if verbose {
+				ctx = WithVerbosePolling(ctx, "my-cluster")
+			}
+			if err := AreWeDoneYet(ctx, &controlplanev1beta2.Operation{Id: "op-1"}, time.Minute, mockClient); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			entries, err := tflogtest.MultilineJSONDecode(&out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var info []map[string]any
+			for _, e := range entries {
+				if e["@level"] == "info" {
+					info = append(info, e)
+				}
+			}
+			if !verbose {
+				if len(info) != 0 {
+					t.Errorf("Expected no INFO logs, got %v", info)
+				}
+				return
+			}
+			if len(info) != 1 {
+				t.Fatalf("Expected one INFO log, got %v", info)
+			}
+			if info[0]["resource"] != "my-cluster" || info[0]["state"] != "STATE_COMPLETED" || info[0]["elapsed"] == nil {
+				t.Errorf("Unexpected poll log %v", info[0])
+			}
+		})
+	}
+}
+
+func TestAreWeDoneYetStalledOperation(t *testing.T) {
+	inProgress := createOpResponse(controlplanev1beta2.Operation_STATE_IN_PROGRESS)
+	testCases := []struct {
+		name     string
+		last     *controlplanev1beta2.GetOperationResponse
+		watchdog *OperationWatchdog
+		wantErr  bool
+	}{
+		{
+			name:     "Stalled operation is warned about but completes",
+			last:     createOpResponse(controlplanev1beta2.Operation_STATE_COMPLETED),
+			watchdog: &OperationWatchdog{Warn: 500 * time.Millisecond},
+		},
+		{
+			name:     "Stalled operation fails after the second threshold",
+			last:     inProgress,
+			watchdog: &OperationWatchdog{Warn: 500 * time.Millisecond, Fail: 2500 * time.Millisecond},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockClient := mocks.NewMockOperationServiceClient(ctrl)
+			gomock.InOrder(
+				mockClient.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(inProgress, nil).Times(2),
+				mockClient.EXPECT().GetOperation(gomock.Any(), gomock.Any()).Return(tc.last, nil),
+			)
+
+			ctx := WithOperationWatchdog(context.Background(), tc.watchdog)
+			err := AreWeDoneYet(ctx, &controlplanev1beta2.Operation{Id: "op-1"}, time.Minute, mockClient)
+			if !tc.wantErr && err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if tc.wantErr && (err == nil || !strings.Contains(err.Error(), "made no progress in state STATE_IN_PROGRESS for 3s")) {
+				t.Fatalf("Expected a stalled operation error, got: %v", err)
+			}
+			if stalled := tc.watchdog.Stalled(); len(stalled) != 1 {
+				t.Fatalf("Expected one stalled operation, got %v", stalled)
+			}
+
+			var diags diag.Diagnostics
+			WarnStalledOperations(&diags, tc.watchdog)
+			if len(diags.Warnings()) != 1 || diags.HasError() {
+				t.Errorf("Expected one warning, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestRetryGetClusterStalledCluster(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockClusterServiceClient(ctrl)
+	mockClient.EXPECT().GetCluster(gomock.Any(), gomock.Any()).Return(&controlplanev1beta2.GetClusterResponse{
+		Cluster: &controlplanev1beta2.Cluster{Id: "cluster-1", State: controlplanev1beta2.Cluster_STATE_CREATING},
+	}, nil).AnyTimes()
+
+	watchdog := &OperationWatchdog{Warn: 500 * time.Millisecond, Fail: 2500 * time.Millisecond}
+	ctx := WithOperationWatchdog(context.Background(), watchdog)
+	_, err := RetryGetCluster(ctx, time.Minute, "cluster-1", &cloud.ControlPlaneClientSet{Cluster: mockClient}, func(c *controlplanev1beta2.Cluster) *RetryError {
+		return RetryableError(fmt.Errorf("expected cluster to be ready but was in state %v", c.GetState()))
+	})
+	if err == nil || !strings.Contains(err.Error(), "cluster cluster-1 made no progress in state STATE_CREATING for 3s") {
+		t.Fatalf("Expected a stalled cluster error, got: %v", err)
+	}
+	if stalled := watchdog.Stalled(); len(stalled) != 1 {
+		t.Fatalf("Expected one stalled cluster, got %v", stalled)
+	}
+}
+
+func throttledError(t *testing.T, delay time.Duration) error {
+	st, err := grpcstatus.New(codes.ResourceExhausted, "too many requests").WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st.Err()
+}
+
 func createOpResponse(state controlplanev1beta2.Operation_State) *controlplanev1beta2.GetOperationResponse {
 	return &controlplanev1beta2.GetOperationResponse{
 		Operation: &controlplanev1beta2.Operation{
@@ -371,6 +514,23 @@ func TestTopicConfigurationToMap(t *testing.T) {
 	}
 }
 
+func TestDiagnosticsError(t *testing.T) {
+	var diags diag.Diagnostics
+	if err := DiagnosticsError(diags); err != nil {
+		t.Errorf("Expected no error, but got %q", err)
+	}
+	diags.AddWarning("deprecated", "the attribute is deprecated")
+	if err := DiagnosticsError(diags); err != nil {
+		t.Errorf("Expected warnings to be ignored, but got %q", err)
+	}
+	diags.AddError("invalid value", "the value must be a string")
+	diags.AddError("missing value", "the value is required")
+	expected := "invalid value: the value must be a string\nmissing value: the value is required"
+	if err := DiagnosticsError(diags); err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, but got %v", expected, err)
+	}
+}
+
 func TestMapToCreateTopicConfiguration(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -553,3 +713,101 @@ func TestFindTopicByName(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudProviderConversion(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expected  controlplanev1beta2.CloudProvider
+		expectErr bool
+	}{
+		{name: "aws", input: "aws", expected: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AWS},
+		{name: "gcp", input: "gcp", expected: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_GCP},
+		{name: "azure", input: "azure", expected: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AZURE},
+		{name: "upper case", input: "Azure", expected: controlplanev1beta2.CloudProvider_CLOUD_PROVIDER_AZURE},
+		{name: "unsupported", input: "oci", expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := StringToCloudProvider(tc.input)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+			if back := CloudProviderToString(got); back != strings.ToLower(tc.input) {
+				t.Errorf("Expected %q, got %q", strings.ToLower(tc.input), back)
+			}
+		})
+	}
+}
+
+func TestInt64ToInt32(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     int64
+		expected  int32
+		expectErr bool
+	}{
+		{name: "in range", input: 12, expected: 12},
+		{name: "max int32", input: math.MaxInt32, expected: math.MaxInt32},
+		{name: "overflow", input: math.MaxInt32 + 1, expectErr: true},
+		{name: "underflow", input: math.MinInt32 - 1, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Int64ToInt32(tc.input)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("Expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestNumberToInt64(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     types.Number
+		expected  types.Int64
+		expectErr bool
+	}{
+		{name: "integer", input: types.NumberValue(big.NewFloat(3)), expected: types.Int64Value(3)},
+		{name: "null", input: types.NumberNull(), expected: types.Int64Null()},
+		{name: "unknown", input: types.NumberUnknown(), expected: types.Int64Unknown()},
+		{name: "fraction", input: types.NumberValue(big.NewFloat(2.5)), expectErr: true},
+		{name: "overflow", input: types.NumberValue(new(big.Float).SetFloat64(1e20)), expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NumberToInt64(tc.input)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if !got.Equal(tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
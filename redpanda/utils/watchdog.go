@@ -0,0 +1,146 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	controlplanev1beta2 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1beta2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/proto"
+)
+
+type operationWatchdogKey struct{}
+
+// OperationWatchdog flags the operations polled by AreWeDoneYet whose state
+// and metadata stop changing, and the clusters polled by RetryGetCluster whose
+// state and state description stop changing, as they are likely stuck.
+type OperationWatchdog struct {
+	// Warn is how long an operation can go unchanged before it is reported
+	// as stalled. 0 disables the warning.
+	Warn time.Duration
+	// Fail is how long an operation can go unchanged before polling it
+	// fails. 0 disables the failure.
+	Fail time.Duration
+
+	mu      sync.Mutex
+	stalled []string
+}
+
+// WithOperationWatchdog returns a context in which AreWeDoneYet and
+// RetryGetCluster report to w the operations and clusters that stop changing.
+func WithOperationWatchdog(ctx context.Context, w *OperationWatchdog) context.Context {
+	return context.WithValue(ctx, operationWatchdogKey{}, w)
+}
+
+// Stalled returns the operations and clusters that went unchanged for longer than Warn,
+// with how long they were unchanged for. It is safe to call on a nil
+// OperationWatchdog.
+func (w *OperationWatchdog) Stalled() []string {
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return append([]string(nil), w.stalled...)
+}
+
+func (w *OperationWatchdog) report(stall string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.stalled = append(w.stalled, stall)
+}
+
+// progressStall tracks how long a polled object has gone unchanged.
+type progressStall struct {
+	watchdog *OperationWatchdog
+	since    time.Time
+	started  bool
+	warned   bool
+}
+
+// observe records a poll of subject, found in state, and returns an error once
+// it has gone unchanged for longer than the Fail threshold. changed reports
+// whether the poll differs from the previous one, and hint tells what to do
+// about a stuck subject.
+func (s *progressStall) observe(ctx context.Context, subject, state string, changed bool, hint string) error {
+	now := time.Now()
+	if !s.started || changed {
+		s.since, s.started, s.warned = now, true, false
+		return nil
+	}
+	if s.watchdog == nil {
+		return nil
+	}
+	unchanged := now.Sub(s.since).Round(time.Second)
+	if s.watchdog.Fail > 0 && unchanged >= s.watchdog.Fail {
+		return fmt.Errorf("%s made no progress in state %s for %s and is likely stuck. %s", subject, state, unchanged, hint)
+	}
+	if s.watchdog.Warn > 0 && unchanged >= s.watchdog.Warn && !s.warned {
+		s.warned = true
+		s.watchdog.report(fmt.Sprintf("%s made no progress in state %s for %s", subject, state, unchanged))
+		tflog.Warn(ctx, "operation seems stuck", map[string]any{
+			"subject":   subject,
+			"state":     state,
+			"unchanged": unchanged.String(),
+		})
+	}
+	return nil
+}
+
+// operationStall tracks how long a single operation has gone unchanged.
+type operationStall struct {
+	progressStall
+	last *controlplanev1beta2.Operation
+}
+
+// observe records the latest poll of op and returns an error once op has gone
+// unchanged for longer than the Fail threshold.
+func (s *operationStall) observe(ctx context.Context, op *controlplanev1beta2.Operation) error {
+	changed := s.last == nil || s.last.GetState() != op.GetState() || !proto.Equal(s.last.GetMetadata(), op.GetMetadata())
+	s.last = op
+	return s.progressStall.observe(ctx, "operation "+op.GetId(), op.GetState().String(), changed,
+		"Check its state with the redpanda_operation data source or contact Redpanda support with the operation ID, then apply again once it has completed")
+}
+
+// clusterStall tracks how long a cluster polled by RetryGetCluster has gone
+// without changing state or state description.
+type clusterStall struct {
+	progressStall
+	last *controlplanev1beta2.Cluster
+}
+
+// observe records the latest poll of cluster and returns an error once it has
+// gone unchanged for longer than the Fail threshold.
+func (s *clusterStall) observe(ctx context.Context, cluster *controlplanev1beta2.Cluster) error {
+	changed := s.last == nil || s.last.GetState() != cluster.GetState() || !proto.Equal(s.last.GetStateDescription(), cluster.GetStateDescription())
+	s.last = cluster
+	return s.progressStall.observe(ctx, "cluster "+cluster.GetId(), cluster.GetState().String(), changed,
+		"Check the cluster in the Redpanda Cloud console or contact Redpanda support with the cluster ID, then apply again once it has settled")
+}
+
+// WarnStalledOperations adds a warning for each operation or cluster that the
+// watchdog reported as stalled.
+func WarnStalledOperations(diags *diag.Diagnostics, w *OperationWatchdog) {
+	for _, stall := range w.Stalled() {
+		diags.AddWarning("operation seems stuck",
+			fmt.Sprintf("The Redpanda Cloud %s. Polling continued until it completed or timed out; if this happens again, contact Redpanda support with its ID.", stall))
+	}
+}
@@ -45,8 +45,8 @@ func (v CloudProviderDependentValidator) MarkdownDescription(_ context.Context)
 // ValidateObject validates an object
 func (v CloudProviderDependentValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
 	var cloudProvider types.String
-	if diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("cloud_provider"), &cloudProvider); diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("cloud_provider"), &cloudProvider)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
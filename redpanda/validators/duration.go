@@ -0,0 +1,61 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var _ validator.String = DurationValidator{}
+
+// Duration returns a DurationValidator
+func Duration() DurationValidator {
+	return DurationValidator{}
+}
+
+// DurationValidator is a custom validator to ensure that an attribute is a
+// human-readable duration such as "45m" or "2h", as parsed by
+// utils.ParseDuration.
+type DurationValidator struct{}
+
+// Description provides a description of the validator
+func (v DurationValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (DurationValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensure that an attribute is a duration such as `45m`, `2h` or `7d`"
+}
+
+// ValidateString validates a string
+func (DurationValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := utils.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", err.Error())
+		return
+	}
+	if d <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", fmt.Sprintf("%q must be longer than 0", req.ConfigValue.ValueString()))
+	}
+}
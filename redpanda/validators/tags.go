@@ -0,0 +1,169 @@
+// Copyright 2024 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GCPNetworkTagPrefix is the prefix of the tags that are added as network tags
+// to the GKE nodes of GCP clusters rather than as labels.
+const GCPNetworkTagPrefix = "gcp.network-tag."
+
+var (
+	awsTagPattern        = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+	gcpLabelKeyPattern   = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	gcpLabelValuePattern = regexp.MustCompile(`^[a-z0-9_-]*$`)
+	gcpNetworkTagPattern = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+	azureTagKeyForbidden = "<>%&\\?/"
+)
+
+// TagErrors returns the problems with the tags for the given cloud provider,
+// following the naming rules of AWS tags, GCP labels and network tags, and
+// Azure tags. Tags of unknown cloud providers are not checked.
+func TagErrors(cloudProvider string, tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var errs []string
+	for _, k := range keys {
+		switch cloudProvider {
+		case "aws":
+			errs = append(errs, awsTagErrors(k, tags[k])...)
+		case "gcp":
+			errs = append(errs, gcpTagErrors(k, tags[k])...)
+		case "azure":
+			errs = append(errs, azureTagErrors(k, tags[k])...)
+		}
+	}
+	if cloudProvider == "azure" {
+		// Azure tag names are case-insensitive
+		seen := map[string]string{}
+		for _, k := range keys {
+			if other, ok := seen[strings.ToLower(k)]; ok {
+				errs = append(errs, fmt.Sprintf("tags %q and %q only differ by case, which Azure does not allow", other, k))
+				continue
+			}
+			seen[strings.ToLower(k)] = k
+		}
+	}
+	return errs
+}
+
+func awsTagErrors(k, v string) []string {
+	var errs []string
+	if k == "" || len(k) > 128 {
+		errs = append(errs, fmt.Sprintf("tag name %q must be 1 to 128 characters long", k))
+	}
+	if strings.HasPrefix(strings.ToLower(k), "aws:") {
+		errs = append(errs, fmt.Sprintf("tag name %q must not start with aws:, which is reserved by AWS", k))
+	}
+	if !awsTagPattern.MatchString(k) {
+		errs = append(errs, fmt.Sprintf("tag name %q may only contain letters, numbers, spaces and _ . : / = + - @", k))
+	}
+	if len(v) > 256 {
+		errs = append(errs, fmt.Sprintf("value of tag %q must be at most 256 characters long", k))
+	}
+	if !awsTagPattern.MatchString(v) {
+		errs = append(errs, fmt.Sprintf("value of tag %q may only contain letters, numbers, spaces and _ . : / = + - @", k))
+	}
+	return errs
+}
+
+func gcpTagErrors(k, v string) []string {
+	if name, ok := strings.CutPrefix(k, GCPNetworkTagPrefix); ok {
+		// the value of network tags is ignored
+		if len(name) > 63 || !gcpNetworkTagPattern.MatchString(name) {
+			return []string{fmt.Sprintf("network tag %q must be 1 to 63 lowercase letters, numbers or dashes, start with a letter and not end with a dash", name)}
+		}
+		return nil
+	}
+	var errs []string
+	if len(k) > 63 || !gcpLabelKeyPattern.MatchString(k) {
+		errs = append(errs, fmt.Sprintf("tag name %q must be 1 to 63 lowercase letters, numbers, underscores or dashes, and start with a letter", k))
+	}
+	if len(v) > 63 || !gcpLabelValuePattern.MatchString(v) {
+		errs = append(errs, fmt.Sprintf("value of tag %q must be at most 63 lowercase letters, numbers, underscores or dashes", k))
+	}
+	return errs
+}
+
+func azureTagErrors(k, v string) []string {
+	var errs []string
+	if k == "" || len(k) > 512 {
+		errs = append(errs, fmt.Sprintf("tag name %q must be 1 to 512 characters long", k))
+	}
+	if strings.ContainsAny(k, azureTagKeyForbidden) {
+		errs = append(errs, fmt.Sprintf("tag name %q must not contain any of %s", k, azureTagKeyForbidden))
+	}
+	if len(v) > 256 {
+		errs = append(errs, fmt.Sprintf("value of tag %q must be at most 256 characters long", k))
+	}
+	return errs
+}
+
+// CloudTagsValidator checks the tags of a map attribute against the naming
+// rules of the cloud provider set in the cloud_provider attribute next to it.
+// Problems are reported as warnings, so that configurations accepted by
+// earlier releases still plan.
+type CloudTagsValidator struct{}
+
+// Description provides a description of the validator
+func (CloudTagsValidator) Description(_ context.Context) string {
+	return "warns when the tags don't follow the naming rules of the cloud provider"
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (CloudTagsValidator) MarkdownDescription(_ context.Context) string {
+	return "Warns when the tags don't follow the naming rules of the `cloud_provider`"
+}
+
+// ValidateMap validates a map
+func (CloudTagsValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var cloudProvider types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("cloud_provider"), &cloudProvider)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cloudProvider.IsNull() || cloudProvider.IsUnknown() {
+		return
+	}
+	tags := map[string]string{}
+	for k, v := range req.ConfigValue.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() {
+			// checked once the value is known
+			continue
+		}
+		tags[k] = s.ValueString()
+	}
+	for _, e := range TagErrors(cloudProvider.ValueString(), tags) {
+		resp.Diagnostics.AddAttributeWarning(req.Path, "Tag may be rejected by the cloud provider", e)
+	}
+}
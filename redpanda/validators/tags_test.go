@@ -0,0 +1,64 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		cloudProvider string
+		tags          map[string]string
+		wantErrs      int
+	}{
+		{name: "aws valid", cloudProvider: "aws", tags: map[string]string{"Cost Center": "1234", "owner": "data-platform@example.com"}},
+		{name: "aws reserved prefix", cloudProvider: "aws", tags: map[string]string{"aws:owner": "me"}, wantErrs: 1},
+		{name: "aws invalid characters", cloudProvider: "aws", tags: map[string]string{"owner": "a|b"}, wantErrs: 1},
+		{name: "aws long value", cloudProvider: "aws", tags: map[string]string{"k": strings.Repeat("a", 257)}, wantErrs: 1},
+		{name: "gcp valid", cloudProvider: "gcp", tags: map[string]string{"cost-center": "1234", "gcp.network-tag.allow-redpanda": ""}},
+		{name: "gcp uppercase", cloudProvider: "gcp", tags: map[string]string{"Owner": "Me"}, wantErrs: 2},
+		{name: "gcp invalid network tag", cloudProvider: "gcp", tags: map[string]string{"gcp.network-tag.Allow_Redpanda": ""}, wantErrs: 1},
+		{name: "azure valid", cloudProvider: "azure", tags: map[string]string{"Cost Center": "1234"}},
+		{name: "azure forbidden characters", cloudProvider: "azure", tags: map[string]string{"team/owner": "me"}, wantErrs: 1},
+		{name: "azure case duplicates", cloudProvider: "azure", tags: map[string]string{"Owner": "a", "owner": "b"}, wantErrs: 1},
+		{name: "unknown cloud provider", cloudProvider: "", tags: map[string]string{"aws:owner": "me"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := TagErrors(tt.cloudProvider, tt.tags)
+			assert.Len(t, errs, tt.wantErrs, errs)
+		})
+	}
+}
+
+func TestCloudTagsValidatorWarns(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"cloud_provider": schema.StringAttribute{Optional: true},
+		"tags":           schema.MapAttribute{ElementType: types.StringType, Optional: true},
+	}}
+	tagsType := tftypes.Map{ElementType: tftypes.String}
+	cfg := tfsdk.Config{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"cloud_provider": tftypes.NewValue(tftypes.String, "gcp"),
+		"tags":           tftypes.NewValue(tagsType, map[string]tftypes.Value{"Team": tftypes.NewValue(tftypes.String, "data")}),
+	})}
+	resp := &validator.MapResponse{}
+	CloudTagsValidator{}.ValidateMap(ctx, validator.MapRequest{
+		Path:        path.Root("tags"),
+		Config:      cfg,
+		ConfigValue: types.MapValueMust(types.StringType, map[string]attr.Value{"Team": types.StringValue("data")}),
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, 1, resp.Diagnostics.WarningsCount())
+}